@@ -0,0 +1,24 @@
+package chat
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/defany/chat-server/app/internal/converter"
+	chatv1 "github.com/defany/chat-server/app/pkg/gen/chat/v1"
+	"github.com/defany/slogger/pkg/logger/sl"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (i *Implementation) AddMembers(ctx context.Context, request *chatv1.AddMembersRequest) (*emptypb.Empty, error) {
+	log := i.log.With(slog.String("op", sl.FnName()))
+
+	err := i.service.AddMembers(ctx, converter.ToAddMembersInput(ctx, request))
+	if err != nil {
+		log.Error("failed to add chat members", sl.ErrAttr(err))
+
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
@@ -12,12 +12,18 @@ type Implementation struct {
 
 	log *slog.Logger
 
-	service servicedef.Chat
+	service     servicedef.Chat
+	reactions   servicedef.Reaction
+	attachments servicedef.Attachment
+	online      servicedef.Online
 }
 
-func NewImplementation(log *slog.Logger, service servicedef.Chat) *Implementation {
+func NewImplementation(log *slog.Logger, service servicedef.Chat, reactions servicedef.Reaction, attachments servicedef.Attachment, online servicedef.Online) *Implementation {
 	return &Implementation{
-		log:     log,
-		service: service,
+		log:         log,
+		service:     service,
+		reactions:   reactions,
+		attachments: attachments,
+		online:      online,
 	}
 }
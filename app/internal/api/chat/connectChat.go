@@ -0,0 +1,27 @@
+package chat
+
+import (
+	"log/slog"
+
+	"github.com/defany/chat-server/app/internal/converter"
+	"github.com/defany/chat-server/app/internal/model"
+	chatv1 "github.com/defany/chat-server/app/pkg/gen/chat/v1"
+	"github.com/defany/slogger/pkg/logger/sl"
+)
+
+func (i *Implementation) ConnectChat(request *chatv1.ConnectChatRequest, stream chatv1.Chat_ConnectChatServer) error {
+	log := i.log.With(slog.String("op", sl.FnName()))
+
+	ctx := stream.Context()
+
+	err := i.service.ConnectChat(ctx, converter.ToConnectChatInput(ctx, request), func(msg model.Message) error {
+		return stream.Send(converter.FromMessage(msg))
+	})
+	if err != nil {
+		log.Error("failed to stream chat messages", sl.ErrAttr(err))
+
+		return err
+	}
+
+	return nil
+}
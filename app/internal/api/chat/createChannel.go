@@ -0,0 +1,25 @@
+package chat
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/defany/chat-server/app/internal/converter"
+	chatv1 "github.com/defany/chat-server/app/pkg/gen/chat/v1"
+	"github.com/defany/slogger/pkg/logger/sl"
+)
+
+func (i *Implementation) CreateChannel(ctx context.Context, request *chatv1.CreateChannelRequest) (*chatv1.CreateChannelResponse, error) {
+	log := i.log.With(slog.String("op", sl.FnName()))
+
+	output, err := i.service.CreateChannel(ctx, converter.ToCreateChannelInput(ctx, request))
+	if err != nil {
+		log.Error("failed to create channel", sl.ErrAttr(err))
+
+		return nil, err
+	}
+
+	return &chatv1.CreateChannelResponse{
+		Id: int64(output.ID),
+	}, nil
+}
@@ -0,0 +1,57 @@
+package chat
+
+import (
+	"errors"
+	"io"
+	"log/slog"
+
+	"github.com/defany/chat-server/app/internal/converter"
+	chatv1 "github.com/defany/chat-server/app/pkg/gen/chat/v1"
+	"github.com/defany/slogger/pkg/logger/sl"
+)
+
+const downloadChunkSize = 64 << 10
+
+func (i *Implementation) DownloadAttachment(request *chatv1.DownloadAttachmentRequest, stream chatv1.Chat_DownloadAttachmentServer) error {
+	log := i.log.With(slog.String("op", sl.FnName()))
+
+	ctx := stream.Context()
+
+	attachment, body, err := i.attachments.Download(ctx, converter.ToDownloadAttachmentInput(ctx, request))
+	if err != nil {
+		log.Error("failed to download attachment", sl.ErrAttr(err))
+
+		return err
+	}
+	defer body.Close()
+
+	err = stream.Send(&chatv1.DownloadAttachmentResponse{
+		Payload: &chatv1.DownloadAttachmentResponse_Info{Info: converter.FromAttachment(attachment)},
+	})
+	if err != nil {
+		return err
+	}
+
+	buf := make([]byte, downloadChunkSize)
+
+	for {
+		n, err := body.Read(buf)
+		if n > 0 {
+			sendErr := stream.Send(&chatv1.DownloadAttachmentResponse{
+				Payload: &chatv1.DownloadAttachmentResponse_Chunk{Chunk: buf[:n]},
+			})
+			if sendErr != nil {
+				return sendErr
+			}
+		}
+
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			log.Error("failed to read attachment", sl.ErrAttr(err))
+
+			return err
+		}
+	}
+}
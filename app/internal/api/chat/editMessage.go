@@ -0,0 +1,23 @@
+package chat
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/defany/chat-server/app/internal/converter"
+	chatv1 "github.com/defany/chat-server/app/pkg/gen/chat/v1"
+	"github.com/defany/slogger/pkg/logger/sl"
+)
+
+func (i *Implementation) EditMessage(ctx context.Context, request *chatv1.EditMessageRequest) (*chatv1.EditMessageResponse, error) {
+	log := i.log.With(slog.String("op", sl.FnName()))
+
+	msg, err := i.service.EditMessage(ctx, converter.ToEditMessageInput(ctx, request))
+	if err != nil {
+		log.Error("failed to edit message", sl.ErrAttr(err))
+
+		return nil, err
+	}
+
+	return converter.FromEditMessageOutput(msg), nil
+}
@@ -0,0 +1,25 @@
+package chat
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/defany/chat-server/app/internal/converter"
+	chatv1 "github.com/defany/chat-server/app/pkg/gen/chat/v1"
+	"github.com/defany/slogger/pkg/logger/sl"
+)
+
+func (i *Implementation) GetChat(ctx context.Context, request *chatv1.GetChatRequest) (*chatv1.GetChatResponse, error) {
+	log := i.log.With(slog.String("op", sl.FnName()))
+
+	chat, err := i.service.GetChat(ctx, converter.ToGetChatInput(ctx, request))
+	if err != nil {
+		log.Error("failed to get chat", sl.ErrAttr(err))
+
+		return nil, err
+	}
+
+	return &chatv1.GetChatResponse{
+		Chat: converter.FromChat(chat),
+	}, nil
+}
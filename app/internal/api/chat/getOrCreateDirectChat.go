@@ -0,0 +1,23 @@
+package chat
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/defany/chat-server/app/internal/converter"
+	chatv1 "github.com/defany/chat-server/app/pkg/gen/chat/v1"
+	"github.com/defany/slogger/pkg/logger/sl"
+)
+
+func (i *Implementation) GetOrCreateDirectChat(ctx context.Context, request *chatv1.GetOrCreateDirectChatRequest) (*chatv1.GetOrCreateDirectChatResponse, error) {
+	log := i.log.With(slog.String("op", sl.FnName()))
+
+	output, err := i.service.GetOrCreateDirectChat(ctx, converter.ToGetOrCreateDirectChatInput(ctx, request))
+	if err != nil {
+		log.Error("failed to get or create direct chat", sl.ErrAttr(err))
+
+		return nil, err
+	}
+
+	return converter.FromGetOrCreateDirectChatOutput(output), nil
+}
@@ -0,0 +1,23 @@
+package chat
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/defany/chat-server/app/internal/converter"
+	chatv1 "github.com/defany/chat-server/app/pkg/gen/chat/v1"
+	"github.com/defany/slogger/pkg/logger/sl"
+)
+
+func (i *Implementation) GetPresence(ctx context.Context, request *chatv1.GetPresenceRequest) (*chatv1.GetPresenceResponse, error) {
+	log := i.log.With(slog.String("op", sl.FnName()))
+
+	presences, err := i.online.GetPresence(ctx, converter.ToGetPresenceInput(ctx, request))
+	if err != nil {
+		log.Error("failed to get presence", sl.ErrAttr(err))
+
+		return nil, err
+	}
+
+	return converter.FromUserPresences(presences), nil
+}
@@ -0,0 +1,24 @@
+package chat
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/defany/chat-server/app/internal/auth"
+	"github.com/defany/chat-server/app/internal/converter"
+	chatv1 "github.com/defany/chat-server/app/pkg/gen/chat/v1"
+	"github.com/defany/slogger/pkg/logger/sl"
+)
+
+func (i *Implementation) GetUnreadCounts(ctx context.Context, _ *chatv1.GetUnreadCountsRequest) (*chatv1.GetUnreadCountsResponse, error) {
+	log := i.log.With(slog.String("op", sl.FnName()))
+
+	counts, err := i.service.GetUnreadCounts(ctx, auth.UserID(ctx))
+	if err != nil {
+		log.Error("failed to get unread counts", sl.ErrAttr(err))
+
+		return nil, err
+	}
+
+	return converter.FromUnreadCounts(counts), nil
+}
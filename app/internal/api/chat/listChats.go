@@ -0,0 +1,23 @@
+package chat
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/defany/chat-server/app/internal/converter"
+	chatv1 "github.com/defany/chat-server/app/pkg/gen/chat/v1"
+	"github.com/defany/slogger/pkg/logger/sl"
+)
+
+func (i *Implementation) ListChats(ctx context.Context, request *chatv1.ListChatsRequest) (*chatv1.ListChatsResponse, error) {
+	log := i.log.With(slog.String("op", sl.FnName()))
+
+	output, err := i.service.ListChats(ctx, converter.ToListChatsInput(ctx, request))
+	if err != nil {
+		log.Error("failed to list chats", sl.ErrAttr(err))
+
+		return nil, err
+	}
+
+	return converter.FromListChatsOutput(output), nil
+}
@@ -0,0 +1,23 @@
+package chat
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/defany/chat-server/app/internal/converter"
+	chatv1 "github.com/defany/chat-server/app/pkg/gen/chat/v1"
+	"github.com/defany/slogger/pkg/logger/sl"
+)
+
+func (i *Implementation) ListMentions(ctx context.Context, request *chatv1.ListMentionsRequest) (*chatv1.ListMentionsResponse, error) {
+	log := i.log.With(slog.String("op", sl.FnName()))
+
+	output, err := i.service.ListMentions(ctx, converter.ToListMentionsInput(ctx, request))
+	if err != nil {
+		log.Error("failed to list mentions", sl.ErrAttr(err))
+
+		return nil, err
+	}
+
+	return converter.FromListMentionsOutput(output), nil
+}
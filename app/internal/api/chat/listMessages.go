@@ -0,0 +1,23 @@
+package chat
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/defany/chat-server/app/internal/converter"
+	chatv1 "github.com/defany/chat-server/app/pkg/gen/chat/v1"
+	"github.com/defany/slogger/pkg/logger/sl"
+)
+
+func (i *Implementation) ListMessages(ctx context.Context, request *chatv1.ListMessagesRequest) (*chatv1.ListMessagesResponse, error) {
+	log := i.log.With(slog.String("op", sl.FnName()))
+
+	output, err := i.service.ListMessages(ctx, converter.ToListMessagesInput(ctx, request))
+	if err != nil {
+		log.Error("failed to list messages", sl.ErrAttr(err))
+
+		return nil, err
+	}
+
+	return converter.FromListMessagesOutput(output), nil
+}
@@ -0,0 +1,23 @@
+package chat
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/defany/chat-server/app/internal/converter"
+	chatv1 "github.com/defany/chat-server/app/pkg/gen/chat/v1"
+	"github.com/defany/slogger/pkg/logger/sl"
+)
+
+func (i *Implementation) ListThread(ctx context.Context, request *chatv1.ListThreadRequest) (*chatv1.ListThreadResponse, error) {
+	log := i.log.With(slog.String("op", sl.FnName()))
+
+	output, err := i.service.ListThread(ctx, converter.ToListThreadInput(ctx, request))
+	if err != nil {
+		log.Error("failed to list thread", sl.ErrAttr(err))
+
+		return nil, err
+	}
+
+	return converter.FromListThreadOutput(output), nil
+}
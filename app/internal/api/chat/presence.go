@@ -0,0 +1,37 @@
+package chat
+
+import (
+	"log/slog"
+
+	"github.com/defany/chat-server/app/internal/auth"
+	"github.com/defany/chat-server/app/internal/converter"
+	"github.com/defany/chat-server/app/internal/model"
+	chatv1 "github.com/defany/chat-server/app/pkg/gen/chat/v1"
+	"github.com/defany/slogger/pkg/logger/sl"
+)
+
+func (i *Implementation) Presence(stream chatv1.Chat_PresenceServer) error {
+	log := i.log.With(slog.String("op", sl.FnName()))
+
+	ctx := stream.Context()
+
+	recv := func() (converter.PresenceInput, error) {
+		req, err := stream.Recv()
+		if err != nil {
+			return converter.PresenceInput{}, err
+		}
+
+		return converter.ToPresenceInput(req), nil
+	}
+
+	err := i.service.Presence(ctx, auth.UserID(ctx), recv, func(event model.PresenceEvent) error {
+		return stream.Send(converter.FromPresenceEvent(event))
+	})
+	if err != nil {
+		log.Error("failed to stream chat presence", sl.ErrAttr(err))
+
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,23 @@
+package chat
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/defany/chat-server/app/internal/converter"
+	chatv1 "github.com/defany/chat-server/app/pkg/gen/chat/v1"
+	"github.com/defany/slogger/pkg/logger/sl"
+)
+
+func (i *Implementation) SearchMessages(ctx context.Context, request *chatv1.SearchMessagesRequest) (*chatv1.SearchMessagesResponse, error) {
+	log := i.log.With(slog.String("op", sl.FnName()))
+
+	output, err := i.service.SearchMessages(ctx, converter.ToSearchMessagesInput(ctx, request))
+	if err != nil {
+		log.Error("failed to search messages", sl.ErrAttr(err))
+
+		return nil, err
+	}
+
+	return converter.FromSearchMessagesOutput(output), nil
+}
@@ -0,0 +1,195 @@
+package chattests
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"log/slog"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/defany/chat-server/app/internal/api/chat"
+	"github.com/defany/chat-server/app/internal/apperr"
+	"github.com/defany/chat-server/app/internal/auth"
+	"github.com/defany/chat-server/app/internal/converter"
+	"github.com/defany/chat-server/app/internal/model"
+	mockservicedef "github.com/defany/chat-server/app/internal/service/mocks"
+	chatv1 "github.com/defany/chat-server/app/pkg/gen/chat/v1"
+	"github.com/defany/slogger/pkg/logger/handlers/slogpretty"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+type uploadStream struct {
+	grpc.ServerStream
+
+	ctx      context.Context
+	requests []*chatv1.UploadAttachmentRequest
+	response *chatv1.UploadAttachmentResponse
+}
+
+func (s *uploadStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *uploadStream) Recv() (*chatv1.UploadAttachmentRequest, error) {
+	if len(s.requests) == 0 {
+		return nil, io.EOF
+	}
+
+	req := s.requests[0]
+	s.requests = s.requests[1:]
+
+	return req, nil
+}
+
+func (s *uploadStream) SendAndClose(res *chatv1.UploadAttachmentResponse) error {
+	s.response = res
+
+	return nil
+}
+
+type downloadStream struct {
+	grpc.ServerStream
+
+	ctx  context.Context
+	sent []*chatv1.DownloadAttachmentResponse
+}
+
+func (s *downloadStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *downloadStream) Send(res *chatv1.DownloadAttachmentResponse) error {
+	s.sent = append(s.sent, res)
+
+	return nil
+}
+
+func TestImplementation_UploadAttachment(t *testing.T) {
+	var (
+		userID = gofakeit.Uint64()
+
+		ctx = auth.InjectUserID(context.Background(), userID)
+
+		info = &chatv1.AttachmentInfo{
+			ChatId:   gofakeit.Int64(),
+			Filename: "photo.png",
+			MimeType: "image/png",
+		}
+
+		attachment = model.Attachment{
+			ID:       gofakeit.Uint64(),
+			ChatID:   info.ChatId,
+			UserID:   userID,
+			Filename: info.Filename,
+			MimeType: info.MimeType,
+			Size:     6,
+		}
+
+		chunk = func(b string) *chatv1.UploadAttachmentRequest {
+			return &chatv1.UploadAttachmentRequest{Payload: &chatv1.UploadAttachmentRequest_Chunk{Chunk: []byte(b)}}
+		}
+
+		infoRequest = &chatv1.UploadAttachmentRequest{Payload: &chatv1.UploadAttachmentRequest_Info{Info: info}}
+	)
+
+	tests := []struct {
+		name     string
+		requests []*chatv1.UploadAttachmentRequest
+		want     *chatv1.UploadAttachmentResponse
+		err      error
+		mocker   func(service *mockservicedef.MockAttachment)
+	}{
+		{
+			name:     "chunks are glued into one file",
+			requests: []*chatv1.UploadAttachmentRequest{infoRequest, chunk("foo"), chunk(""), chunk("bar")},
+			want:     converter.FromUploadAttachmentOutput(attachment),
+			mocker: func(service *mockservicedef.MockAttachment) {
+				service.On("Upload", ctx, converter.ToUploadAttachmentInput(ctx, info), mock.Anything).
+					Run(func(args mock.Arguments) {
+						body, err := io.ReadAll(args.Get(2).(io.Reader))
+						require.NoError(t, err)
+						require.Equal(t, []byte("foobar"), body)
+					}).
+					Return(attachment, nil)
+			},
+		},
+		{
+			name:     "file bytes before info",
+			requests: []*chatv1.UploadAttachmentRequest{chunk("foo")},
+			err: apperr.InvalidArgument("first message must carry attachment info", apperr.FieldViolation{
+				Field:       "info",
+				Description: "send attachment info before file bytes",
+			}),
+			mocker: func(service *mockservicedef.MockAttachment) {},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := mockservicedef.NewMockAttachment(t)
+
+			tt.mocker(service)
+
+			impl := chat.NewImplementation(slog.New(slogpretty.NewHandler()), nil, nil, service, nil)
+
+			stream := &uploadStream{
+				ctx:      ctx,
+				requests: tt.requests,
+			}
+
+			err := impl.UploadAttachment(stream)
+
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.want, stream.response)
+		})
+	}
+}
+
+func TestImplementation_DownloadAttachment(t *testing.T) {
+	var (
+		userID = gofakeit.Uint64()
+
+		ctx = auth.InjectUserID(context.Background(), userID)
+
+		req = &chatv1.DownloadAttachmentRequest{
+			AttachmentId: gofakeit.Int64(),
+		}
+
+		// Больше одного куска, чтобы проверить нарезку
+		content = bytes.Repeat([]byte("a"), 64<<10+10)
+
+		attachment = model.Attachment{
+			ID:       uint64(req.AttachmentId),
+			Filename: "report.pdf",
+			MimeType: "application/pdf",
+			Size:     int64(len(content)),
+		}
+	)
+
+	service := mockservicedef.NewMockAttachment(t)
+
+	service.On("Download", ctx, converter.ToDownloadAttachmentInput(ctx, req)).
+		Return(attachment, io.NopCloser(bytes.NewReader(content)), nil)
+
+	impl := chat.NewImplementation(slog.New(slogpretty.NewHandler()), nil, nil, service, nil)
+
+	stream := &downloadStream{
+		ctx: ctx,
+	}
+
+	err := impl.DownloadAttachment(req, stream)
+	require.NoError(t, err)
+
+	require.Len(t, stream.sent, 3)
+	require.Equal(t, converter.FromAttachment(attachment), stream.sent[0].GetInfo())
+
+	var got []byte
+	for _, res := range stream.sent[1:] {
+		got = append(got, res.GetChunk()...)
+	}
+
+	require.Equal(t, content, got)
+}
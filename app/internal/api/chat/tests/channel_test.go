@@ -0,0 +1,63 @@
+package chattests
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/defany/chat-server/app/internal/api/chat"
+	"github.com/defany/chat-server/app/internal/auth"
+	"github.com/defany/chat-server/app/internal/converter"
+	mockservicedef "github.com/defany/chat-server/app/internal/service/mocks"
+	chatv1 "github.com/defany/chat-server/app/pkg/gen/chat/v1"
+	"github.com/defany/slogger/pkg/logger/handlers/slogpretty"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func TestImplementation_Channel(t *testing.T) {
+	var (
+		userID = gofakeit.Uint64()
+
+		ctx = auth.InjectUserID(context.Background(), userID)
+
+		chatID = gofakeit.Int64()
+	)
+
+	service := mockservicedef.NewMockChat(t)
+
+	impl := chat.NewImplementation(slog.New(slogpretty.NewHandler()), service, nil, nil, nil)
+
+	t.Run("create channel", func(t *testing.T) {
+		req := &chatv1.CreateChannelRequest{
+			Title:       gofakeit.JobTitle(),
+			Description: gofakeit.Sentence(5),
+		}
+
+		service.On("CreateChannel", ctx, converter.CreateChannelInput{
+			Title:       req.Title,
+			Description: req.Description,
+			UserID:      userID,
+		}).Return(converter.CreateChatOutput{ID: uint64(chatID)}, nil)
+
+		res, err := impl.CreateChannel(ctx, req)
+
+		require.NoError(t, err)
+		require.Equal(t, &chatv1.CreateChannelResponse{Id: chatID}, res)
+	})
+
+	t.Run("subscribe", func(t *testing.T) {
+		service.On("Subscribe", ctx, converter.SubscribeInput{
+			ChatID: chatID,
+			UserID: userID,
+		}).Return(nil)
+
+		res, err := impl.Subscribe(ctx, &chatv1.SubscribeRequest{
+			ChatId: chatID,
+		})
+
+		require.NoError(t, err)
+		require.Equal(t, &emptypb.Empty{}, res)
+	})
+}
@@ -0,0 +1,117 @@
+package chattests
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/defany/chat-server/app/internal/api/chat"
+	"github.com/defany/chat-server/app/internal/auth"
+	"github.com/defany/chat-server/app/internal/converter"
+	"github.com/defany/chat-server/app/internal/model"
+	servicedef "github.com/defany/chat-server/app/internal/service"
+	mockservicedef "github.com/defany/chat-server/app/internal/service/mocks"
+	chatv1 "github.com/defany/chat-server/app/pkg/gen/chat/v1"
+	"github.com/defany/slogger/pkg/logger/handlers/slogpretty"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+type connectChatStream struct {
+	grpc.ServerStream
+
+	ctx  context.Context
+	sent []*chatv1.Message
+}
+
+func (s *connectChatStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *connectChatStream) Send(msg *chatv1.Message) error {
+	s.sent = append(s.sent, msg)
+
+	return nil
+}
+
+func TestImplementation_SuccessConnectChat(t *testing.T) {
+	type mocker struct {
+		service servicedef.Chat
+	}
+
+	type args struct {
+		ctx context.Context
+		req *chatv1.ConnectChatRequest
+	}
+
+	var (
+		userID = gofakeit.Uint64()
+
+		ctx = auth.InjectUserID(context.Background(), userID)
+
+		chatID = gofakeit.Int64()
+
+		req = &chatv1.ConnectChatRequest{
+			ChatId: chatID,
+		}
+
+		msg = model.Message{
+			ID:        gofakeit.Uint64(),
+			ChatID:    chatID,
+			UserID:    gofakeit.Uint64(),
+			Text:      gofakeit.AppName(),
+			Timestamp: gofakeit.Date(),
+		}
+	)
+
+	tests := []struct {
+		name   string
+		args   args
+		want   []*chatv1.Message
+		err    error
+		mocker func(tt args) mocker
+	}{
+		{
+			name: "success stream chat messages",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			want: []*chatv1.Message{converter.FromMessage(msg)},
+			err:  nil,
+			mocker: func(tt args) mocker {
+				service := mockservicedef.NewMockChat(t)
+
+				service.On("ConnectChat", tt.ctx, converter.ToConnectChatInput(tt.ctx, tt.req), mock.Anything).
+					Run(func(args mock.Arguments) {
+						send := args.Get(2).(func(msg model.Message) error)
+
+						require.NoError(t, send(msg))
+					}).
+					Return(nil)
+
+				return mocker{
+					service: service,
+				}
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mocker := tt.mocker(tt.args)
+
+			impl := chat.NewImplementation(slog.New(slogpretty.NewHandler()), mocker.service, nil, nil, nil)
+
+			stream := &connectChatStream{
+				ctx: tt.args.ctx,
+			}
+
+			err := impl.ConnectChat(tt.args.req, stream)
+
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.want, stream.sent)
+		})
+	}
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/brianvoe/gofakeit/v6"
 	"github.com/defany/chat-server/app/internal/api/chat"
+	"github.com/defany/chat-server/app/internal/auth"
 	"github.com/defany/chat-server/app/internal/converter"
 	servicedef "github.com/defany/chat-server/app/internal/service"
 	mockservicedef "github.com/defany/chat-server/app/internal/service/mocks"
@@ -26,11 +27,11 @@ func TestImplementation_SuccessCreate(t *testing.T) {
 	}
 
 	var (
-		ctx = context.Background()
+		userID = gofakeit.Uint64()
 
-		id = gofakeit.Int64()
+		ctx = auth.InjectUserID(context.Background(), userID)
 
-		userID = uint64(0)
+		id = gofakeit.Int64()
 
 		title     = gofakeit.BookTitle()
 		usernames = []string{gofakeit.BookTitle(), gofakeit.BookTitle(), gofakeit.BookTitle()}
@@ -67,7 +68,7 @@ func TestImplementation_SuccessCreate(t *testing.T) {
 			mocker: func(tt args) mocker {
 				service := mockservicedef.NewMockChat(t)
 
-				service.On("CreateChat", tt.ctx, converter.ToCreateChatInput(userID, tt.req)).Return(output, nil)
+				service.On("CreateChat", tt.ctx, converter.ToCreateChatInput(tt.ctx, tt.req)).Return(output, nil)
 
 				return mocker{
 					service: service,
@@ -79,7 +80,7 @@ func TestImplementation_SuccessCreate(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			mocker := tt.mocker(tt.args)
 
-			impl := chat.NewImplementation(slog.New(slogpretty.NewHandler()), mocker.service)
+			impl := chat.NewImplementation(slog.New(slogpretty.NewHandler()), mocker.service, nil, nil, nil)
 
 			res, err := impl.Create(ctx, tt.args.req)
 
@@ -7,6 +7,7 @@ import (
 
 	"github.com/brianvoe/gofakeit/v6"
 	"github.com/defany/chat-server/app/internal/api/chat"
+	"github.com/defany/chat-server/app/internal/auth"
 	"github.com/defany/chat-server/app/internal/converter"
 	servicedef "github.com/defany/chat-server/app/internal/service"
 	mockservicedef "github.com/defany/chat-server/app/internal/service/mocks"
@@ -27,11 +28,11 @@ func TestImplementation_SuccessDelete(t *testing.T) {
 	}
 
 	var (
-		ctx = context.Background()
+		userID = gofakeit.Uint64()
 
-		id = gofakeit.Int64()
+		ctx = auth.InjectUserID(context.Background(), userID)
 
-		userID = uint64(0)
+		id = gofakeit.Int64()
 
 		req = &chatv1.DeleteRequest{
 			Id: id,
@@ -58,7 +59,7 @@ func TestImplementation_SuccessDelete(t *testing.T) {
 			mocker: func(tt args) mocker {
 				service := mockservicedef.NewMockChat(t)
 
-				service.On("DeleteChat", tt.ctx, converter.ToDeleteChatInput(userID, tt.req)).Return(nil)
+				service.On("DeleteChat", tt.ctx, converter.ToDeleteChatInput(tt.ctx, tt.req)).Return(nil)
 
 				return mocker{
 					service: service,
@@ -70,7 +71,7 @@ func TestImplementation_SuccessDelete(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			mocker := tt.mocker(tt.args)
 
-			impl := chat.NewImplementation(slog.New(slogpretty.NewHandler()), mocker.service)
+			impl := chat.NewImplementation(slog.New(slogpretty.NewHandler()), mocker.service, nil, nil, nil)
 
 			res, err := impl.Delete(ctx, tt.args.req)
 
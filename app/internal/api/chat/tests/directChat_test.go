@@ -0,0 +1,58 @@
+package chattests
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/defany/chat-server/app/internal/api/chat"
+	"github.com/defany/chat-server/app/internal/auth"
+	"github.com/defany/chat-server/app/internal/converter"
+	"github.com/defany/chat-server/app/internal/model"
+	mockservicedef "github.com/defany/chat-server/app/internal/service/mocks"
+	chatv1 "github.com/defany/chat-server/app/pkg/gen/chat/v1"
+	"github.com/defany/slogger/pkg/logger/handlers/slogpretty"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestImplementation_GetOrCreateDirectChat(t *testing.T) {
+	var (
+		userID = gofakeit.Uint64()
+
+		ctx = auth.InjectUserID(context.Background(), userID)
+
+		req = &chatv1.GetOrCreateDirectChatRequest{
+			UserId: gofakeit.Int64(),
+		}
+
+		direct = model.Chat{
+			ID:            gofakeit.Int64(),
+			Kind:          model.ChatKindDirect,
+			Version:       1,
+			LastMessageAt: gofakeit.Date(),
+		}
+	)
+
+	service := mockservicedef.NewMockChat(t)
+	service.On("GetOrCreateDirectChat", ctx, converter.GetOrCreateDirectChatInput{
+		UserID: userID,
+		PeerID: uint64(req.UserId),
+	}).Return(converter.GetOrCreateDirectChatOutput{Chat: direct, Created: true}, nil)
+
+	impl := chat.NewImplementation(slog.New(slogpretty.NewHandler()), service, nil, nil, nil)
+
+	res, err := impl.GetOrCreateDirectChat(ctx, req)
+
+	require.NoError(t, err)
+	require.Equal(t, &chatv1.GetOrCreateDirectChatResponse{
+		Chat: &chatv1.ChatInfo{
+			Id:            direct.ID,
+			Version:       1,
+			LastMessageAt: timestamppb.New(direct.LastMessageAt),
+			Kind:          chatv1.ChatKind_CHAT_KIND_DIRECT,
+		},
+		Created: true,
+	}, res)
+}
@@ -0,0 +1,78 @@
+package chattests
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/defany/chat-server/app/internal/api/chat"
+	"github.com/defany/chat-server/app/internal/apperr"
+	"github.com/defany/chat-server/app/internal/auth"
+	"github.com/defany/chat-server/app/internal/converter"
+	"github.com/defany/chat-server/app/internal/model"
+	mockservicedef "github.com/defany/chat-server/app/internal/service/mocks"
+	chatv1 "github.com/defany/chat-server/app/pkg/gen/chat/v1"
+	"github.com/defany/slogger/pkg/logger/handlers/slogpretty"
+	"github.com/stretchr/testify/require"
+)
+
+func TestImplementation_EditMessage(t *testing.T) {
+	var (
+		userID = gofakeit.Uint64()
+
+		ctx = auth.InjectUserID(context.Background(), userID)
+
+		req = &chatv1.EditMessageRequest{
+			ChatId:    gofakeit.Int64(),
+			MessageId: gofakeit.Int64(),
+			Text:      gofakeit.AppName(),
+		}
+
+		editedAt = time.Now()
+
+		msg = model.Message{
+			ID:        uint64(req.MessageId),
+			ChatID:    req.ChatId,
+			UserID:    userID,
+			Text:      req.Text,
+			Timestamp: gofakeit.Date(),
+			EditedAt:  &editedAt,
+		}
+
+		errNotAuthor = apperr.PermissionDenied("only author can change message")
+	)
+
+	tests := []struct {
+		name string
+		msg  model.Message
+		err  error
+		want *chatv1.EditMessageResponse
+	}{
+		{
+			name: "success edit message",
+			msg:  msg,
+			want: converter.FromEditMessageOutput(msg),
+		},
+		{
+			name: "service error is returned as is",
+			err:  errNotAuthor,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := mockservicedef.NewMockChat(t)
+
+			service.On("EditMessage", ctx, converter.ToEditMessageInput(ctx, req)).Return(tt.msg, tt.err)
+
+			impl := chat.NewImplementation(slog.New(slogpretty.NewHandler()), service, nil, nil, nil)
+
+			res, err := impl.EditMessage(ctx, req)
+
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.want, res)
+		})
+	}
+}
@@ -0,0 +1,59 @@
+package chattests
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/defany/chat-server/app/internal/api/chat"
+	"github.com/defany/chat-server/app/internal/auth"
+	"github.com/defany/chat-server/app/internal/converter"
+	"github.com/defany/chat-server/app/internal/model"
+	mockservicedef "github.com/defany/chat-server/app/internal/service/mocks"
+	chatv1 "github.com/defany/chat-server/app/pkg/gen/chat/v1"
+	"github.com/defany/slogger/pkg/logger/handlers/slogpretty"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestImplementation_GetPresence(t *testing.T) {
+	var (
+		userID = gofakeit.Uint64()
+
+		ctx = auth.InjectUserID(context.Background(), userID)
+
+		onlineID  = gofakeit.Int64()
+		offlineID = gofakeit.Int64()
+
+		lastSeen = time.Now().Add(-5 * time.Minute)
+
+		presences = []model.UserPresence{
+			{UserID: uint64(onlineID), Online: true},
+			{UserID: uint64(offlineID), LastSeenAt: &lastSeen},
+		}
+
+		want = &chatv1.GetPresenceResponse{
+			Presences: []*chatv1.UserPresence{
+				{UserId: onlineID, Online: true},
+				{UserId: offlineID, LastSeenAt: timestamppb.New(lastSeen)},
+			},
+		}
+	)
+
+	online := mockservicedef.NewMockOnline(t)
+	online.On("GetPresence", ctx, converter.GetPresenceInput{
+		UserID:  userID,
+		UserIDs: []uint64{uint64(onlineID), uint64(offlineID)},
+	}).Return(presences, nil)
+
+	impl := chat.NewImplementation(slog.New(slogpretty.NewHandler()), nil, nil, nil, online)
+
+	res, err := impl.GetPresence(ctx, &chatv1.GetPresenceRequest{
+		UserIds: []int64{onlineID, offlineID},
+	})
+
+	require.NoError(t, err)
+	require.Equal(t, want, res)
+}
@@ -0,0 +1,48 @@
+package chattests
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/defany/chat-server/app/internal/api/chat"
+	"github.com/defany/chat-server/app/internal/auth"
+	"github.com/defany/chat-server/app/internal/model"
+	mockservicedef "github.com/defany/chat-server/app/internal/service/mocks"
+	chatv1 "github.com/defany/chat-server/app/pkg/gen/chat/v1"
+	"github.com/defany/slogger/pkg/logger/handlers/slogpretty"
+	"github.com/stretchr/testify/require"
+)
+
+func TestImplementation_GetUnreadCounts(t *testing.T) {
+	var (
+		userID = gofakeit.Uint64()
+
+		ctx = auth.InjectUserID(context.Background(), userID)
+
+		counts = []model.UnreadCount{
+			{ChatID: gofakeit.Int64(), Count: 5, LastReadMessageID: gofakeit.Uint64()},
+		}
+
+		want = &chatv1.GetUnreadCountsResponse{
+			Counts: []*chatv1.ChatUnreadCount{
+				{
+					ChatId:            counts[0].ChatID,
+					UnreadCount:       5,
+					LastReadMessageId: int64(counts[0].LastReadMessageID),
+				},
+			},
+		}
+	)
+
+	service := mockservicedef.NewMockChat(t)
+	service.On("GetUnreadCounts", ctx, userID).Return(counts, nil)
+
+	impl := chat.NewImplementation(slog.New(slogpretty.NewHandler()), service, nil, nil, nil)
+
+	res, err := impl.GetUnreadCounts(ctx, &chatv1.GetUnreadCountsRequest{})
+
+	require.NoError(t, err)
+	require.Equal(t, want, res)
+}
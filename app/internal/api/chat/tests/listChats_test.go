@@ -0,0 +1,92 @@
+package chattests
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/defany/chat-server/app/internal/api/chat"
+	"github.com/defany/chat-server/app/internal/auth"
+	"github.com/defany/chat-server/app/internal/converter"
+	"github.com/defany/chat-server/app/internal/model"
+	mockservicedef "github.com/defany/chat-server/app/internal/service/mocks"
+	chatv1 "github.com/defany/chat-server/app/pkg/gen/chat/v1"
+	"github.com/defany/slogger/pkg/logger/handlers/slogpretty"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestImplementation_ListChats(t *testing.T) {
+	var (
+		userID = gofakeit.Uint64()
+
+		ctx = auth.InjectUserID(context.Background(), userID)
+
+		req = &chatv1.ListChatsRequest{
+			Cursor: gofakeit.UUID(),
+			Limit:  10,
+		}
+
+		lastMessage = model.Message{
+			ID:        gofakeit.Uint64(),
+			UserID:    gofakeit.Uint64(),
+			Text:      gofakeit.JobTitle(),
+			Timestamp: gofakeit.Date(),
+		}
+
+		output = converter.ListChatsOutput{
+			Chats: []model.ChatSummary{
+				{
+					ID:            gofakeit.Int64(),
+					Title:         gofakeit.AppName(),
+					MemberCount:   3,
+					UnreadCount:   1,
+					LastMessageAt: lastMessage.Timestamp,
+					LastMessage:   &lastMessage,
+				},
+				{
+					ID:            gofakeit.Int64(),
+					Title:         gofakeit.AppName(),
+					MemberCount:   1,
+					LastMessageAt: gofakeit.Date(),
+				},
+			},
+			NextCursor: gofakeit.UUID(),
+		}
+
+		want = &chatv1.ListChatsResponse{
+			Chats: []*chatv1.ChatSummary{
+				{
+					Id:            output.Chats[0].ID,
+					Title:         output.Chats[0].Title,
+					MemberCount:   3,
+					UnreadCount:   1,
+					LastMessageAt: timestamppb.New(lastMessage.Timestamp),
+					LastMessage:   converter.FromMessage(lastMessage),
+				},
+				{
+					Id:            output.Chats[1].ID,
+					Title:         output.Chats[1].Title,
+					MemberCount:   1,
+					LastMessageAt: timestamppb.New(output.Chats[1].LastMessageAt),
+				},
+			},
+			NextCursor: output.NextCursor,
+		}
+	)
+
+	service := mockservicedef.NewMockChat(t)
+	service.On("ListChats", ctx, converter.ListChatsInput{
+		UserID: userID,
+		Cursor: req.Cursor,
+		Limit:  req.Limit,
+	}).Return(output, nil)
+
+	impl := chat.NewImplementation(slog.New(slogpretty.NewHandler()), service, nil, nil, nil)
+
+	res, err := impl.ListChats(ctx, req)
+
+	require.NoError(t, err)
+	require.Equal(t, want, res)
+}
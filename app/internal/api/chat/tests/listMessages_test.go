@@ -0,0 +1,95 @@
+package chattests
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/defany/chat-server/app/internal/api/chat"
+	"github.com/defany/chat-server/app/internal/auth"
+	"github.com/defany/chat-server/app/internal/converter"
+	"github.com/defany/chat-server/app/internal/model"
+	servicedef "github.com/defany/chat-server/app/internal/service"
+	mockservicedef "github.com/defany/chat-server/app/internal/service/mocks"
+	chatv1 "github.com/defany/chat-server/app/pkg/gen/chat/v1"
+	"github.com/defany/slogger/pkg/logger/handlers/slogpretty"
+	"github.com/stretchr/testify/require"
+)
+
+func TestImplementation_SuccessListMessages(t *testing.T) {
+	type mocker struct {
+		service servicedef.Chat
+	}
+
+	type args struct {
+		ctx context.Context
+		req *chatv1.ListMessagesRequest
+	}
+
+	var (
+		userID = gofakeit.Uint64()
+
+		ctx = auth.InjectUserID(context.Background(), userID)
+
+		chatID = gofakeit.Int64()
+
+		req = &chatv1.ListMessagesRequest{
+			ChatId:    chatID,
+			Limit:     gofakeit.Int32(),
+			Direction: chatv1.ListDirection_LIST_DIRECTION_FORWARD,
+		}
+
+		output = converter.ListMessagesOutput{
+			Messages: []model.Message{
+				{
+					ID:        gofakeit.Uint64(),
+					ChatID:    chatID,
+					UserID:    gofakeit.Uint64(),
+					Text:      gofakeit.AppName(),
+					Timestamp: gofakeit.Date(),
+				},
+			},
+			NextCursor: gofakeit.UUID(),
+		}
+	)
+
+	tests := []struct {
+		name   string
+		args   args
+		want   *chatv1.ListMessagesResponse
+		err    error
+		mocker func(tt args) mocker
+	}{
+		{
+			name: "success list messages",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			want: converter.FromListMessagesOutput(output),
+			err:  nil,
+			mocker: func(tt args) mocker {
+				service := mockservicedef.NewMockChat(t)
+
+				service.On("ListMessages", tt.ctx, converter.ToListMessagesInput(tt.ctx, tt.req)).Return(output, nil)
+
+				return mocker{
+					service: service,
+				}
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mocker := tt.mocker(tt.args)
+
+			impl := chat.NewImplementation(slog.New(slogpretty.NewHandler()), mocker.service, nil, nil, nil)
+
+			res, err := impl.ListMessages(tt.args.ctx, tt.args.req)
+
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.want, res)
+		})
+	}
+}
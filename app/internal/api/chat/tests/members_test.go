@@ -0,0 +1,101 @@
+package chattests
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/defany/chat-server/app/internal/api/chat"
+	"github.com/defany/chat-server/app/internal/auth"
+	"github.com/defany/chat-server/app/internal/converter"
+	mockservicedef "github.com/defany/chat-server/app/internal/service/mocks"
+	chatv1 "github.com/defany/chat-server/app/pkg/gen/chat/v1"
+	"github.com/defany/slogger/pkg/logger/handlers/slogpretty"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func TestImplementation_Members(t *testing.T) {
+	var (
+		userID = gofakeit.Uint64()
+
+		ctx = auth.InjectUserID(context.Background(), userID)
+
+		chatID = gofakeit.Int64()
+	)
+
+	service := mockservicedef.NewMockChat(t)
+
+	impl := chat.NewImplementation(slog.New(slogpretty.NewHandler()), service, nil, nil, nil)
+
+	t.Run("add members", func(t *testing.T) {
+		req := &chatv1.AddMembersRequest{
+			ChatId:    chatID,
+			Usernames: []string{gofakeit.Username()},
+		}
+
+		service.On("AddMembers", ctx, converter.AddMembersInput{
+			ChatID:    chatID,
+			UserID:    userID,
+			Nicknames: req.Usernames,
+		}).Return(nil)
+
+		res, err := impl.AddMembers(ctx, req)
+
+		require.NoError(t, err)
+		require.Equal(t, &emptypb.Empty{}, res)
+	})
+
+	t.Run("remove member", func(t *testing.T) {
+		memberID := gofakeit.Int64()
+
+		service.On("RemoveMember", ctx, converter.RemoveMemberInput{
+			ChatID:   chatID,
+			UserID:   userID,
+			MemberID: uint64(memberID),
+		}).Return(nil)
+
+		res, err := impl.RemoveMember(ctx, &chatv1.RemoveMemberRequest{
+			ChatId: chatID,
+			UserId: memberID,
+		})
+
+		require.NoError(t, err)
+		require.Equal(t, &emptypb.Empty{}, res)
+	})
+
+	t.Run("set member admin", func(t *testing.T) {
+		memberID := gofakeit.Int64()
+
+		service.On("SetMemberAdmin", ctx, converter.SetMemberAdminInput{
+			ChatID:   chatID,
+			UserID:   userID,
+			MemberID: uint64(memberID),
+			Admin:    true,
+		}).Return(nil)
+
+		res, err := impl.SetMemberAdmin(ctx, &chatv1.SetMemberAdminRequest{
+			ChatId: chatID,
+			UserId: memberID,
+			Admin:  true,
+		})
+
+		require.NoError(t, err)
+		require.Equal(t, &emptypb.Empty{}, res)
+	})
+
+	t.Run("leave chat", func(t *testing.T) {
+		service.On("LeaveChat", ctx, converter.LeaveChatInput{
+			ChatID: chatID,
+			UserID: userID,
+		}).Return(nil)
+
+		res, err := impl.LeaveChat(ctx, &chatv1.LeaveChatRequest{
+			ChatId: chatID,
+		})
+
+		require.NoError(t, err)
+		require.Equal(t, &emptypb.Empty{}, res)
+	})
+}
@@ -0,0 +1,124 @@
+package chattests
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/defany/chat-server/app/internal/api/chat"
+	"github.com/defany/chat-server/app/internal/auth"
+	"github.com/defany/chat-server/app/internal/converter"
+	"github.com/defany/chat-server/app/internal/model"
+	mockservicedef "github.com/defany/chat-server/app/internal/service/mocks"
+	chatv1 "github.com/defany/chat-server/app/pkg/gen/chat/v1"
+	"github.com/defany/slogger/pkg/logger/handlers/slogpretty"
+	"github.com/stretchr/testify/require"
+)
+
+func TestImplementation_SendMessageWithContent(t *testing.T) {
+	var (
+		userID = gofakeit.Uint64()
+		peerID = gofakeit.Uint64()
+
+		ctx = auth.InjectUserID(context.Background(), userID)
+
+		req = &chatv1.SendMessageRequest{
+			ChatId: gofakeit.Int64(),
+			Content: &chatv1.MessageContent{
+				Text: "hey bob, see https://example.com",
+				Entities: []*chatv1.MessageEntity{
+					{Type: chatv1.MessageEntityType_MESSAGE_ENTITY_TYPE_BOLD, Offset: 0, Length: 3},
+					{Type: chatv1.MessageEntityType_MESSAGE_ENTITY_TYPE_MENTION, Offset: 4, Length: 3, UserId: int64(peerID)},
+				},
+			},
+		}
+
+		input = converter.SendMessageInput{
+			ChatID: req.ChatId,
+			From:   userID,
+			Text:   req.Content.Text,
+			Entities: []model.MessageEntity{
+				{Type: model.EntityBold, Offset: 0, Length: 3},
+				{Type: model.EntityMention, Offset: 4, Length: 3, UserID: peerID},
+			},
+		}
+
+		msg = model.Message{
+			ID:     gofakeit.Uint64(),
+			ChatID: req.ChatId,
+			UserID: userID,
+			Text:   input.Text,
+			Entities: append(input.Entities, model.MessageEntity{
+				Type:   model.EntityLink,
+				Offset: 13,
+				Length: 19,
+				URL:    "https://example.com",
+			}),
+		}
+	)
+
+	service := mockservicedef.NewMockChat(t)
+	service.On("SendMessage", ctx, input).Return(msg, nil)
+
+	impl := chat.NewImplementation(slog.New(slogpretty.NewHandler()), service, nil, nil, nil)
+
+	res, err := impl.SendMessage(ctx, req)
+
+	require.NoError(t, err)
+	require.Equal(t, msg.Text, res.GetMessage().GetText())
+	require.Equal(t, &chatv1.MessageContent{
+		Text: msg.Text,
+		Entities: []*chatv1.MessageEntity{
+			{Type: chatv1.MessageEntityType_MESSAGE_ENTITY_TYPE_BOLD, Offset: 0, Length: 3},
+			{Type: chatv1.MessageEntityType_MESSAGE_ENTITY_TYPE_MENTION, Offset: 4, Length: 3, UserId: int64(peerID)},
+			{Type: chatv1.MessageEntityType_MESSAGE_ENTITY_TYPE_LINK, Offset: 13, Length: 19, Url: "https://example.com"},
+		},
+	}, res.GetMessage().GetContent())
+}
+
+func TestImplementation_ListMentions(t *testing.T) {
+	var (
+		userID = gofakeit.Uint64()
+
+		ctx = auth.InjectUserID(context.Background(), userID)
+
+		req = &chatv1.ListMentionsRequest{
+			Cursor: gofakeit.UUID(),
+			Limit:  10,
+		}
+
+		output = converter.ListMentionsOutput{
+			Messages: []model.Message{
+				{
+					ID:        gofakeit.Uint64(),
+					ChatID:    gofakeit.Int64(),
+					UserID:    gofakeit.Uint64(),
+					Text:      "bob, look",
+					Timestamp: gofakeit.Date(),
+					Entities: []model.MessageEntity{
+						{Type: model.EntityMention, Offset: 0, Length: 3, UserID: userID},
+					},
+				},
+			},
+			NextCursor: gofakeit.UUID(),
+		}
+	)
+
+	service := mockservicedef.NewMockChat(t)
+	service.On("ListMentions", ctx, converter.ListMentionsInput{
+		UserID: userID,
+		Cursor: req.Cursor,
+		Limit:  req.Limit,
+	}).Return(output, nil)
+
+	impl := chat.NewImplementation(slog.New(slogpretty.NewHandler()), service, nil, nil, nil)
+
+	res, err := impl.ListMentions(ctx, req)
+
+	require.NoError(t, err)
+	require.Equal(t, &chatv1.ListMentionsResponse{
+		Messages:   []*chatv1.Message{converter.FromMessage(output.Messages[0])},
+		NextCursor: output.NextCursor,
+	}, res)
+}
@@ -0,0 +1,139 @@
+package chattests
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/defany/chat-server/app/internal/api/chat"
+	"github.com/defany/chat-server/app/internal/auth"
+	"github.com/defany/chat-server/app/internal/converter"
+	"github.com/defany/chat-server/app/internal/model"
+	servicedef "github.com/defany/chat-server/app/internal/service"
+	mockservicedef "github.com/defany/chat-server/app/internal/service/mocks"
+	chatv1 "github.com/defany/chat-server/app/pkg/gen/chat/v1"
+	"github.com/defany/slogger/pkg/logger/handlers/slogpretty"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+type presenceStream struct {
+	grpc.ServerStream
+
+	ctx      context.Context
+	requests []*chatv1.PresenceRequest
+	sent     []*chatv1.PresenceEvent
+}
+
+func (s *presenceStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *presenceStream) Recv() (*chatv1.PresenceRequest, error) {
+	if len(s.requests) == 0 {
+		return nil, io.EOF
+	}
+
+	req := s.requests[0]
+	s.requests = s.requests[1:]
+
+	return req, nil
+}
+
+func (s *presenceStream) Send(event *chatv1.PresenceEvent) error {
+	s.sent = append(s.sent, event)
+
+	return nil
+}
+
+func TestImplementation_SuccessPresence(t *testing.T) {
+	type mocker struct {
+		service servicedef.Chat
+	}
+
+	type args struct {
+		ctx      context.Context
+		requests []*chatv1.PresenceRequest
+	}
+
+	var (
+		userID = gofakeit.Uint64()
+
+		ctx = auth.InjectUserID(context.Background(), userID)
+
+		chatID = gofakeit.Int64()
+
+		req = &chatv1.PresenceRequest{
+			ChatId: chatID,
+			Action: chatv1.PresenceAction_PRESENCE_ACTION_TYPING_START,
+		}
+
+		event = model.PresenceEvent{
+			ChatID: chatID,
+			UserID: gofakeit.Uint64(),
+			Online: true,
+			Typing: true,
+		}
+	)
+
+	tests := []struct {
+		name   string
+		args   args
+		want   []*chatv1.PresenceEvent
+		err    error
+		mocker func(tt args) mocker
+	}{
+		{
+			name: "success stream chat presence",
+			args: args{
+				ctx:      ctx,
+				requests: []*chatv1.PresenceRequest{req},
+			},
+			want: []*chatv1.PresenceEvent{converter.FromPresenceEvent(event)},
+			err:  nil,
+			mocker: func(tt args) mocker {
+				service := mockservicedef.NewMockChat(t)
+
+				service.On("Presence", tt.ctx, userID, mock.Anything, mock.Anything).
+					Run(func(args mock.Arguments) {
+						recv := args.Get(2).(func() (converter.PresenceInput, error))
+						send := args.Get(3).(func(event model.PresenceEvent) error)
+
+						input, err := recv()
+						require.NoError(t, err)
+						require.Equal(t, converter.PresenceInput{ChatID: chatID, Action: model.PresenceActionTypingStart}, input)
+
+						_, err = recv()
+						require.ErrorIs(t, err, io.EOF)
+
+						require.NoError(t, send(event))
+					}).
+					Return(nil)
+
+				return mocker{
+					service: service,
+				}
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mocker := tt.mocker(tt.args)
+
+			impl := chat.NewImplementation(slog.New(slogpretty.NewHandler()), mocker.service, nil, nil, nil)
+
+			stream := &presenceStream{
+				ctx:      tt.args.ctx,
+				requests: tt.args.requests,
+			}
+
+			err := impl.Presence(stream)
+
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.want, stream.sent)
+		})
+	}
+}
@@ -0,0 +1,52 @@
+package chattests
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/defany/chat-server/app/internal/api/chat"
+	"github.com/defany/chat-server/app/internal/auth"
+	"github.com/defany/chat-server/app/internal/converter"
+	mockservicedef "github.com/defany/chat-server/app/internal/service/mocks"
+	chatv1 "github.com/defany/chat-server/app/pkg/gen/chat/v1"
+	"github.com/defany/slogger/pkg/logger/handlers/slogpretty"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func TestImplementation_Reactions(t *testing.T) {
+	var (
+		ctx = auth.InjectUserID(context.Background(), gofakeit.Uint64())
+
+		addReq = &chatv1.AddReactionRequest{
+			ChatId:    gofakeit.Int64(),
+			MessageId: gofakeit.Int64(),
+			Emoji:     "👍",
+		}
+
+		removeReq = &chatv1.RemoveReactionRequest{
+			ChatId:    addReq.ChatId,
+			MessageId: addReq.MessageId,
+			Emoji:     addReq.Emoji,
+		}
+	)
+
+	reactions := mockservicedef.NewMockReaction(t)
+
+	reactions.On("AddReaction", ctx, converter.ToAddReactionInput(ctx, addReq)).Return(nil)
+	reactions.On("RemoveReaction", ctx, converter.ToRemoveReactionInput(ctx, removeReq)).Return(nil)
+
+	impl := chat.NewImplementation(slog.New(slogpretty.NewHandler()), nil, reactions, nil, nil)
+
+	res, err := impl.AddReaction(ctx, addReq)
+
+	require.NoError(t, err)
+	require.Equal(t, &emptypb.Empty{}, res)
+
+	res, err = impl.RemoveReaction(ctx, removeReq)
+
+	require.NoError(t, err)
+	require.Equal(t, &emptypb.Empty{}, res)
+}
@@ -0,0 +1,75 @@
+package chattests
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/defany/chat-server/app/internal/api/chat"
+	"github.com/defany/chat-server/app/internal/auth"
+	"github.com/defany/chat-server/app/internal/converter"
+	"github.com/defany/chat-server/app/internal/model"
+	mockservicedef "github.com/defany/chat-server/app/internal/service/mocks"
+	chatv1 "github.com/defany/chat-server/app/pkg/gen/chat/v1"
+	"github.com/defany/slogger/pkg/logger/handlers/slogpretty"
+	"github.com/stretchr/testify/require"
+)
+
+func TestImplementation_SearchMessages(t *testing.T) {
+	var (
+		userID = gofakeit.Uint64()
+
+		ctx = auth.InjectUserID(context.Background(), userID)
+
+		req = &chatv1.SearchMessagesRequest{
+			Query:  "release",
+			ChatId: gofakeit.Int64(),
+			Cursor: gofakeit.UUID(),
+			Limit:  10,
+		}
+
+		msg = model.Message{
+			ID:        gofakeit.Uint64(),
+			ChatID:    req.ChatId,
+			UserID:    gofakeit.Uint64(),
+			Text:      "release is tomorrow",
+			Timestamp: gofakeit.Date(),
+		}
+
+		output = converter.SearchMessagesOutput{
+			Hits: []model.SearchHit{
+				{
+					Message: msg,
+					Snippet: "<b>release</b> is tomorrow",
+					Rank:    0.06,
+				},
+			},
+			NextCursor: gofakeit.UUID(),
+		}
+	)
+
+	service := mockservicedef.NewMockChat(t)
+	service.On("SearchMessages", ctx, converter.SearchMessagesInput{
+		UserID: userID,
+		ChatID: req.ChatId,
+		Query:  req.Query,
+		Cursor: req.Cursor,
+		Limit:  req.Limit,
+	}).Return(output, nil)
+
+	impl := chat.NewImplementation(slog.New(slogpretty.NewHandler()), service, nil, nil, nil)
+
+	res, err := impl.SearchMessages(ctx, req)
+
+	require.NoError(t, err)
+	require.Equal(t, &chatv1.SearchMessagesResponse{
+		Hits: []*chatv1.SearchHit{
+			{
+				Message: converter.FromMessage(msg),
+				Snippet: output.Hits[0].Snippet,
+			},
+		},
+		NextCursor: output.NextCursor,
+	}, res)
+}
@@ -7,13 +7,14 @@ import (
 
 	"github.com/brianvoe/gofakeit/v6"
 	"github.com/defany/chat-server/app/internal/api/chat"
+	"github.com/defany/chat-server/app/internal/auth"
 	"github.com/defany/chat-server/app/internal/converter"
+	"github.com/defany/chat-server/app/internal/model"
 	servicedef "github.com/defany/chat-server/app/internal/service"
 	mockservicedef "github.com/defany/chat-server/app/internal/service/mocks"
 	chatv1 "github.com/defany/chat-server/app/pkg/gen/chat/v1"
 	"github.com/defany/slogger/pkg/logger/handlers/slogpretty"
 	"github.com/stretchr/testify/require"
-	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -28,28 +29,36 @@ func TestImplementation_SuccessSendMessage(t *testing.T) {
 	}
 
 	var (
-		ctx = context.Background()
+		userID = gofakeit.Uint64()
+
+		ctx = auth.InjectUserID(context.Background(), userID)
 
 		id = gofakeit.Int64()
 
-		fromID    = gofakeit.Int64()
 		text      = gofakeit.AppName()
 		timestamp = gofakeit.Date()
 
 		req = &chatv1.SendMessageRequest{
 			ChatId:    id,
-			From:      fromID,
 			Text:      text,
 			Timestamp: timestamppb.New(timestamp),
 		}
 
-		res = &emptypb.Empty{}
+		msg = model.Message{
+			ID:        gofakeit.Uint64(),
+			ChatID:    id,
+			UserID:    userID,
+			Text:      text,
+			Timestamp: gofakeit.Date(),
+		}
+
+		res = converter.FromSendMessageOutput(msg)
 	)
 
 	tests := []struct {
 		name   string
 		args   args
-		want   *emptypb.Empty
+		want   *chatv1.SendMessageResponse
 		err    error
 		mocker func(tt args) mocker
 	}{
@@ -64,7 +73,7 @@ func TestImplementation_SuccessSendMessage(t *testing.T) {
 			mocker: func(tt args) mocker {
 				service := mockservicedef.NewMockChat(t)
 
-				service.On("SendMessage", tt.ctx, converter.ToSendMessageInput(tt.req)).Return(nil)
+				service.On("SendMessage", tt.ctx, converter.ToSendMessageInput(tt.ctx, tt.req)).Return(msg, nil)
 
 				return mocker{
 					service: service,
@@ -76,7 +85,7 @@ func TestImplementation_SuccessSendMessage(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			mocker := tt.mocker(tt.args)
 
-			impl := chat.NewImplementation(slog.New(slogpretty.NewHandler()), mocker.service)
+			impl := chat.NewImplementation(slog.New(slogpretty.NewHandler()), mocker.service, nil, nil, nil)
 
 			res, err := impl.SendMessage(ctx, tt.args.req)
 
@@ -0,0 +1,64 @@
+package chattests
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/defany/chat-server/app/internal/api/chat"
+	"github.com/defany/chat-server/app/internal/auth"
+	"github.com/defany/chat-server/app/internal/converter"
+	"github.com/defany/chat-server/app/internal/model"
+	mockservicedef "github.com/defany/chat-server/app/internal/service/mocks"
+	chatv1 "github.com/defany/chat-server/app/pkg/gen/chat/v1"
+	"github.com/defany/slogger/pkg/logger/handlers/slogpretty"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestImplementation_UpdateChat(t *testing.T) {
+	var (
+		userID = gofakeit.Uint64()
+
+		ctx = auth.InjectUserID(context.Background(), userID)
+
+		req = &chatv1.UpdateChatRequest{
+			ChatId:      gofakeit.Int64(),
+			Description: proto.String(gofakeit.Sentence(5)),
+			Version:     2,
+		}
+
+		updated = model.Chat{
+			ID:            req.ChatId,
+			Title:         gofakeit.JobTitle(),
+			Description:   req.GetDescription(),
+			Version:       3,
+			LastMessageAt: gofakeit.Date(),
+		}
+	)
+
+	service := mockservicedef.NewMockChat(t)
+	service.On("UpdateChat", ctx, converter.UpdateChatInput{
+		ChatID:      req.ChatId,
+		UserID:      userID,
+		Description: req.Description,
+		Version:     2,
+	}).Return(updated, nil)
+
+	impl := chat.NewImplementation(slog.New(slogpretty.NewHandler()), service, nil, nil, nil)
+
+	res, err := impl.UpdateChat(ctx, req)
+
+	require.NoError(t, err)
+	require.Equal(t, &chatv1.UpdateChatResponse{
+		Chat: &chatv1.ChatInfo{
+			Id:            updated.ID,
+			Title:         updated.Title,
+			Description:   updated.Description,
+			Version:       3,
+			LastMessageAt: timestamppb.New(updated.LastMessageAt),
+		},
+	}, res)
+}
@@ -0,0 +1,25 @@
+package chat
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/defany/chat-server/app/internal/converter"
+	chatv1 "github.com/defany/chat-server/app/pkg/gen/chat/v1"
+	"github.com/defany/slogger/pkg/logger/sl"
+)
+
+func (i *Implementation) UpdateChat(ctx context.Context, request *chatv1.UpdateChatRequest) (*chatv1.UpdateChatResponse, error) {
+	log := i.log.With(slog.String("op", sl.FnName()))
+
+	chat, err := i.service.UpdateChat(ctx, converter.ToUpdateChatInput(ctx, request))
+	if err != nil {
+		log.Error("failed to update chat", sl.ErrAttr(err))
+
+		return nil, err
+	}
+
+	return &chatv1.UpdateChatResponse{
+		Chat: converter.FromChat(chat),
+	}, nil
+}
@@ -0,0 +1,67 @@
+package chat
+
+import (
+	"log/slog"
+
+	"github.com/defany/chat-server/app/internal/apperr"
+	"github.com/defany/chat-server/app/internal/converter"
+	chatv1 "github.com/defany/chat-server/app/pkg/gen/chat/v1"
+	"github.com/defany/slogger/pkg/logger/sl"
+)
+
+func (i *Implementation) UploadAttachment(stream chatv1.Chat_UploadAttachmentServer) error {
+	log := i.log.With(slog.String("op", sl.FnName()))
+
+	ctx := stream.Context()
+
+	req, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+
+	info := req.GetInfo()
+	if info == nil {
+		return apperr.InvalidArgument("first message must carry attachment info", apperr.FieldViolation{
+			Field:       "info",
+			Description: "send attachment info before file bytes",
+		})
+	}
+
+	attachment, err := i.attachments.Upload(ctx, converter.ToUploadAttachmentInput(ctx, info), &chunkReader{stream: stream})
+	if err != nil {
+		log.Error("failed to upload attachment", sl.ErrAttr(err))
+
+		return err
+	}
+
+	return stream.SendAndClose(converter.FromUploadAttachmentOutput(attachment))
+}
+
+// chunkReader склеивает куски из стрима в обычный io.Reader, чтобы сервис не знал про grpc
+type chunkReader struct {
+	stream chatv1.Chat_UploadAttachmentServer
+	chunk  []byte
+}
+
+func (r *chunkReader) Read(p []byte) (int, error) {
+	for len(r.chunk) == 0 {
+		req, err := r.stream.Recv()
+		if err != nil {
+			return 0, err
+		}
+
+		if req.GetInfo() != nil {
+			return 0, apperr.InvalidArgument("attachment info must be sent only once", apperr.FieldViolation{
+				Field:       "info",
+				Description: "only the first message carries attachment info",
+			})
+		}
+
+		r.chunk = req.GetChunk()
+	}
+
+	n := copy(p, r.chunk)
+	r.chunk = r.chunk[n:]
+
+	return n, nil
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net"
 
+	"github.com/defany/chat-server/app/internal/interceptor"
 	"github.com/defany/chat-server/app/pkg/closer"
 	chatv1 "github.com/defany/chat-server/app/pkg/gen/chat/v1"
 	"google.golang.org/grpc"
@@ -37,6 +38,8 @@ func (a *App) Run(ctx context.Context) error {
 
 	a.registerUserService(ctx)
 
+	a.runWorkers(ctx)
+
 	return a.runGRPCServer(ctx)
 }
 
@@ -48,6 +51,19 @@ func (a *App) setupDI() {
 	a.di = newDI()
 }
 
+func (a *App) runWorkers(ctx context.Context) {
+	ctx, cancel := context.WithCancel(ctx)
+
+	closer.Add(func() error {
+		cancel()
+
+		return nil
+	})
+
+	go a.di.Purger(ctx).Run(ctx)
+	go a.di.Dispatcher(ctx).Run(ctx)
+}
+
 func (a *App) runGRPCServer(ctx context.Context) error {
 	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", a.di.Config(ctx).Server.Port))
 	if err != nil {
@@ -64,7 +80,19 @@ func (a *App) runGRPCServer(ctx context.Context) error {
 }
 
 func (a *App) registerUserService(ctx context.Context) {
-	a.grpcServer = grpc.NewServer()
+	a.grpcServer = grpc.NewServer(
+		grpc.ChainUnaryInterceptor(
+			interceptor.Errors(),
+			interceptor.Auth(a.di.AuthVerifier(ctx)),
+			interceptor.Validate(),
+		),
+		grpc.ChainStreamInterceptor(
+			interceptor.ErrorsStream(),
+			interceptor.AuthStream(a.di.AuthVerifier(ctx)),
+			interceptor.OnlineStream(a.di.Log(ctx), a.di.OnlineService(ctx)),
+			interceptor.ValidateStream(),
+		),
+	)
 	reflection.Register(a.grpcServer)
 
 	chatv1.RegisterChatServer(a.grpcServer, a.di.ChatImpl(ctx))
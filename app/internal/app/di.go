@@ -6,12 +6,28 @@ import (
 	"os"
 
 	"github.com/defany/chat-server/app/internal/api/chat"
+	"github.com/defany/chat-server/app/internal/auth"
+	"github.com/defany/chat-server/app/internal/client"
+	blobclient "github.com/defany/chat-server/app/internal/client/blob"
+	notifierclient "github.com/defany/chat-server/app/internal/client/notifier"
+	userclient "github.com/defany/chat-server/app/internal/client/user"
 	"github.com/defany/chat-server/app/internal/config"
 	"github.com/defany/chat-server/app/internal/repository"
+	attachmentrepo "github.com/defany/chat-server/app/internal/repository/attachment"
 	chatrepo "github.com/defany/chat-server/app/internal/repository/chat"
 	logrepo "github.com/defany/chat-server/app/internal/repository/log"
+	notificationrepo "github.com/defany/chat-server/app/internal/repository/notification"
+	presencerepo "github.com/defany/chat-server/app/internal/repository/presence"
+	reactionrepo "github.com/defany/chat-server/app/internal/repository/reaction"
 	servicedef "github.com/defany/chat-server/app/internal/service"
+	attachmentservice "github.com/defany/chat-server/app/internal/service/attachment"
 	chatservice "github.com/defany/chat-server/app/internal/service/chat"
+	"github.com/defany/chat-server/app/internal/service/hub"
+	notificationservice "github.com/defany/chat-server/app/internal/service/notification"
+	onlineservice "github.com/defany/chat-server/app/internal/service/online"
+	"github.com/defany/chat-server/app/internal/service/presence"
+	reactionservice "github.com/defany/chat-server/app/internal/service/reaction"
+	"github.com/defany/chat-server/app/internal/worker"
 	"github.com/defany/chat-server/app/pkg/closer"
 	"github.com/defany/db/pkg/postgres"
 	"github.com/defany/slogger/pkg/logger/sl"
@@ -23,12 +39,37 @@ type DI struct {
 	cfg *config.Config
 
 	repositories struct {
-		chat repository.Chat
-		log  repository.Log
+		chat         repository.Chat
+		reaction     repository.Reaction
+		attachment   repository.Attachment
+		notification repository.Notification
+		presence     repository.Presence
+		log          repository.Log
+	}
+
+	clients struct {
+		users    client.UserDirectory
+		blobs    client.BlobStore
+		notifier client.Notifier
 	}
 
 	services struct {
-		chat servicedef.Chat
+		chat         servicedef.Chat
+		reaction     servicedef.Reaction
+		attachment   servicedef.Attachment
+		notification servicedef.Notification
+		online       servicedef.Online
+	}
+
+	hub      *hub.Hub
+	presence *presence.Tracker
+	registry *presence.Registry
+
+	authVerifier auth.Verifier
+
+	workers struct {
+		purger     *worker.Purger
+		dispatcher *worker.Dispatcher
 	}
 
 	implementations struct {
@@ -103,6 +144,23 @@ func (d *DI) TxManager(ctx context.Context) postgres.TxManager {
 	return d.txManager
 }
 
+func (d *DI) AuthVerifier(ctx context.Context) auth.Verifier {
+	if d.authVerifier != nil {
+		return d.authVerifier
+	}
+
+	verifier, err := auth.NewJWTVerifier(d.Config(ctx).Auth)
+	if err != nil {
+		d.Log(ctx).Error("failed to setup auth verifier", sl.ErrAttr(err))
+
+		os.Exit(1)
+	}
+
+	d.authVerifier = verifier
+
+	return d.authVerifier
+}
+
 func (d *DI) ChatRepo(ctx context.Context) repository.Chat {
 	if d.repositories.chat != nil {
 		return d.repositories.chat
@@ -113,6 +171,46 @@ func (d *DI) ChatRepo(ctx context.Context) repository.Chat {
 	return d.repositories.chat
 }
 
+func (d *DI) ReactionRepo(ctx context.Context) repository.Reaction {
+	if d.repositories.reaction != nil {
+		return d.repositories.reaction
+	}
+
+	d.repositories.reaction = reactionrepo.NewRepository(d.Database(ctx))
+
+	return d.repositories.reaction
+}
+
+func (d *DI) AttachmentRepo(ctx context.Context) repository.Attachment {
+	if d.repositories.attachment != nil {
+		return d.repositories.attachment
+	}
+
+	d.repositories.attachment = attachmentrepo.NewRepository(d.Database(ctx))
+
+	return d.repositories.attachment
+}
+
+func (d *DI) NotificationRepo(ctx context.Context) repository.Notification {
+	if d.repositories.notification != nil {
+		return d.repositories.notification
+	}
+
+	d.repositories.notification = notificationrepo.NewRepository(d.Database(ctx))
+
+	return d.repositories.notification
+}
+
+func (d *DI) PresenceRepo(ctx context.Context) repository.Presence {
+	if d.repositories.presence != nil {
+		return d.repositories.presence
+	}
+
+	d.repositories.presence = presencerepo.NewRepository(d.Database(ctx))
+
+	return d.repositories.presence
+}
+
 func (d *DI) LogRepo(ctx context.Context) repository.Log {
 	if d.repositories.log != nil {
 		return d.repositories.log
@@ -123,22 +221,168 @@ func (d *DI) LogRepo(ctx context.Context) repository.Log {
 	return d.repositories.log
 }
 
+func (d *DI) UserDirectory(ctx context.Context) client.UserDirectory {
+	if d.clients.users != nil {
+		return d.clients.users
+	}
+
+	d.clients.users = userclient.NewLocalDirectory(d.Config(ctx).UserDirectory.Users)
+
+	return d.clients.users
+}
+
+func (d *DI) BlobStore(ctx context.Context) client.BlobStore {
+	if d.clients.blobs != nil {
+		return d.clients.blobs
+	}
+
+	blobs, err := blobclient.NewLocalStore(d.Config(ctx).Attachments.Dir)
+	if err != nil {
+		d.Log(ctx).Error("failed to setup blob store", sl.ErrAttr(err))
+
+		os.Exit(1)
+	}
+
+	d.clients.blobs = blobs
+
+	return d.clients.blobs
+}
+
+// Notifier шлет уведомления в вебхук, а если он не настроен - просто пишет их в лог
+func (d *DI) Notifier(ctx context.Context) client.Notifier {
+	if d.clients.notifier != nil {
+		return d.clients.notifier
+	}
+
+	cfg := d.Config(ctx).Notifications
+
+	if cfg.WebhookURL == "" {
+		d.clients.notifier = notifierclient.NewLogNotifier(d.Log(ctx))
+	} else {
+		d.clients.notifier = notifierclient.NewWebhookNotifier(cfg.WebhookURL, cfg.WebhookTimeout)
+	}
+
+	return d.clients.notifier
+}
+
+func (d *DI) Hub(_ context.Context) *hub.Hub {
+	if d.hub != nil {
+		return d.hub
+	}
+
+	d.hub = hub.New()
+
+	closer.Add(func() error {
+		d.hub.Close()
+
+		return nil
+	})
+
+	return d.hub
+}
+
+func (d *DI) Presence(ctx context.Context) *presence.Tracker {
+	if d.presence != nil {
+		return d.presence
+	}
+
+	d.presence = presence.New(d.Config(ctx).Chat.TypingTTL)
+
+	closer.Add(func() error {
+		d.presence.Close()
+
+		return nil
+	})
+
+	return d.presence
+}
+
+func (d *DI) OnlineRegistry(_ context.Context) *presence.Registry {
+	if d.registry != nil {
+		return d.registry
+	}
+
+	d.registry = presence.NewRegistry()
+
+	return d.registry
+}
+
 func (d *DI) ChatService(ctx context.Context) servicedef.Chat {
 	if d.services.chat != nil {
 		return d.services.chat
 	}
 
-	d.services.chat = chatservice.NewService(d.TxManager(ctx), d.ChatRepo(ctx), d.LogRepo(ctx))
+	d.services.chat = chatservice.NewService(d.TxManager(ctx), d.ChatRepo(ctx), d.ReactionRepo(ctx), d.AttachmentRepo(ctx), d.NotificationRepo(ctx), d.LogRepo(ctx), d.UserDirectory(ctx), d.Hub(ctx), d.Presence(ctx), d.Config(ctx).Chat)
 
 	return d.services.chat
 }
 
+func (d *DI) ReactionService(ctx context.Context) servicedef.Reaction {
+	if d.services.reaction != nil {
+		return d.services.reaction
+	}
+
+	d.services.reaction = reactionservice.NewService(d.TxManager(ctx), d.ChatRepo(ctx), d.ReactionRepo(ctx), d.Hub(ctx))
+
+	return d.services.reaction
+}
+
+func (d *DI) AttachmentService(ctx context.Context) servicedef.Attachment {
+	if d.services.attachment != nil {
+		return d.services.attachment
+	}
+
+	d.services.attachment = attachmentservice.NewService(d.ChatRepo(ctx), d.AttachmentRepo(ctx), d.BlobStore(ctx), d.Config(ctx).Attachments)
+
+	return d.services.attachment
+}
+
+func (d *DI) NotificationService(ctx context.Context) servicedef.Notification {
+	if d.services.notification != nil {
+		return d.services.notification
+	}
+
+	d.services.notification = notificationservice.NewService(d.TxManager(ctx), d.NotificationRepo(ctx), d.Notifier(ctx), d.Config(ctx).Notifications)
+
+	return d.services.notification
+}
+
+func (d *DI) Purger(ctx context.Context) *worker.Purger {
+	if d.workers.purger != nil {
+		return d.workers.purger
+	}
+
+	d.workers.purger = worker.NewPurger(d.Log(ctx), d.ChatService(ctx), d.AttachmentService(ctx), d.Config(ctx).Chat.PurgeInterval)
+
+	return d.workers.purger
+}
+
+func (d *DI) Dispatcher(ctx context.Context) *worker.Dispatcher {
+	if d.workers.dispatcher != nil {
+		return d.workers.dispatcher
+	}
+
+	d.workers.dispatcher = worker.NewDispatcher(d.Log(ctx), d.NotificationService(ctx), d.Config(ctx).Notifications.DispatchInterval)
+
+	return d.workers.dispatcher
+}
+
+func (d *DI) OnlineService(ctx context.Context) servicedef.Online {
+	if d.services.online != nil {
+		return d.services.online
+	}
+
+	d.services.online = onlineservice.NewService(d.PresenceRepo(ctx), d.OnlineRegistry(ctx))
+
+	return d.services.online
+}
+
 func (d *DI) ChatImpl(ctx context.Context) *chat.Implementation {
 	if d.implementations.chat != nil {
 		return d.implementations.chat
 	}
 
-	d.implementations.chat = chat.NewImplementation(d.Log(ctx), d.ChatService(ctx))
+	d.implementations.chat = chat.NewImplementation(d.Log(ctx), d.ChatService(ctx), d.ReactionService(ctx), d.AttachmentService(ctx), d.OnlineService(ctx))
 
 	return d.implementations.chat
 }
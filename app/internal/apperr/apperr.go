@@ -0,0 +1,136 @@
+package apperr
+
+import (
+	"errors"
+	"fmt"
+)
+
+type Code int
+
+const (
+	CodeInternal Code = iota
+	CodeNotFound
+	CodeAlreadyExists
+	CodeInvalidArgument
+	CodePermissionDenied
+	CodeConflict
+	CodeFailedPrecondition
+)
+
+var codeNames = map[Code]string{
+	CodeInternal:           "INTERNAL",
+	CodeNotFound:           "NOT_FOUND",
+	CodeAlreadyExists:      "ALREADY_EXISTS",
+	CodeInvalidArgument:    "INVALID_ARGUMENT",
+	CodePermissionDenied:   "PERMISSION_DENIED",
+	CodeConflict:           "CONFLICT",
+	CodeFailedPrecondition: "FAILED_PRECONDITION",
+}
+
+func (c Code) String() string {
+	if name, ok := codeNames[c]; ok {
+		return name
+	}
+
+	return codeNames[CodeInternal]
+}
+
+// Sentinel ошибки для errors.Is: совпадают с любой ошибкой того же кода
+var (
+	ErrNotFound           = &Error{Code: CodeNotFound}
+	ErrAlreadyExists      = &Error{Code: CodeAlreadyExists}
+	ErrInvalidArgument    = &Error{Code: CodeInvalidArgument}
+	ErrPermissionDenied   = &Error{Code: CodePermissionDenied}
+	ErrConflict           = &Error{Code: CodeConflict}
+	ErrFailedPrecondition = &Error{Code: CodeFailedPrecondition}
+)
+
+type FieldViolation struct {
+	Field       string
+	Description string
+}
+
+type Error struct {
+	Code       Code
+	Message    string
+	Violations []FieldViolation
+
+	err error
+}
+
+func New(code Code, message string) *Error {
+	return &Error{
+		Code:    code,
+		Message: message,
+	}
+}
+
+func Wrap(code Code, message string, err error) *Error {
+	return &Error{
+		Code:    code,
+		Message: message,
+		err:     err,
+	}
+}
+
+func NotFound(message string) *Error {
+	return New(CodeNotFound, message)
+}
+
+func AlreadyExists(message string) *Error {
+	return New(CodeAlreadyExists, message)
+}
+
+func InvalidArgument(message string, violations ...FieldViolation) *Error {
+	e := New(CodeInvalidArgument, message)
+	e.Violations = violations
+
+	return e
+}
+
+func PermissionDenied(message string) *Error {
+	return New(CodePermissionDenied, message)
+}
+
+func Conflict(message string) *Error {
+	return New(CodeConflict, message)
+}
+
+func FailedPrecondition(message string) *Error {
+	return New(CodeFailedPrecondition, message)
+}
+
+func (e *Error) Error() string {
+	msg := e.Message
+	if msg == "" {
+		msg = e.Code.String()
+	}
+
+	if e.err != nil {
+		return fmt.Sprintf("%s: %s", msg, e.err)
+	}
+
+	return msg
+}
+
+func (e *Error) Unwrap() error {
+	return e.err
+}
+
+func (e *Error) Is(target error) bool {
+	t, ok := target.(*Error)
+	if !ok {
+		return false
+	}
+
+	return t.Code == e.Code && t.Message == ""
+}
+
+func As(err error) (*Error, bool) {
+	var e *Error
+	if !errors.As(err, &e) {
+		return nil, false
+	}
+
+	return e, true
+}
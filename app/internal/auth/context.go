@@ -0,0 +1,21 @@
+package auth
+
+import "context"
+
+type userIDKey struct{}
+
+func InjectUserID(ctx context.Context, userID uint64) context.Context {
+	return context.WithValue(ctx, userIDKey{}, userID)
+}
+
+func ExtractUserID(ctx context.Context) (uint64, bool) {
+	userID, ok := ctx.Value(userIDKey{}).(uint64)
+
+	return userID, ok
+}
+
+func UserID(ctx context.Context) uint64 {
+	userID, _ := ExtractUserID(ctx)
+
+	return userID
+}
@@ -0,0 +1,88 @@
+// Code generated by mockery v2.42.0. DO NOT EDIT.
+
+package mockauth
+
+import mock "github.com/stretchr/testify/mock"
+
+// MockVerifier is an autogenerated mock type for the Verifier type
+type MockVerifier struct {
+	mock.Mock
+}
+
+type MockVerifier_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockVerifier) EXPECT() *MockVerifier_Expecter {
+	return &MockVerifier_Expecter{mock: &_m.Mock}
+}
+
+// Verify provides a mock function with given fields: token
+func (_m *MockVerifier) Verify(token string) (uint64, error) {
+	ret := _m.Called(token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Verify")
+	}
+
+	var r0 uint64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (uint64, error)); ok {
+		return rf(token)
+	}
+	if rf, ok := ret.Get(0).(func(string) uint64); ok {
+		r0 = rf(token)
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(token)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockVerifier_Verify_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Verify'
+type MockVerifier_Verify_Call struct {
+	*mock.Call
+}
+
+// Verify is a helper method to define mock.On call
+//   - token string
+func (_e *MockVerifier_Expecter) Verify(token interface{}) *MockVerifier_Verify_Call {
+	return &MockVerifier_Verify_Call{Call: _e.mock.On("Verify", token)}
+}
+
+func (_c *MockVerifier_Verify_Call) Run(run func(token string)) *MockVerifier_Verify_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockVerifier_Verify_Call) Return(_a0 uint64, _a1 error) *MockVerifier_Verify_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockVerifier_Verify_Call) RunAndReturn(run func(string) (uint64, error)) *MockVerifier_Verify_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockVerifier creates a new instance of MockVerifier. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockVerifier(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockVerifier {
+	mock := &MockVerifier{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
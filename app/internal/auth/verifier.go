@@ -0,0 +1,81 @@
+package auth
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/defany/chat-server/app/internal/config"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+var ErrInvalidToken = errors.New("invalid token")
+
+type Verifier interface {
+	Verify(token string) (uint64, error)
+}
+
+type jwtVerifier struct {
+	key    any
+	parser *jwt.Parser
+}
+
+func NewJWTVerifier(cfg config.Auth) (Verifier, error) {
+	opts := []jwt.ParserOption{
+		jwt.WithValidMethods([]string{cfg.Algorithm}),
+		jwt.WithExpirationRequired(),
+	}
+
+	if cfg.Issuer != "" {
+		opts = append(opts, jwt.WithIssuer(cfg.Issuer))
+	}
+
+	key, err := verificationKey(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &jwtVerifier{
+		key:    key,
+		parser: jwt.NewParser(opts...),
+	}, nil
+}
+
+func (v *jwtVerifier) Verify(token string) (uint64, error) {
+	var claims jwt.RegisteredClaims
+
+	_, err := v.parser.ParseWithClaims(token, &claims, func(_ *jwt.Token) (any, error) {
+		return v.key, nil
+	})
+	if err != nil {
+		return 0, fmt.Errorf("%w: %w", ErrInvalidToken, err)
+	}
+
+	userID, err := strconv.ParseUint(claims.Subject, 10, 64)
+	if err != nil || userID == 0 {
+		return 0, fmt.Errorf("%w: subject must be a positive user id", ErrInvalidToken)
+	}
+
+	return userID, nil
+}
+
+func verificationKey(cfg config.Auth) (any, error) {
+	switch cfg.Algorithm {
+	case jwt.SigningMethodHS256.Alg():
+		if cfg.Secret == "" {
+			return nil, errors.New("auth secret is required for HS256")
+		}
+
+		return []byte(cfg.Secret), nil
+	case jwt.SigningMethodRS256.Alg():
+		pem, err := os.ReadFile(cfg.PublicKeyPath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read auth public key: %w", err)
+		}
+
+		return jwt.ParseRSAPublicKeyFromPEM(pem)
+	default:
+		return nil, fmt.Errorf("unsupported auth algorithm: %s", cfg.Algorithm)
+	}
+}
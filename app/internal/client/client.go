@@ -0,0 +1,33 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	"github.com/defany/chat-server/app/internal/model"
+)
+
+var (
+	ErrUserNotFound = errors.New("user not found")
+	ErrBlobNotFound = errors.New("blob not found")
+)
+
+type UserDirectory interface {
+	IDs(ctx context.Context, usernames []string) ([]uint64, error)
+	// Usernames возвращает имена только найденных пользователей, отсутствие имени ошибкой не считается
+	Usernames(ctx context.Context, ids []uint64) (map[uint64]string, error)
+}
+
+// BlobStore хранит содержимое вложений, метаданные живут в постгресе
+type BlobStore interface {
+	// Put читает r до конца и возвращает количество записанных байт. При ошибке чтения блоб не сохраняется
+	Put(ctx context.Context, key string, r io.Reader) (int64, error)
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	Delete(ctx context.Context, key string) error
+}
+
+// Notifier доставляет уведомление пользователю. Доставка как минимум один раз, получатель отсеивает повторы по id
+type Notifier interface {
+	Notify(ctx context.Context, notification model.Notification) error
+}
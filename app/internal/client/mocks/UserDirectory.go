@@ -0,0 +1,154 @@
+// Code generated by mockery v2.42.0. DO NOT EDIT.
+
+package mockclient
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockUserDirectory is an autogenerated mock type for the UserDirectory type
+type MockUserDirectory struct {
+	mock.Mock
+}
+
+type MockUserDirectory_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockUserDirectory) EXPECT() *MockUserDirectory_Expecter {
+	return &MockUserDirectory_Expecter{mock: &_m.Mock}
+}
+
+// IDs provides a mock function with given fields: ctx, usernames
+func (_m *MockUserDirectory) IDs(ctx context.Context, usernames []string) ([]uint64, error) {
+	ret := _m.Called(ctx, usernames)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IDs")
+	}
+
+	var r0 []uint64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []string) ([]uint64, error)); ok {
+		return rf(ctx, usernames)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []string) []uint64); ok {
+		r0 = rf(ctx, usernames)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]uint64)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = rf(ctx, usernames)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUserDirectory_IDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IDs'
+type MockUserDirectory_IDs_Call struct {
+	*mock.Call
+}
+
+// IDs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - usernames []string
+func (_e *MockUserDirectory_Expecter) IDs(ctx interface{}, usernames interface{}) *MockUserDirectory_IDs_Call {
+	return &MockUserDirectory_IDs_Call{Call: _e.mock.On("IDs", ctx, usernames)}
+}
+
+func (_c *MockUserDirectory_IDs_Call) Run(run func(ctx context.Context, usernames []string)) *MockUserDirectory_IDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]string))
+	})
+	return _c
+}
+
+func (_c *MockUserDirectory_IDs_Call) Return(_a0 []uint64, _a1 error) *MockUserDirectory_IDs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUserDirectory_IDs_Call) RunAndReturn(run func(context.Context, []string) ([]uint64, error)) *MockUserDirectory_IDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Usernames provides a mock function with given fields: ctx, ids
+func (_m *MockUserDirectory) Usernames(ctx context.Context, ids []uint64) (map[uint64]string, error) {
+	ret := _m.Called(ctx, ids)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Usernames")
+	}
+
+	var r0 map[uint64]string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []uint64) (map[uint64]string, error)); ok {
+		return rf(ctx, ids)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []uint64) map[uint64]string); ok {
+		r0 = rf(ctx, ids)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[uint64]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []uint64) error); ok {
+		r1 = rf(ctx, ids)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUserDirectory_Usernames_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Usernames'
+type MockUserDirectory_Usernames_Call struct {
+	*mock.Call
+}
+
+// Usernames is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ids []uint64
+func (_e *MockUserDirectory_Expecter) Usernames(ctx interface{}, ids interface{}) *MockUserDirectory_Usernames_Call {
+	return &MockUserDirectory_Usernames_Call{Call: _e.mock.On("Usernames", ctx, ids)}
+}
+
+func (_c *MockUserDirectory_Usernames_Call) Run(run func(ctx context.Context, ids []uint64)) *MockUserDirectory_Usernames_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]uint64))
+	})
+	return _c
+}
+
+func (_c *MockUserDirectory_Usernames_Call) Return(_a0 map[uint64]string, _a1 error) *MockUserDirectory_Usernames_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUserDirectory_Usernames_Call) RunAndReturn(run func(context.Context, []uint64) (map[uint64]string, error)) *MockUserDirectory_Usernames_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockUserDirectory creates a new instance of MockUserDirectory. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockUserDirectory(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockUserDirectory {
+	mock := &MockUserDirectory{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
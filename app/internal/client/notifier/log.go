@@ -0,0 +1,32 @@
+package notifierclient
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/defany/chat-server/app/internal/client"
+	"github.com/defany/chat-server/app/internal/model"
+)
+
+// logNotifier ничего не доставляет, а пишет уведомления в лог. Нужен, пока не настроен вебхук
+type logNotifier struct {
+	log *slog.Logger
+}
+
+func NewLogNotifier(log *slog.Logger) client.Notifier {
+	return &logNotifier{
+		log: log,
+	}
+}
+
+func (n *logNotifier) Notify(ctx context.Context, notification model.Notification) error {
+	n.log.InfoContext(ctx, "notification",
+		slog.Uint64("id", notification.ID),
+		slog.String("kind", string(notification.Kind)),
+		slog.Uint64("user_id", notification.UserID),
+		slog.Int64("chat_id", notification.ChatID),
+		slog.Uint64("message_id", notification.MessageID),
+	)
+
+	return nil
+}
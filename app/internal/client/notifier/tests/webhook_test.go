@@ -0,0 +1,94 @@
+package notifiertests
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+	notifierclient "github.com/defany/chat-server/app/internal/client/notifier"
+	"github.com/defany/chat-server/app/internal/model"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWebhookNotifier_Notify(t *testing.T) {
+	notification := model.Notification{
+		ID:        gofakeit.Uint64(),
+		Kind:      model.NotificationMention,
+		UserID:    gofakeit.Uint64(),
+		ChatID:    gofakeit.Int64(),
+		MessageID: gofakeit.Uint64(),
+		AuthorID:  gofakeit.Uint64(),
+		Text:      gofakeit.Sentence(5),
+		CreatedAt: time.Now().UTC().Truncate(time.Second),
+	}
+
+	tests := []struct {
+		name   string
+		status int
+		err    string
+	}{
+		{
+			name:   "receiver accepted notification",
+			status: http.StatusAccepted,
+		},
+		{
+			name:   "receiver is down",
+			status: http.StatusServiceUnavailable,
+			err:    "webhook responded with status 503",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				body struct {
+					ID        uint64    `json:"id"`
+					Kind      string    `json:"kind"`
+					UserID    uint64    `json:"user_id"`
+					ChatID    int64     `json:"chat_id"`
+					MessageID uint64    `json:"message_id"`
+					AuthorID  uint64    `json:"author_id"`
+					Text      string    `json:"text"`
+					CreatedAt time.Time `json:"created_at"`
+				}
+
+				header http.Header
+			)
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				header = r.Header
+
+				_ = json.NewDecoder(r.Body).Decode(&body)
+
+				w.WriteHeader(tt.status)
+			}))
+			defer server.Close()
+
+			notifier := notifierclient.NewWebhookNotifier(server.URL, time.Second)
+
+			err := notifier.Notify(context.Background(), notification)
+			if tt.err != "" {
+				require.EqualError(t, err, tt.err)
+			} else {
+				require.NoError(t, err)
+			}
+
+			require.Equal(t, "application/json", header.Get("Content-Type"))
+			require.Equal(t, strconv.FormatUint(notification.ID, 10), header.Get("Idempotency-Key"))
+
+			require.Equal(t, notification.ID, body.ID)
+			require.Equal(t, string(notification.Kind), body.Kind)
+			require.Equal(t, notification.UserID, body.UserID)
+			require.Equal(t, notification.ChatID, body.ChatID)
+			require.Equal(t, notification.MessageID, body.MessageID)
+			require.Equal(t, notification.AuthorID, body.AuthorID)
+			require.Equal(t, notification.Text, body.Text)
+			require.True(t, notification.CreatedAt.Equal(body.CreatedAt))
+		})
+	}
+}
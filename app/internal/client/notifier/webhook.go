@@ -0,0 +1,81 @@
+package notifierclient
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/defany/chat-server/app/internal/client"
+	"github.com/defany/chat-server/app/internal/model"
+)
+
+// webhookNotifier отправляет уведомление POST'ом в json. Любой ответ кроме 2xx считается ошибкой и уходит на повтор
+type webhookNotifier struct {
+	url    string
+	client *http.Client
+}
+
+type webhookPayload struct {
+	ID        uint64    `json:"id"`
+	Kind      string    `json:"kind"`
+	UserID    uint64    `json:"user_id"`
+	ChatID    int64     `json:"chat_id"`
+	MessageID uint64    `json:"message_id"`
+	AuthorID  uint64    `json:"author_id"`
+	Text      string    `json:"text"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+func NewWebhookNotifier(url string, timeout time.Duration) client.Notifier {
+	return &webhookNotifier{
+		url: url,
+		client: &http.Client{
+			Timeout: timeout,
+		},
+	}
+}
+
+func (n *webhookNotifier) Notify(ctx context.Context, notification model.Notification) error {
+	body, err := json.Marshal(webhookPayload{
+		ID:        notification.ID,
+		Kind:      string(notification.Kind),
+		UserID:    notification.UserID,
+		ChatID:    notification.ChatID,
+		MessageID: notification.MessageID,
+		AuthorID:  notification.AuthorID,
+		Text:      notification.Text,
+		CreatedAt: notification.CreatedAt,
+	})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, n.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	// Одно и то же уведомление может прийти повторно, если мы не успели отметить его отправленным
+	req.Header.Set("Idempotency-Key", strconv.FormatUint(notification.ID, 10))
+
+	res, err := n.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	// Дочитываем тело, чтобы соединение вернулось в пул
+	_, _ = io.Copy(io.Discard, io.LimitReader(res.Body, 64<<10))
+
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		return fmt.Errorf("webhook responded with status %d", res.StatusCode)
+	}
+
+	return nil
+}
@@ -0,0 +1,53 @@
+package userclient
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/defany/chat-server/app/internal/client"
+)
+
+// localDirectory резолвит пользователей из статичного списка, пока у нас нет походов в auth сервис
+type localDirectory struct {
+	users     map[string]uint64
+	usernames map[uint64]string
+}
+
+func NewLocalDirectory(users map[string]uint64) client.UserDirectory {
+	usernames := make(map[uint64]string, len(users))
+	for username, id := range users {
+		usernames[id] = username
+	}
+
+	return &localDirectory{
+		users:     users,
+		usernames: usernames,
+	}
+}
+
+func (d *localDirectory) IDs(_ context.Context, usernames []string) ([]uint64, error) {
+	ids := make([]uint64, 0, len(usernames))
+
+	for _, username := range usernames {
+		id, ok := d.users[username]
+		if !ok {
+			return nil, fmt.Errorf("%w: %s", client.ErrUserNotFound, username)
+		}
+
+		ids = append(ids, id)
+	}
+
+	return ids, nil
+}
+
+func (d *localDirectory) Usernames(_ context.Context, ids []uint64) (map[uint64]string, error) {
+	usernames := make(map[uint64]string, len(ids))
+
+	for _, id := range ids {
+		if username, ok := d.usernames[id]; ok {
+			usernames[id] = username
+		}
+	}
+
+	return usernames, nil
+}
@@ -31,12 +31,61 @@ type Database struct {
 	ConnectAttemptsDelay time.Duration `json:"connect_attempts_delay" env:"DATABASE_CONNECT_ATTEMPTS_DELAY" env-default:"5s"`
 }
 
+type Auth struct {
+	Algorithm     string `json:"algorithm" env:"AUTH_ALGORITHM" env-default:"HS256"` // HS256 | RS256
+	Secret        string `json:"secret" env:"AUTH_SECRET"`
+	PublicKeyPath string `json:"public_key_path" env:"AUTH_PUBLIC_KEY_PATH"`
+	Issuer        string `json:"issuer" env:"AUTH_ISSUER"`
+}
+
+type UserDirectory struct {
+	Users map[string]uint64 `json:"users"`
+}
+
+type Chat struct {
+	DeletedRetention time.Duration `json:"deleted_retention" env:"CHAT_DELETED_RETENTION" env-default:"720h"`
+	PurgeInterval    time.Duration `json:"purge_interval" env:"CHAT_PURGE_INTERVAL" env-default:"1h"`
+	PurgeBatchSize   uint64        `json:"purge_batch_size" env:"CHAT_PURGE_BATCH_SIZE" env-default:"100"`
+	EditWindow       time.Duration `json:"edit_window" env:"CHAT_EDIT_WINDOW"` // 0 - без ограничений
+	TypingTTL        time.Duration `json:"typing_ttl" env:"CHAT_TYPING_TTL" env-default:"6s"`
+}
+
+type Attachments struct {
+	Dir     string `json:"dir" env:"ATTACHMENTS_DIR" env-default:"attachments"`
+	MaxSize int64  `json:"max_size" env:"ATTACHMENTS_MAX_SIZE" env-default:"20971520"` // в байтах
+	// AllowedMimeTypes точные типы или маски вида image/*, пустой список разрешает любые
+	AllowedMimeTypes []string `json:"allowed_mime_types" env:"ATTACHMENTS_ALLOWED_MIME_TYPES" env-separator:","`
+	// UnattachedTTL сколько загруженное вложение ждет отправки сообщения, прежде чем его удалит purger
+	UnattachedTTL  time.Duration `json:"unattached_ttl" env:"ATTACHMENTS_UNATTACHED_TTL" env-default:"24h"`
+	PurgeBatchSize uint64        `json:"purge_batch_size" env:"ATTACHMENTS_PURGE_BATCH_SIZE" env-default:"100"`
+}
+
+type Notifications struct {
+	// WebhookURL пустой - уведомления только пишутся в лог
+	WebhookURL       string        `json:"webhook_url" env:"NOTIFICATIONS_WEBHOOK_URL"`
+	WebhookTimeout   time.Duration `json:"webhook_timeout" env:"NOTIFICATIONS_WEBHOOK_TIMEOUT" env-default:"5s"`
+	DispatchInterval time.Duration `json:"dispatch_interval" env:"NOTIFICATIONS_DISPATCH_INTERVAL" env-default:"1s"`
+	BatchSize        uint64        `json:"batch_size" env:"NOTIFICATIONS_BATCH_SIZE" env-default:"100"`
+	MaxAttempts      int           `json:"max_attempts" env:"NOTIFICATIONS_MAX_ATTEMPTS" env-default:"10"`
+	// RetryDelay задержка перед второй попыткой, дальше она удваивается до MaxRetryDelay
+	RetryDelay    time.Duration `json:"retry_delay" env:"NOTIFICATIONS_RETRY_DELAY" env-default:"5s"`
+	MaxRetryDelay time.Duration `json:"max_retry_delay" env:"NOTIFICATIONS_MAX_RETRY_DELAY" env-default:"10m"`
+	Lease         time.Duration `json:"lease" env:"NOTIFICATIONS_LEASE" env-default:"1m"`
+}
+
 type Config struct {
 	Env      string   `json:"env" env-required:"true" env:"ENV"`
 	Metrics  Metrics  `json:"metrics"`
 	Server   Server   `json:"server"`
 	Database Database `json:"database"`
 	Logger   sl.Slog  `json:"logger"`
+	Auth     Auth     `json:"auth"`
+	Chat     Chat     `json:"chat"`
+
+	Attachments   Attachments   `json:"attachments"`
+	Notifications Notifications `json:"notifications"`
+
+	UserDirectory UserDirectory `json:"user_directory"`
 }
 
 func MustLoad() *Config {
@@ -0,0 +1,214 @@
+package content
+
+import (
+	"cmp"
+	"fmt"
+	"net/url"
+	"regexp"
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/defany/chat-server/app/internal/apperr"
+	"github.com/defany/chat-server/app/internal/model"
+)
+
+const MaxEntities = 100
+
+// linkPattern ищет голые ссылки в тексте, хвостовую пунктуацию отрезаем отдельно
+var linkPattern = regexp.MustCompile(`https?://[^\s<>"]+`)
+
+// Parse проверяет разметку от клиента и приводит ее к одному виду:
+// обрезает пробелы по краям сущностей, выкидывает пустые и повторяющиеся, сортирует по началу
+// и размечает ссылки, которые клиент оставил голым текстом.
+// Сущности могут быть вложены друг в друга, но не могут пересекаться частично
+func Parse(text string, entities []model.MessageEntity) ([]model.MessageEntity, error) {
+	runes := []rune(text)
+
+	var parsed []model.MessageEntity
+
+	for i, entity := range entities {
+		entity, err := normalize(runes, entity)
+		if err != nil {
+			return nil, invalidEntity(fmt.Sprintf("content.entities[%d]", i), err.Error())
+		}
+
+		if entity.Length == 0 {
+			continue
+		}
+
+		parsed = append(parsed, entity)
+	}
+
+	sortEntities(parsed)
+
+	parsed = slices.Compact(parsed)
+
+	parsed, err := checkNesting(parsed)
+	if err != nil {
+		return nil, invalidEntity("content.entities", err.Error())
+	}
+
+	parsed = append(parsed, detectLinks(text, parsed)...)
+
+	sortEntities(parsed)
+
+	if len(parsed) > MaxEntities {
+		return nil, invalidEntity("content.entities", fmt.Sprintf("message can not have more than %d entities", MaxEntities))
+	}
+
+	return parsed, nil
+}
+
+// Mentions возвращает упомянутых пользователей без повторов, в порядке появления в тексте
+func Mentions(entities []model.MessageEntity) []uint64 {
+	var userIDs []uint64
+
+	for _, entity := range entities {
+		if entity.Type == model.EntityMention && !slices.Contains(userIDs, entity.UserID) {
+			userIDs = append(userIDs, entity.UserID)
+		}
+	}
+
+	return userIDs
+}
+
+func normalize(runes []rune, entity model.MessageEntity) (model.MessageEntity, error) {
+	if entity.Offset < 0 || entity.Length < 0 || entity.End() > len(runes) {
+		return model.MessageEntity{}, fmt.Errorf("entity is out of text bounds")
+	}
+
+	switch entity.Type {
+	case model.EntityBold, model.EntityItalic, model.EntityCode:
+		entity.URL, entity.UserID = "", 0
+	case model.EntityLink:
+		link, err := normalizeURL(entity.URL)
+		if err != nil {
+			return model.MessageEntity{}, err
+		}
+
+		entity.URL, entity.UserID = link, 0
+	case model.EntityMention:
+		if entity.UserID == 0 {
+			return model.MessageEntity{}, fmt.Errorf("mention must have user id")
+		}
+
+		entity.URL = ""
+	default:
+		return model.MessageEntity{}, fmt.Errorf("unknown entity type %q", entity.Type)
+	}
+
+	for entity.Length > 0 && unicode.IsSpace(runes[entity.Offset]) {
+		entity.Offset++
+		entity.Length--
+	}
+
+	for entity.Length > 0 && unicode.IsSpace(runes[entity.End()-1]) {
+		entity.Length--
+	}
+
+	return entity, nil
+}
+
+func normalizeURL(raw string) (string, error) {
+	u, err := url.Parse(strings.TrimSpace(raw))
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return "", fmt.Errorf("link must be an absolute http or https url")
+	}
+
+	u.Host = strings.ToLower(u.Host)
+
+	return u.String(), nil
+}
+
+// sortEntities ставит внешние сущности раньше вложенных: по началу, а при равном начале сначала длинные
+func sortEntities(entities []model.MessageEntity) {
+	slices.SortFunc(entities, func(a, b model.MessageEntity) int {
+		return cmp.Or(
+			cmp.Compare(a.Offset, b.Offset),
+			cmp.Compare(b.Length, a.Length),
+			cmp.Compare(a.Type, b.Type),
+			cmp.Compare(a.URL, b.URL),
+			cmp.Compare(a.UserID, b.UserID),
+		)
+	})
+}
+
+// checkNesting проходит по отсортированным сущностям со стеком открытых. Жирный внутри жирного ничего не меняет,
+// такие сущности выкидываем. Внутри кода разметки не бывает, а ссылки и упоминания не вкладываются друг в друга
+func checkNesting(entities []model.MessageEntity) ([]model.MessageEntity, error) {
+	var checked []model.MessageEntity
+
+	var open []model.MessageEntity
+
+entities:
+	for _, entity := range entities {
+		for len(open) > 0 && open[len(open)-1].End() <= entity.Offset {
+			open = open[:len(open)-1]
+		}
+
+		for _, parent := range open {
+			if entity.End() > parent.End() {
+				return nil, fmt.Errorf("entities must not partially overlap")
+			}
+
+			if parent.Type == model.EntityCode {
+				return nil, fmt.Errorf("code can not contain other entities")
+			}
+
+			if isReference(parent.Type) && isReference(entity.Type) {
+				return nil, fmt.Errorf("links and mentions can not be nested")
+			}
+
+			if parent.Type == entity.Type {
+				continue entities
+			}
+		}
+
+		open = append(open, entity)
+		checked = append(checked, entity)
+	}
+
+	return checked, nil
+}
+
+// detectLinks размечает ссылки, которые не задеты ни одной сущностью клиента
+func detectLinks(text string, entities []model.MessageEntity) []model.MessageEntity {
+	var links []model.MessageEntity
+
+	for _, match := range linkPattern.FindAllStringIndex(text, -1) {
+		raw := strings.TrimRight(text[match[0]:match[1]], ".,:;!?)]}'")
+
+		link, err := normalizeURL(raw)
+		if err != nil {
+			continue
+		}
+
+		entity := model.MessageEntity{
+			Type:   model.EntityLink,
+			Offset: len([]rune(text[:match[0]])),
+			Length: len([]rune(raw)),
+			URL:    link,
+		}
+
+		overlaps := slices.ContainsFunc(entities, func(e model.MessageEntity) bool {
+			return e.Offset < entity.End() && entity.Offset < e.End()
+		})
+		if !overlaps {
+			links = append(links, entity)
+		}
+	}
+
+	return links
+}
+
+func isReference(t model.EntityType) bool {
+	return t == model.EntityLink || t == model.EntityMention
+}
+
+func invalidEntity(field, description string) error {
+	return apperr.InvalidArgument("invalid message entities", apperr.FieldViolation{
+		Field:       field,
+		Description: description,
+	})
+}
@@ -0,0 +1,163 @@
+package contenttests
+
+import (
+	"testing"
+
+	"github.com/defany/chat-server/app/internal/apperr"
+	"github.com/defany/chat-server/app/internal/content"
+	"github.com/defany/chat-server/app/internal/model"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	invalid := func(field, description string) error {
+		return apperr.InvalidArgument("invalid message entities", apperr.FieldViolation{
+			Field:       field,
+			Description: description,
+		})
+	}
+
+	tests := []struct {
+		name     string
+		text     string
+		entities []model.MessageEntity
+		want     []model.MessageEntity
+		err      error
+	}{
+		{
+			name: "plain text stays without entities",
+			text: "hello world",
+		},
+		{
+			name: "offsets are counted in unicode code points",
+			text: "привет, мир",
+			entities: []model.MessageEntity{
+				{Type: model.EntityBold, Offset: 8, Length: 3},
+			},
+			want: []model.MessageEntity{
+				{Type: model.EntityBold, Offset: 8, Length: 3},
+			},
+		},
+		{
+			name: "entities are trimmed, sorted and deduplicated",
+			text: "say hello to bob",
+			entities: []model.MessageEntity{
+				{Type: model.EntityMention, Offset: 12, Length: 4, UserID: 7, URL: "https://ignored.io"},
+				{Type: model.EntityItalic, Offset: 3, Length: 7},
+				{Type: model.EntityItalic, Offset: 4, Length: 5},
+				{Type: model.EntityBold, Offset: 0, Length: 1, UserID: 1},
+				{Type: model.EntityCode, Offset: 3, Length: 1},
+			},
+			want: []model.MessageEntity{
+				{Type: model.EntityBold, Offset: 0, Length: 1},
+				{Type: model.EntityItalic, Offset: 4, Length: 5},
+				{Type: model.EntityMention, Offset: 13, Length: 3, UserID: 7},
+			},
+		},
+		{
+			name: "same entity inside itself is dropped",
+			text: "very bold text",
+			entities: []model.MessageEntity{
+				{Type: model.EntityBold, Offset: 0, Length: 14},
+				{Type: model.EntityBold, Offset: 5, Length: 4},
+				{Type: model.EntityItalic, Offset: 5, Length: 4},
+			},
+			want: []model.MessageEntity{
+				{Type: model.EntityBold, Offset: 0, Length: 14},
+				{Type: model.EntityItalic, Offset: 5, Length: 4},
+			},
+		},
+		{
+			name: "bare links are detected outside of other entities",
+			text: "docs at https://Example.com/a?b=1, code `https://skip.me`",
+			entities: []model.MessageEntity{
+				{Type: model.EntityCode, Offset: 40, Length: 17},
+			},
+			want: []model.MessageEntity{
+				{Type: model.EntityLink, Offset: 8, Length: 25, URL: "https://example.com/a?b=1"},
+				{Type: model.EntityCode, Offset: 40, Length: 17},
+			},
+		},
+		{
+			name: "entity out of text bounds",
+			text: "short",
+			entities: []model.MessageEntity{
+				{Type: model.EntityBold, Offset: 2, Length: 10},
+			},
+			err: invalid("content.entities[0]", "entity is out of text bounds"),
+		},
+		{
+			name: "link with unsupported scheme",
+			text: "click me",
+			entities: []model.MessageEntity{
+				{Type: model.EntityLink, Offset: 0, Length: 5, URL: "javascript:alert(1)"},
+			},
+			err: invalid("content.entities[0]", "link must be an absolute http or https url"),
+		},
+		{
+			name: "mention without user",
+			text: "hey bob",
+			entities: []model.MessageEntity{
+				{Type: model.EntityBold, Offset: 0, Length: 3},
+				{Type: model.EntityMention, Offset: 4, Length: 3},
+			},
+			err: invalid("content.entities[1]", "mention must have user id"),
+		},
+		{
+			name: "unknown entity type",
+			text: "text",
+			entities: []model.MessageEntity{
+				{Offset: 0, Length: 4},
+			},
+			err: invalid("content.entities[0]", `unknown entity type ""`),
+		},
+		{
+			name: "partially overlapping entities",
+			text: "overlapping",
+			entities: []model.MessageEntity{
+				{Type: model.EntityBold, Offset: 0, Length: 6},
+				{Type: model.EntityItalic, Offset: 4, Length: 6},
+			},
+			err: invalid("content.entities", "entities must not partially overlap"),
+		},
+		{
+			name: "markup inside code",
+			text: "code block",
+			entities: []model.MessageEntity{
+				{Type: model.EntityCode, Offset: 0, Length: 10},
+				{Type: model.EntityBold, Offset: 0, Length: 4},
+			},
+			err: invalid("content.entities", "code can not contain other entities"),
+		},
+		{
+			name: "mention inside link",
+			text: "bob's page",
+			entities: []model.MessageEntity{
+				{Type: model.EntityLink, Offset: 0, Length: 10, URL: "https://example.com"},
+				{Type: model.EntityMention, Offset: 0, Length: 3, UserID: 7},
+			},
+			err: invalid("content.entities", "links and mentions can not be nested"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			entities, err := content.Parse(tt.text, tt.entities)
+
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.want, entities)
+		})
+	}
+}
+
+func TestMentions(t *testing.T) {
+	entities := []model.MessageEntity{
+		{Type: model.EntityMention, Offset: 0, Length: 3, UserID: 2},
+		{Type: model.EntityBold, Offset: 4, Length: 3},
+		{Type: model.EntityMention, Offset: 8, Length: 3, UserID: 1},
+		{Type: model.EntityMention, Offset: 12, Length: 3, UserID: 2},
+	}
+
+	require.Equal(t, []uint64{2, 1}, content.Mentions(entities))
+	require.Empty(t, content.Mentions(nil))
+}
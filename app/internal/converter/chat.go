@@ -1,7 +1,13 @@
 package converter
 
 import (
+	"context"
+	"time"
+
+	"github.com/defany/chat-server/app/internal/auth"
+	"github.com/defany/chat-server/app/internal/model"
 	chatv1 "github.com/defany/chat-server/app/pkg/gen/chat/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type CreateChatInput struct {
@@ -14,23 +20,235 @@ type CreateChatOutput struct {
 	ID uint64
 }
 
+type CreateChannelInput struct {
+	Title       string
+	Description string
+	UserID      uint64
+}
+
+type SubscribeInput struct {
+	ChatID int64
+	UserID uint64
+}
+
+type GetOrCreateDirectChatInput struct {
+	UserID uint64
+	PeerID uint64
+}
+
+type GetOrCreateDirectChatOutput struct {
+	Chat    model.Chat
+	Created bool
+}
+
 type DeleteChatInput struct {
 	ChatID int64
 	UserID uint64
 }
 
+type RestoreChatInput struct {
+	ChatID int64
+	UserID uint64
+}
+
+type GetChatInput struct {
+	ChatID int64
+	UserID uint64
+}
+
+// UpdateChatInput nil в поле значит, что его не меняем
+type UpdateChatInput struct {
+	ChatID      int64
+	UserID      uint64
+	Title       *string
+	Description *string
+	AvatarURL   *string
+	Version     uint64
+}
+
+type AddMembersInput struct {
+	ChatID    int64
+	UserID    uint64
+	Nicknames []string
+}
+
+type RemoveMemberInput struct {
+	ChatID   int64
+	UserID   uint64
+	MemberID uint64
+}
+
+type SetMemberAdminInput struct {
+	ChatID   int64
+	UserID   uint64
+	MemberID uint64
+	Admin    bool
+}
+
+type LeaveChatInput struct {
+	ChatID int64
+	UserID uint64
+}
+
 type SendMessageInput struct {
+	ChatID       int64
+	From         uint64
+	Text         string
+	ClientSentAt *time.Time
+	// ClientMessageID пустой, если клиент не передал ключ идемпотентности
+	ClientMessageID string
+	// ReplyToMessageID 0, если сообщение не является ответом
+	ReplyToMessageID uint64
+	// AttachmentIDs заранее загруженные автором вложения
+	AttachmentIDs []uint64
+	Entities      []model.MessageEntity
+}
+
+type EditMessageInput struct {
+	ChatID    int64
+	MessageID uint64
+	UserID    uint64
+	Text      string
+	Entities  []model.MessageEntity
+}
+
+type DeleteMessageInput struct {
+	ChatID    int64
+	MessageID uint64
+	UserID    uint64
+}
+
+type ListThreadInput struct {
+	ChatID    int64
+	MessageID uint64
+	UserID    uint64
+	Cursor    string
+	Limit     int32
+	Direction model.Direction
+}
+
+type ListThreadOutput struct {
+	Root       model.Message
+	Replies    []model.Message
+	NextCursor string
+}
+
+type ReactionInput struct {
+	ChatID    int64
+	MessageID uint64
+	UserID    uint64
+	Emoji     string
+}
+
+type MarkReadInput struct {
+	ChatID    int64
+	MessageID uint64
+	UserID    uint64
+}
+
+type MuteChatInput struct {
 	ChatID int64
-	From   uint64
-	Text   string
+	UserID uint64
+	Muted  bool
+}
+
+type GetPresenceInput struct {
+	UserID uint64
+	// UserIDs чей статус хочет узнать UserID
+	UserIDs []uint64
+}
+
+type PresenceInput struct {
+	ChatID int64
+	Action model.PresenceAction
+}
+
+type ConnectChatInput struct {
+	ChatID int64
+	UserID uint64
+}
+
+type ListChatsInput struct {
+	UserID uint64
+	Cursor string
+	Limit  int32
+}
+
+type ListChatsOutput struct {
+	Chats      []model.ChatSummary
+	NextCursor string
+}
+
+type SearchMessagesInput struct {
+	UserID uint64
+	// ChatID 0, если ищем по всем чатам пользователя
+	ChatID int64
+	Query  string
+	Cursor string
+	Limit  int32
+}
+
+type SearchMessagesOutput struct {
+	Hits       []model.SearchHit
+	NextCursor string
+}
+
+type ListMessagesInput struct {
+	ChatID    int64
+	UserID    uint64
+	Cursor    string
+	Limit     int32
+	Direction model.Direction
 }
 
-func ToCreateChatInput(userID uint64, req *chatv1.CreateRequest) CreateChatInput {
+type ListMessagesOutput struct {
+	Messages   []model.Message
+	NextCursor string
+}
+
+type ListMentionsInput struct {
+	UserID uint64
+	Cursor string
+	Limit  int32
+}
+
+type ListMentionsOutput struct {
+	Messages   []model.Message
+	NextCursor string
+}
+
+type UploadAttachmentInput struct {
+	ChatID   int64
+	UserID   uint64
+	Filename string
+	MimeType string
+}
+
+type DownloadAttachmentInput struct {
+	AttachmentID uint64
+	UserID       uint64
+}
+
+func ToCreateChatInput(ctx context.Context, req *chatv1.CreateRequest) CreateChatInput {
 	return CreateChatInput{
 		Title:     req.GetTitle(),
 		Nicknames: req.GetUsernames(),
-		// Зафиксим это, когда будем делать авторизацию и будем брать из заголовка
-		UserID: userID,
+		UserID:    auth.UserID(ctx),
+	}
+}
+
+func ToCreateChannelInput(ctx context.Context, req *chatv1.CreateChannelRequest) CreateChannelInput {
+	return CreateChannelInput{
+		Title:       req.GetTitle(),
+		Description: req.GetDescription(),
+		UserID:      auth.UserID(ctx),
+	}
+}
+
+func ToSubscribeInput(ctx context.Context, req *chatv1.SubscribeRequest) SubscribeInput {
+	return SubscribeInput{
+		ChatID: req.GetChatId(),
+		UserID: auth.UserID(ctx),
 	}
 }
 
@@ -40,17 +258,583 @@ func FromCreateChatInput(input CreateChatOutput) *chatv1.CreateResponse {
 	}
 }
 
-func ToDeleteChatInput(userID uint64, req *chatv1.DeleteRequest) DeleteChatInput {
+func ToDeleteChatInput(ctx context.Context, req *chatv1.DeleteRequest) DeleteChatInput {
 	return DeleteChatInput{
 		ChatID: req.GetId(),
-		UserID: userID,
+		UserID: auth.UserID(ctx),
+	}
+}
+
+func ToRestoreChatInput(ctx context.Context, req *chatv1.RestoreChatRequest) RestoreChatInput {
+	return RestoreChatInput{
+		ChatID: req.GetId(),
+		UserID: auth.UserID(ctx),
+	}
+}
+
+func ToGetChatInput(ctx context.Context, req *chatv1.GetChatRequest) GetChatInput {
+	return GetChatInput{
+		ChatID: req.GetChatId(),
+		UserID: auth.UserID(ctx),
+	}
+}
+
+func ToUpdateChatInput(ctx context.Context, req *chatv1.UpdateChatRequest) UpdateChatInput {
+	return UpdateChatInput{
+		ChatID:      req.GetChatId(),
+		UserID:      auth.UserID(ctx),
+		Title:       req.Title,
+		Description: req.Description,
+		AvatarURL:   req.AvatarUrl,
+		Version:     uint64(req.GetVersion()),
+	}
+}
+
+func ToGetOrCreateDirectChatInput(ctx context.Context, req *chatv1.GetOrCreateDirectChatRequest) GetOrCreateDirectChatInput {
+	return GetOrCreateDirectChatInput{
+		UserID: auth.UserID(ctx),
+		PeerID: uint64(req.GetUserId()),
+	}
+}
+
+func FromGetOrCreateDirectChatOutput(output GetOrCreateDirectChatOutput) *chatv1.GetOrCreateDirectChatResponse {
+	return &chatv1.GetOrCreateDirectChatResponse{
+		Chat:    FromChat(output.Chat),
+		Created: output.Created,
+	}
+}
+
+func FromChat(chat model.Chat) *chatv1.ChatInfo {
+	return &chatv1.ChatInfo{
+		Id:            chat.ID,
+		Title:         chat.Title,
+		Description:   chat.Description,
+		AvatarUrl:     chat.AvatarURL,
+		Version:       int64(chat.Version),
+		LastMessageAt: timestamppb.New(chat.LastMessageAt),
+		Kind:          FromChatKind(chat.Kind),
+	}
+}
+
+func FromChatKind(kind model.ChatKind) chatv1.ChatKind {
+	switch kind {
+	case model.ChatKindDirect:
+		return chatv1.ChatKind_CHAT_KIND_DIRECT
+	case model.ChatKindGroup:
+		return chatv1.ChatKind_CHAT_KIND_GROUP
+	case model.ChatKindChannel:
+		return chatv1.ChatKind_CHAT_KIND_CHANNEL
+	}
+
+	return chatv1.ChatKind_CHAT_KIND_UNSPECIFIED
+}
+
+func ToAddMembersInput(ctx context.Context, req *chatv1.AddMembersRequest) AddMembersInput {
+	return AddMembersInput{
+		ChatID:    req.GetChatId(),
+		UserID:    auth.UserID(ctx),
+		Nicknames: req.GetUsernames(),
+	}
+}
+
+func ToRemoveMemberInput(ctx context.Context, req *chatv1.RemoveMemberRequest) RemoveMemberInput {
+	return RemoveMemberInput{
+		ChatID:   req.GetChatId(),
+		UserID:   auth.UserID(ctx),
+		MemberID: uint64(req.GetUserId()),
 	}
 }
 
-func ToSendMessageInput(req *chatv1.SendMessageRequest) SendMessageInput {
-	return SendMessageInput{
+func ToSetMemberAdminInput(ctx context.Context, req *chatv1.SetMemberAdminRequest) SetMemberAdminInput {
+	return SetMemberAdminInput{
+		ChatID:   req.GetChatId(),
+		UserID:   auth.UserID(ctx),
+		MemberID: uint64(req.GetUserId()),
+		Admin:    req.GetAdmin(),
+	}
+}
+
+func ToLeaveChatInput(ctx context.Context, req *chatv1.LeaveChatRequest) LeaveChatInput {
+	return LeaveChatInput{
+		ChatID: req.GetChatId(),
+		UserID: auth.UserID(ctx),
+	}
+}
+
+func ToSendMessageInput(ctx context.Context, req *chatv1.SendMessageRequest) SendMessageInput {
+	input := SendMessageInput{
 		ChatID: req.GetChatId(),
-		From:   uint64(req.GetFrom()),
+		From:   auth.UserID(ctx),
 		Text:   req.GetText(),
+
+		ClientMessageID:  req.GetClientMessageId(),
+		ReplyToMessageID: uint64(req.GetReplyToMessageId()),
+	}
+
+	for _, id := range req.GetAttachmentIds() {
+		input.AttachmentIDs = append(input.AttachmentIDs, uint64(id))
+	}
+
+	if content := req.GetContent(); content != nil {
+		input.Text = content.GetText()
+		input.Entities = ToMessageEntities(content.GetEntities())
+	}
+
+	if req.GetTimestamp() != nil {
+		clientSentAt := req.GetTimestamp().AsTime()
+
+		input.ClientSentAt = &clientSentAt
+	}
+
+	return input
+}
+
+func FromSendMessageOutput(msg model.Message) *chatv1.SendMessageResponse {
+	return &chatv1.SendMessageResponse{
+		Message: FromMessage(msg),
+	}
+}
+
+func ToEditMessageInput(ctx context.Context, req *chatv1.EditMessageRequest) EditMessageInput {
+	input := EditMessageInput{
+		ChatID:    req.GetChatId(),
+		MessageID: uint64(req.GetMessageId()),
+		UserID:    auth.UserID(ctx),
+		Text:      req.GetText(),
+	}
+
+	if content := req.GetContent(); content != nil {
+		input.Text = content.GetText()
+		input.Entities = ToMessageEntities(content.GetEntities())
+	}
+
+	return input
+}
+
+func FromEditMessageOutput(msg model.Message) *chatv1.EditMessageResponse {
+	return &chatv1.EditMessageResponse{
+		Message: FromMessage(msg),
+	}
+}
+
+func ToDeleteMessageInput(ctx context.Context, req *chatv1.DeleteMessageRequest) DeleteMessageInput {
+	return DeleteMessageInput{
+		ChatID:    req.GetChatId(),
+		MessageID: uint64(req.GetMessageId()),
+		UserID:    auth.UserID(ctx),
+	}
+}
+
+func ToConnectChatInput(ctx context.Context, req *chatv1.ConnectChatRequest) ConnectChatInput {
+	return ConnectChatInput{
+		ChatID: req.GetChatId(),
+		UserID: auth.UserID(ctx),
+	}
+}
+
+func FromMessage(msg model.Message) *chatv1.Message {
+	message := &chatv1.Message{
+		Id:        int64(msg.ID),
+		ChatId:    msg.ChatID,
+		From:      int64(msg.UserID),
+		Text:      msg.Text,
+		Timestamp: timestamppb.New(msg.Timestamp),
+	}
+
+	if msg.ClientSentAt != nil {
+		message.ClientSentAt = timestamppb.New(*msg.ClientSentAt)
+	}
+
+	if msg.ClientMessageID != nil {
+		message.ClientMessageId = *msg.ClientMessageID
+	}
+
+	if msg.EditedAt != nil {
+		message.EditedAt = timestamppb.New(*msg.EditedAt)
+	}
+
+	if msg.DeletedAt != nil {
+		message.Deleted = true
+	}
+
+	if msg.ReplyToMessageID != nil {
+		message.ReplyToMessageId = int64(*msg.ReplyToMessageID)
+	}
+
+	message.System = msg.System
+
+	message.Content = &chatv1.MessageContent{
+		Text:     msg.Text,
+		Entities: FromMessageEntities(msg.Entities),
+	}
+
+	for _, reaction := range msg.Reactions {
+		message.Reactions = append(message.Reactions, &chatv1.ReactionCount{
+			Emoji: reaction.Emoji,
+			Count: int64(reaction.Count),
+		})
+	}
+
+	for _, attachment := range msg.Attachments {
+		message.Attachments = append(message.Attachments, FromAttachment(attachment))
+	}
+
+	return message
+}
+
+func ToMessageEntities(entities []*chatv1.MessageEntity) []model.MessageEntity {
+	if len(entities) == 0 {
+		return nil
+	}
+
+	converted := make([]model.MessageEntity, 0, len(entities))
+
+	for _, entity := range entities {
+		converted = append(converted, model.MessageEntity{
+			Type:   toEntityType(entity.GetType()),
+			Offset: int(entity.GetOffset()),
+			Length: int(entity.GetLength()),
+			URL:    entity.GetUrl(),
+			UserID: uint64(entity.GetUserId()),
+		})
+	}
+
+	return converted
+}
+
+func FromMessageEntities(entities []model.MessageEntity) []*chatv1.MessageEntity {
+	converted := make([]*chatv1.MessageEntity, 0, len(entities))
+
+	for _, entity := range entities {
+		converted = append(converted, &chatv1.MessageEntity{
+			Type:   fromEntityType(entity.Type),
+			Offset: int32(entity.Offset),
+			Length: int32(entity.Length),
+			Url:    entity.URL,
+			UserId: int64(entity.UserID),
+		})
+	}
+
+	return converted
+}
+
+func toEntityType(t chatv1.MessageEntityType) model.EntityType {
+	switch t {
+	case chatv1.MessageEntityType_MESSAGE_ENTITY_TYPE_BOLD:
+		return model.EntityBold
+	case chatv1.MessageEntityType_MESSAGE_ENTITY_TYPE_ITALIC:
+		return model.EntityItalic
+	case chatv1.MessageEntityType_MESSAGE_ENTITY_TYPE_CODE:
+		return model.EntityCode
+	case chatv1.MessageEntityType_MESSAGE_ENTITY_TYPE_LINK:
+		return model.EntityLink
+	case chatv1.MessageEntityType_MESSAGE_ENTITY_TYPE_MENTION:
+		return model.EntityMention
+	}
+
+	return ""
+}
+
+func fromEntityType(t model.EntityType) chatv1.MessageEntityType {
+	switch t {
+	case model.EntityBold:
+		return chatv1.MessageEntityType_MESSAGE_ENTITY_TYPE_BOLD
+	case model.EntityItalic:
+		return chatv1.MessageEntityType_MESSAGE_ENTITY_TYPE_ITALIC
+	case model.EntityCode:
+		return chatv1.MessageEntityType_MESSAGE_ENTITY_TYPE_CODE
+	case model.EntityLink:
+		return chatv1.MessageEntityType_MESSAGE_ENTITY_TYPE_LINK
+	case model.EntityMention:
+		return chatv1.MessageEntityType_MESSAGE_ENTITY_TYPE_MENTION
+	}
+
+	return chatv1.MessageEntityType_MESSAGE_ENTITY_TYPE_UNSPECIFIED
+}
+
+func FromAttachment(attachment model.Attachment) *chatv1.Attachment {
+	return &chatv1.Attachment{
+		Id:       int64(attachment.ID),
+		Filename: attachment.Filename,
+		MimeType: attachment.MimeType,
+		Size:     attachment.Size,
+		Sha256:   attachment.SHA256,
+	}
+}
+
+func ToListChatsInput(ctx context.Context, req *chatv1.ListChatsRequest) ListChatsInput {
+	return ListChatsInput{
+		UserID: auth.UserID(ctx),
+		Cursor: req.GetCursor(),
+		Limit:  req.GetLimit(),
+	}
+}
+
+func ToSearchMessagesInput(ctx context.Context, req *chatv1.SearchMessagesRequest) SearchMessagesInput {
+	return SearchMessagesInput{
+		UserID: auth.UserID(ctx),
+		ChatID: req.GetChatId(),
+		Query:  req.GetQuery(),
+		Cursor: req.GetCursor(),
+		Limit:  req.GetLimit(),
+	}
+}
+
+func FromSearchMessagesOutput(output SearchMessagesOutput) *chatv1.SearchMessagesResponse {
+	hits := make([]*chatv1.SearchHit, 0, len(output.Hits))
+
+	for _, hit := range output.Hits {
+		hits = append(hits, &chatv1.SearchHit{
+			Message: FromMessage(hit.Message),
+			Snippet: hit.Snippet,
+		})
+	}
+
+	return &chatv1.SearchMessagesResponse{
+		Hits:       hits,
+		NextCursor: output.NextCursor,
+	}
+}
+
+func FromListChatsOutput(output ListChatsOutput) *chatv1.ListChatsResponse {
+	chats := make([]*chatv1.ChatSummary, 0, len(output.Chats))
+
+	for _, summary := range output.Chats {
+		chat := &chatv1.ChatSummary{
+			Id:            summary.ID,
+			Title:         summary.Title,
+			MemberCount:   int64(summary.MemberCount),
+			UnreadCount:   int64(summary.UnreadCount),
+			LastMessageAt: timestamppb.New(summary.LastMessageAt),
+			Kind:          FromChatKind(summary.Kind),
+		}
+
+		if summary.LastMessage != nil {
+			chat.LastMessage = FromMessage(*summary.LastMessage)
+		}
+
+		chats = append(chats, chat)
+	}
+
+	return &chatv1.ListChatsResponse{
+		Chats:      chats,
+		NextCursor: output.NextCursor,
+	}
+}
+
+func ToListMessagesInput(ctx context.Context, req *chatv1.ListMessagesRequest) ListMessagesInput {
+	return ListMessagesInput{
+		ChatID:    req.GetChatId(),
+		UserID:    auth.UserID(ctx),
+		Cursor:    req.GetCursor(),
+		Limit:     req.GetLimit(),
+		Direction: toDirection(req.GetDirection()),
+	}
+}
+
+func FromListMessagesOutput(output ListMessagesOutput) *chatv1.ListMessagesResponse {
+	messages := make([]*chatv1.Message, 0, len(output.Messages))
+
+	for _, msg := range output.Messages {
+		messages = append(messages, FromMessage(msg))
+	}
+
+	return &chatv1.ListMessagesResponse{
+		Messages:   messages,
+		NextCursor: output.NextCursor,
+	}
+}
+
+func ToListMentionsInput(ctx context.Context, req *chatv1.ListMentionsRequest) ListMentionsInput {
+	return ListMentionsInput{
+		UserID: auth.UserID(ctx),
+		Cursor: req.GetCursor(),
+		Limit:  req.GetLimit(),
+	}
+}
+
+func FromListMentionsOutput(output ListMentionsOutput) *chatv1.ListMentionsResponse {
+	messages := make([]*chatv1.Message, 0, len(output.Messages))
+
+	for _, msg := range output.Messages {
+		messages = append(messages, FromMessage(msg))
+	}
+
+	return &chatv1.ListMentionsResponse{
+		Messages:   messages,
+		NextCursor: output.NextCursor,
+	}
+}
+
+func toDirection(direction chatv1.ListDirection) model.Direction {
+	if direction == chatv1.ListDirection_LIST_DIRECTION_FORWARD {
+		return model.DirectionForward
+	}
+
+	return model.DirectionBackward
+}
+
+func ToListThreadInput(ctx context.Context, req *chatv1.ListThreadRequest) ListThreadInput {
+	input := ListThreadInput{
+		ChatID:    req.GetChatId(),
+		MessageID: uint64(req.GetMessageId()),
+		UserID:    auth.UserID(ctx),
+		Cursor:    req.GetCursor(),
+		Limit:     req.GetLimit(),
+		Direction: model.DirectionForward,
+	}
+
+	// Ветку обычно читают сверху вниз, поэтому по умолчанию от старых ответов к новым
+	if req.GetDirection() == chatv1.ListDirection_LIST_DIRECTION_BACKWARD {
+		input.Direction = model.DirectionBackward
+	}
+
+	return input
+}
+
+func FromListThreadOutput(output ListThreadOutput) *chatv1.ListThreadResponse {
+	replies := make([]*chatv1.Message, 0, len(output.Replies))
+	for _, msg := range output.Replies {
+		replies = append(replies, FromMessage(msg))
+	}
+
+	return &chatv1.ListThreadResponse{
+		Root:       FromMessage(output.Root),
+		Replies:    replies,
+		NextCursor: output.NextCursor,
+	}
+}
+
+func ToAddReactionInput(ctx context.Context, req *chatv1.AddReactionRequest) ReactionInput {
+	return ReactionInput{
+		ChatID:    req.GetChatId(),
+		MessageID: uint64(req.GetMessageId()),
+		UserID:    auth.UserID(ctx),
+		Emoji:     req.GetEmoji(),
+	}
+}
+
+func ToRemoveReactionInput(ctx context.Context, req *chatv1.RemoveReactionRequest) ReactionInput {
+	return ReactionInput{
+		ChatID:    req.GetChatId(),
+		MessageID: uint64(req.GetMessageId()),
+		UserID:    auth.UserID(ctx),
+		Emoji:     req.GetEmoji(),
+	}
+}
+
+func ToMarkReadInput(ctx context.Context, req *chatv1.MarkReadRequest) MarkReadInput {
+	return MarkReadInput{
+		ChatID:    req.GetChatId(),
+		MessageID: uint64(req.GetMessageId()),
+		UserID:    auth.UserID(ctx),
+	}
+}
+
+func ToMuteChatInput(ctx context.Context, req *chatv1.MuteChatRequest) MuteChatInput {
+	return MuteChatInput{
+		ChatID: req.GetChatId(),
+		UserID: auth.UserID(ctx),
+		Muted:  req.GetMuted(),
+	}
+}
+
+func FromUnreadCounts(counts []model.UnreadCount) *chatv1.GetUnreadCountsResponse {
+	res := &chatv1.GetUnreadCountsResponse{
+		Counts: make([]*chatv1.ChatUnreadCount, 0, len(counts)),
+	}
+
+	for _, count := range counts {
+		res.Counts = append(res.Counts, &chatv1.ChatUnreadCount{
+			ChatId:            count.ChatID,
+			UnreadCount:       int64(count.Count),
+			LastReadMessageId: int64(count.LastReadMessageID),
+		})
+	}
+
+	return res
+}
+
+func ToPresenceInput(req *chatv1.PresenceRequest) PresenceInput {
+	input := PresenceInput{
+		ChatID: req.GetChatId(),
+	}
+
+	switch req.GetAction() {
+	case chatv1.PresenceAction_PRESENCE_ACTION_JOIN:
+		input.Action = model.PresenceActionJoin
+	case chatv1.PresenceAction_PRESENCE_ACTION_LEAVE:
+		input.Action = model.PresenceActionLeave
+	case chatv1.PresenceAction_PRESENCE_ACTION_TYPING_START:
+		input.Action = model.PresenceActionTypingStart
+	case chatv1.PresenceAction_PRESENCE_ACTION_TYPING_STOP:
+		input.Action = model.PresenceActionTypingStop
+	}
+
+	return input
+}
+
+func FromPresenceEvent(event model.PresenceEvent) *chatv1.PresenceEvent {
+	return &chatv1.PresenceEvent{
+		ChatId: event.ChatID,
+		UserId: int64(event.UserID),
+		Online: event.Online,
+		Typing: event.Typing,
+	}
+}
+
+func ToGetPresenceInput(ctx context.Context, req *chatv1.GetPresenceRequest) GetPresenceInput {
+	userIDs := make([]uint64, 0, len(req.GetUserIds()))
+
+	for _, userID := range req.GetUserIds() {
+		userIDs = append(userIDs, uint64(userID))
+	}
+
+	return GetPresenceInput{
+		UserID:  auth.UserID(ctx),
+		UserIDs: userIDs,
+	}
+}
+
+func FromUserPresences(presences []model.UserPresence) *chatv1.GetPresenceResponse {
+	res := &chatv1.GetPresenceResponse{
+		Presences: make([]*chatv1.UserPresence, 0, len(presences)),
+	}
+
+	for _, presence := range presences {
+		userPresence := &chatv1.UserPresence{
+			UserId: int64(presence.UserID),
+			Online: presence.Online,
+		}
+
+		if presence.LastSeenAt != nil {
+			userPresence.LastSeenAt = timestamppb.New(*presence.LastSeenAt)
+		}
+
+		res.Presences = append(res.Presences, userPresence)
+	}
+
+	return res
+}
+
+func ToUploadAttachmentInput(ctx context.Context, info *chatv1.AttachmentInfo) UploadAttachmentInput {
+	return UploadAttachmentInput{
+		ChatID:   info.GetChatId(),
+		UserID:   auth.UserID(ctx),
+		Filename: info.GetFilename(),
+		MimeType: info.GetMimeType(),
+	}
+}
+
+func FromUploadAttachmentOutput(attachment model.Attachment) *chatv1.UploadAttachmentResponse {
+	return &chatv1.UploadAttachmentResponse{
+		Attachment: FromAttachment(attachment),
+	}
+}
+
+func ToDownloadAttachmentInput(ctx context.Context, req *chatv1.DownloadAttachmentRequest) DownloadAttachmentInput {
+	return DownloadAttachmentInput{
+		AttachmentID: uint64(req.GetAttachmentId()),
+		UserID:       auth.UserID(ctx),
 	}
 }
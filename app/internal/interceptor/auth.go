@@ -0,0 +1,65 @@
+package interceptor
+
+import (
+	"context"
+	"strings"
+
+	"github.com/defany/chat-server/app/internal/auth"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	authorizationHeader = "authorization"
+	bearerPrefix        = "Bearer "
+)
+
+// reflectionPrefix пропускаем без токена, иначе grpcurl и evans не смогут получить схему
+const reflectionPrefix = "/grpc.reflection."
+
+func Auth(verifier auth.Verifier) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		ctx, err := authenticate(ctx, verifier)
+		if err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+func AuthStream(verifier auth.Verifier) grpc.StreamServerInterceptor {
+	return func(srv any, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if strings.HasPrefix(info.FullMethod, reflectionPrefix) {
+			return handler(srv, stream)
+		}
+
+		ctx, err := authenticate(stream.Context(), verifier)
+		if err != nil {
+			return err
+		}
+
+		return handler(srv, wrapServerStream(ctx, stream))
+	}
+}
+
+func authenticate(ctx context.Context, verifier auth.Verifier) (context.Context, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "metadata is not provided")
+	}
+
+	values := md.Get(authorizationHeader)
+	if len(values) == 0 || !strings.HasPrefix(values[0], bearerPrefix) {
+		return nil, status.Error(codes.Unauthenticated, "bearer token is not provided")
+	}
+
+	userID, err := verifier.Verify(strings.TrimPrefix(values[0], bearerPrefix))
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "invalid token")
+	}
+
+	return auth.InjectUserID(ctx, userID), nil
+}
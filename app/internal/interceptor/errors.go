@@ -0,0 +1,124 @@
+package interceptor
+
+import (
+	"context"
+	"errors"
+
+	"github.com/defany/chat-server/app/internal/apperr"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/protoadapt"
+)
+
+const errorDomain = "chat-server"
+
+var grpcCodes = map[apperr.Code]codes.Code{
+	apperr.CodeInternal:           codes.Internal,
+	apperr.CodeNotFound:           codes.NotFound,
+	apperr.CodeAlreadyExists:      codes.AlreadyExists,
+	apperr.CodeInvalidArgument:    codes.InvalidArgument,
+	apperr.CodePermissionDenied:   codes.PermissionDenied,
+	apperr.CodeConflict:           codes.Aborted,
+	apperr.CodeFailedPrecondition: codes.FailedPrecondition,
+}
+
+func Errors() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		res, err := handler(ctx, req)
+		if err != nil {
+			return nil, toStatusError(err)
+		}
+
+		return res, nil
+	}
+}
+
+func ErrorsStream() grpc.StreamServerInterceptor {
+	return func(srv any, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := handler(srv, stream); err != nil {
+			return toStatusError(err)
+		}
+
+		return nil
+	}
+}
+
+func toStatusError(err error) error {
+	// Отмена и дедлайн клиента - не внутренняя ошибка, текст берем у самой контекстной ошибки без обертки op'ами
+	for _, ctxErr := range []error{context.Canceled, context.DeadlineExceeded} {
+		if errors.Is(err, ctxErr) {
+			return status.FromContextError(ctxErr).Err()
+		}
+	}
+
+	appErr, ok := apperr.As(err)
+	if !ok {
+		// Ошибки, которые уже стали статусом (например, из auth интерцептора), отдаем как есть,
+		// а все остальное прячем, чтобы не светить наружу внутренности
+		if _, ok := status.FromError(err); ok {
+			return err
+		}
+
+		return status.Error(codes.Internal, "internal error")
+	}
+
+	code, ok := grpcCodes[appErr.Code]
+	if !ok {
+		code = codes.Internal
+	}
+
+	if code == codes.Internal {
+		return status.Error(codes.Internal, "internal error")
+	}
+
+	st := status.New(code, appErr.Message)
+
+	details := []proto.Message{
+		&errdetails.ErrorInfo{
+			Reason: appErr.Code.String(),
+			Domain: errorDomain,
+		},
+	}
+
+	switch appErr.Code {
+	case apperr.CodeInvalidArgument:
+		if len(appErr.Violations) == 0 {
+			break
+		}
+
+		badRequest := &errdetails.BadRequest{}
+		for _, v := range appErr.Violations {
+			badRequest.FieldViolations = append(badRequest.FieldViolations, &errdetails.BadRequest_FieldViolation{
+				Field:       v.Field,
+				Description: v.Description,
+			})
+		}
+
+		details = append(details, badRequest)
+	case apperr.CodeFailedPrecondition:
+		details = append(details, &errdetails.PreconditionFailure{
+			Violations: []*errdetails.PreconditionFailure_Violation{
+				{
+					Type:        appErr.Code.String(),
+					Description: appErr.Message,
+				},
+			},
+		})
+	}
+
+	// status пока принимает сообщения старого API, переводим их адаптером
+	v1 := make([]protoadapt.MessageV1, 0, len(details))
+	for _, detail := range details {
+		v1 = append(v1, protoadapt.MessageV1Of(detail))
+	}
+
+	withDetails, err := st.WithDetails(v1...)
+	if err != nil {
+		return st.Err()
+	}
+
+	return withDetails.Err()
+}
@@ -0,0 +1,167 @@
+// Code generated by mockery v2.42.0. DO NOT EDIT.
+
+package mockinterceptor
+
+import mock "github.com/stretchr/testify/mock"
+
+// MockfieldError is an autogenerated mock type for the fieldError type
+type MockfieldError struct {
+	mock.Mock
+}
+
+type MockfieldError_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockfieldError) EXPECT() *MockfieldError_Expecter {
+	return &MockfieldError_Expecter{mock: &_m.Mock}
+}
+
+// Error provides a mock function with given fields:
+func (_m *MockfieldError) Error() string {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Error")
+	}
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// MockfieldError_Error_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Error'
+type MockfieldError_Error_Call struct {
+	*mock.Call
+}
+
+// Error is a helper method to define mock.On call
+func (_e *MockfieldError_Expecter) Error() *MockfieldError_Error_Call {
+	return &MockfieldError_Error_Call{Call: _e.mock.On("Error")}
+}
+
+func (_c *MockfieldError_Error_Call) Run(run func()) *MockfieldError_Error_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockfieldError_Error_Call) Return(_a0 string) *MockfieldError_Error_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockfieldError_Error_Call) RunAndReturn(run func() string) *MockfieldError_Error_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Field provides a mock function with given fields:
+func (_m *MockfieldError) Field() string {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Field")
+	}
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// MockfieldError_Field_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Field'
+type MockfieldError_Field_Call struct {
+	*mock.Call
+}
+
+// Field is a helper method to define mock.On call
+func (_e *MockfieldError_Expecter) Field() *MockfieldError_Field_Call {
+	return &MockfieldError_Field_Call{Call: _e.mock.On("Field")}
+}
+
+func (_c *MockfieldError_Field_Call) Run(run func()) *MockfieldError_Field_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockfieldError_Field_Call) Return(_a0 string) *MockfieldError_Field_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockfieldError_Field_Call) RunAndReturn(run func() string) *MockfieldError_Field_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Reason provides a mock function with given fields:
+func (_m *MockfieldError) Reason() string {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Reason")
+	}
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// MockfieldError_Reason_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Reason'
+type MockfieldError_Reason_Call struct {
+	*mock.Call
+}
+
+// Reason is a helper method to define mock.On call
+func (_e *MockfieldError_Expecter) Reason() *MockfieldError_Reason_Call {
+	return &MockfieldError_Reason_Call{Call: _e.mock.On("Reason")}
+}
+
+func (_c *MockfieldError_Reason_Call) Run(run func()) *MockfieldError_Reason_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockfieldError_Reason_Call) Return(_a0 string) *MockfieldError_Reason_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockfieldError_Reason_Call) RunAndReturn(run func() string) *MockfieldError_Reason_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockfieldError creates a new instance of MockfieldError. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockfieldError(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockfieldError {
+	mock := &MockfieldError{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
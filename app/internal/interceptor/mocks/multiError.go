@@ -0,0 +1,79 @@
+// Code generated by mockery v2.42.0. DO NOT EDIT.
+
+package mockinterceptor
+
+import mock "github.com/stretchr/testify/mock"
+
+// MockmultiError is an autogenerated mock type for the multiError type
+type MockmultiError struct {
+	mock.Mock
+}
+
+type MockmultiError_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockmultiError) EXPECT() *MockmultiError_Expecter {
+	return &MockmultiError_Expecter{mock: &_m.Mock}
+}
+
+// AllErrors provides a mock function with given fields:
+func (_m *MockmultiError) AllErrors() []error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for AllErrors")
+	}
+
+	var r0 []error
+	if rf, ok := ret.Get(0).(func() []error); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]error)
+		}
+	}
+
+	return r0
+}
+
+// MockmultiError_AllErrors_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AllErrors'
+type MockmultiError_AllErrors_Call struct {
+	*mock.Call
+}
+
+// AllErrors is a helper method to define mock.On call
+func (_e *MockmultiError_Expecter) AllErrors() *MockmultiError_AllErrors_Call {
+	return &MockmultiError_AllErrors_Call{Call: _e.mock.On("AllErrors")}
+}
+
+func (_c *MockmultiError_AllErrors_Call) Run(run func()) *MockmultiError_AllErrors_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockmultiError_AllErrors_Call) Return(_a0 []error) *MockmultiError_AllErrors_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockmultiError_AllErrors_Call) RunAndReturn(run func() []error) *MockmultiError_AllErrors_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockmultiError creates a new instance of MockmultiError. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockmultiError(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockmultiError {
+	mock := &MockmultiError{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,77 @@
+// Code generated by mockery v2.42.0. DO NOT EDIT.
+
+package mockinterceptor
+
+import mock "github.com/stretchr/testify/mock"
+
+// MockvalidatorAll is an autogenerated mock type for the validatorAll type
+type MockvalidatorAll struct {
+	mock.Mock
+}
+
+type MockvalidatorAll_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockvalidatorAll) EXPECT() *MockvalidatorAll_Expecter {
+	return &MockvalidatorAll_Expecter{mock: &_m.Mock}
+}
+
+// ValidateAll provides a mock function with given fields:
+func (_m *MockvalidatorAll) ValidateAll() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for ValidateAll")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockvalidatorAll_ValidateAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ValidateAll'
+type MockvalidatorAll_ValidateAll_Call struct {
+	*mock.Call
+}
+
+// ValidateAll is a helper method to define mock.On call
+func (_e *MockvalidatorAll_Expecter) ValidateAll() *MockvalidatorAll_ValidateAll_Call {
+	return &MockvalidatorAll_ValidateAll_Call{Call: _e.mock.On("ValidateAll")}
+}
+
+func (_c *MockvalidatorAll_ValidateAll_Call) Run(run func()) *MockvalidatorAll_ValidateAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockvalidatorAll_ValidateAll_Call) Return(_a0 error) *MockvalidatorAll_ValidateAll_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockvalidatorAll_ValidateAll_Call) RunAndReturn(run func() error) *MockvalidatorAll_ValidateAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockvalidatorAll creates a new instance of MockvalidatorAll. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockvalidatorAll(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockvalidatorAll {
+	mock := &MockvalidatorAll{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
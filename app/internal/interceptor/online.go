@@ -0,0 +1,45 @@
+package interceptor
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/defany/chat-server/app/internal/auth"
+	servicedef "github.com/defany/chat-server/app/internal/service"
+	chatv1 "github.com/defany/chat-server/app/pkg/gen/chat/v1"
+	"github.com/defany/slogger/pkg/logger/sl"
+	"google.golang.org/grpc"
+)
+
+// sessionStreams открыты, пока клиент запущен. Загрузка и скачивание файлов тоже стримы, но онлайн пользователя не делают
+var sessionStreams = map[string]struct{}{
+	chatv1.Chat_ConnectChat_FullMethodName: {},
+	chatv1.Chat_Presence_FullMethodName:    {},
+}
+
+// OnlineStream считает пользователя онлайн, пока у него открыт хотя бы один стрим сессии
+func OnlineStream(log *slog.Logger, online servicedef.Online) grpc.StreamServerInterceptor {
+	return func(srv any, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx := stream.Context()
+
+		if _, ok := sessionStreams[info.FullMethod]; !ok {
+			return handler(srv, stream)
+		}
+
+		userID, ok := auth.ExtractUserID(ctx)
+		if !ok {
+			return handler(srv, stream)
+		}
+
+		online.Connect(ctx, userID)
+
+		defer func() {
+			// Контекст стрима к этому моменту уже отменен, а отметку сохранить нужно
+			if err := online.Disconnect(context.WithoutCancel(ctx), userID); err != nil {
+				log.Error("failed to save last seen", slog.String("method", info.FullMethod), sl.ErrAttr(err))
+			}
+		}()
+
+		return handler(srv, stream)
+	}
+}
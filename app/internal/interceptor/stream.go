@@ -0,0 +1,24 @@
+package interceptor
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+)
+
+type serverStream struct {
+	grpc.ServerStream
+
+	ctx context.Context
+}
+
+func wrapServerStream(ctx context.Context, stream grpc.ServerStream) grpc.ServerStream {
+	return &serverStream{
+		ServerStream: stream,
+		ctx:          ctx,
+	}
+}
+
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
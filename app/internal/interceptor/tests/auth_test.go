@@ -0,0 +1,101 @@
+package interceptortests
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/defany/chat-server/app/internal/auth"
+	"github.com/defany/chat-server/app/internal/config"
+	"github.com/defany/chat-server/app/internal/interceptor"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func signToken(t *testing.T, secret string, claims jwt.RegisteredClaims) string {
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(secret))
+	require.NoError(t, err)
+
+	return token
+}
+
+func TestInterceptor_Auth(t *testing.T) {
+	var (
+		secret = gofakeit.Password(true, true, true, false, false, 32)
+
+		userID = gofakeit.Uint64()
+
+		validToken = signToken(t, secret, jwt.RegisteredClaims{
+			Subject:   strconv.FormatUint(userID, 10),
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+		})
+
+		expiredToken = signToken(t, secret, jwt.RegisteredClaims{
+			Subject:   strconv.FormatUint(userID, 10),
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(-time.Hour)),
+		})
+
+		foreignToken = signToken(t, "another secret", jwt.RegisteredClaims{
+			Subject:   strconv.FormatUint(userID, 10),
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+		})
+	)
+
+	verifier, err := auth.NewJWTVerifier(config.Auth{
+		Algorithm: jwt.SigningMethodHS256.Alg(),
+		Secret:    secret,
+	})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name   string
+		md     metadata.MD
+		userID uint64
+		code   codes.Code
+	}{
+		{
+			name:   "valid token puts user id into context",
+			md:     metadata.Pairs("authorization", "Bearer "+validToken),
+			userID: userID,
+			code:   codes.OK,
+		},
+		{
+			name: "missing token",
+			md:   metadata.MD{},
+			code: codes.Unauthenticated,
+		},
+		{
+			name: "expired token",
+			md:   metadata.Pairs("authorization", "Bearer "+expiredToken),
+			code: codes.Unauthenticated,
+		},
+		{
+			name: "token signed with another key",
+			md:   metadata.Pairs("authorization", "Bearer "+foreignToken),
+			code: codes.Unauthenticated,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := metadata.NewIncomingContext(context.Background(), tt.md)
+
+			var got uint64
+
+			_, err := interceptor.Auth(verifier)(ctx, nil, &grpc.UnaryServerInfo{}, func(ctx context.Context, req any) (any, error) {
+				got = auth.UserID(ctx)
+
+				return nil, nil
+			})
+
+			require.Equal(t, tt.code, status.Code(err))
+			require.Equal(t, tt.userID, got)
+		})
+	}
+}
@@ -0,0 +1,107 @@
+package interceptortests
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/defany/chat-server/app/internal/apperr"
+	"github.com/defany/chat-server/app/internal/interceptor"
+	"github.com/defany/slogger/pkg/logger/sl"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestInterceptor_Errors(t *testing.T) {
+	tests := []struct {
+		name       string
+		err        error
+		code       codes.Code
+		message    string
+		violations []*errdetails.BadRequest_FieldViolation
+	}{
+		{
+			name:    "not found",
+			err:     sl.Err("service.DeleteChat", apperr.NotFound("chat not found")),
+			code:    codes.NotFound,
+			message: "chat not found",
+		},
+		{
+			name:    "permission denied",
+			err:     sl.Err("service.SendMessage", apperr.PermissionDenied("user is not a member of the chat")),
+			code:    codes.PermissionDenied,
+			message: "user is not a member of the chat",
+		},
+		{
+			name:    "conflict",
+			err:     apperr.Conflict("chat was changed"),
+			code:    codes.Aborted,
+			message: "chat was changed",
+		},
+		{
+			name: "invalid argument with field violations",
+			err: apperr.InvalidArgument("invalid cursor", apperr.FieldViolation{
+				Field:       "cursor",
+				Description: "cursor must be taken from the previous page",
+			}),
+			code:    codes.InvalidArgument,
+			message: "invalid cursor",
+			violations: []*errdetails.BadRequest_FieldViolation{
+				{Field: "cursor", Description: "cursor must be taken from the previous page"},
+			},
+		},
+		{
+			name:    "status error passes through",
+			err:     status.Error(codes.Unauthenticated, "missing token"),
+			code:    codes.Unauthenticated,
+			message: "missing token",
+		},
+		{
+			name:    "canceled request",
+			err:     sl.Err("service.ListMessages", context.Canceled),
+			code:    codes.Canceled,
+			message: context.Canceled.Error(),
+		},
+		{
+			name:    "deadline exceeded inside app error",
+			err:     apperr.Wrap(apperr.CodeInternal, "failed to list chats", context.DeadlineExceeded),
+			code:    codes.DeadlineExceeded,
+			message: context.DeadlineExceeded.Error(),
+		},
+		{
+			name:    "unknown error is hidden",
+			err:     errors.New("connection refused"),
+			code:    codes.Internal,
+			message: "internal error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := interceptor.Errors()(context.Background(), nil, &grpc.UnaryServerInfo{}, func(ctx context.Context, req any) (any, error) {
+				return nil, tt.err
+			})
+
+			st, ok := status.FromError(err)
+			require.True(t, ok)
+			require.Equal(t, tt.code, st.Code())
+			require.Equal(t, tt.message, st.Message())
+
+			var violations []*errdetails.BadRequest_FieldViolation
+			for _, detail := range st.Details() {
+				if badRequest, ok := detail.(*errdetails.BadRequest); ok {
+					violations = badRequest.GetFieldViolations()
+				}
+			}
+
+			require.Len(t, violations, len(tt.violations))
+			for i, v := range tt.violations {
+				require.Equal(t, v.GetField(), violations[i].GetField())
+				require.Equal(t, v.GetDescription(), violations[i].GetDescription())
+			}
+		})
+	}
+}
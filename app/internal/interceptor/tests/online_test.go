@@ -0,0 +1,106 @@
+package interceptortests
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/defany/chat-server/app/internal/auth"
+	"github.com/defany/chat-server/app/internal/interceptor"
+	mockservicedef "github.com/defany/chat-server/app/internal/service/mocks"
+	"github.com/defany/slogger/pkg/logger/handlers/slogpretty"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+type onlineStream struct {
+	grpc.ServerStream
+
+	ctx context.Context
+}
+
+func (s *onlineStream) Context() context.Context {
+	return s.ctx
+}
+
+func TestInterceptor_OnlineStream(t *testing.T) {
+	var (
+		userID = gofakeit.Uint64()
+
+		handlerErr = errors.New("stream closed")
+	)
+
+	tests := []struct {
+		name   string
+		ctx    context.Context
+		method string
+		online bool
+	}{
+		{
+			name:   "user is online while stream is open",
+			ctx:    auth.InjectUserID(context.Background(), userID),
+			method: "/chat.v1.Chat/ConnectChat",
+			online: true,
+		},
+		{
+			name:   "presence stream keeps user online",
+			ctx:    auth.InjectUserID(context.Background(), userID),
+			method: "/chat.v1.Chat/Presence",
+			online: true,
+		},
+		{
+			name:   "file download does not make user online",
+			ctx:    auth.InjectUserID(context.Background(), userID),
+			method: "/chat.v1.Chat/DownloadAttachment",
+			online: false,
+		},
+		{
+			name:   "file upload does not make user online",
+			ctx:    auth.InjectUserID(context.Background(), userID),
+			method: "/chat.v1.Chat/UploadAttachment",
+			online: false,
+		},
+		{
+			name:   "reflection stream is not tracked",
+			ctx:    auth.InjectUserID(context.Background(), userID),
+			method: "/grpc.reflection.v1.ServerReflection/ServerReflectionInfo",
+			online: false,
+		},
+		{
+			name:   "anonymous stream is not tracked",
+			ctx:    context.Background(),
+			method: "/chat.v1.Chat/ConnectChat",
+			online: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			online := mockservicedef.NewMockOnline(t)
+
+			connected := false
+
+			if tt.online {
+				online.On("Connect", tt.ctx, userID).Run(func(mock.Arguments) {
+					connected = true
+				}).Return()
+
+				// Ошибка сохранения только логируется и не ломает ответ хендлера
+				online.On("Disconnect", mock.Anything, userID).Return(errors.New("connection refused"))
+			}
+
+			stream := &onlineStream{ctx: tt.ctx}
+
+			err := interceptor.OnlineStream(slog.New(slogpretty.NewHandler()), online)(nil, stream, &grpc.StreamServerInfo{FullMethod: tt.method}, func(srv any, stream grpc.ServerStream) error {
+				require.Equal(t, tt.online, connected)
+
+				return handlerErr
+			})
+
+			require.Equal(t, handlerErr, err)
+		})
+	}
+}
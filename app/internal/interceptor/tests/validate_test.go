@@ -0,0 +1,127 @@
+package interceptortests
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/defany/chat-server/app/internal/apperr"
+	"github.com/defany/chat-server/app/internal/interceptor"
+	chatv1 "github.com/defany/chat-server/app/pkg/gen/chat/v1"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestInterceptor_Validate(t *testing.T) {
+	tests := []struct {
+		name   string
+		req    any
+		fields []string
+	}{
+		{
+			name: "valid create request",
+			req: &chatv1.CreateRequest{
+				Title:     gofakeit.JobTitle(),
+				Usernames: []string{gofakeit.Username()},
+			},
+		},
+		{
+			name:   "create request without usernames",
+			req:    &chatv1.CreateRequest{},
+			fields: []string{"Usernames"},
+		},
+		{
+			name: "create request with too long title",
+			req: &chatv1.CreateRequest{
+				Title:     strings.Repeat("a", 256),
+				Usernames: []string{"bob"},
+			},
+			fields: []string{"Title"},
+		},
+		{
+			name: "create request with empty username",
+			req: &chatv1.CreateRequest{
+				Title:     gofakeit.JobTitle(),
+				Usernames: []string{gofakeit.Username(), ""},
+			},
+			fields: []string{"Usernames[1]"},
+		},
+		{
+			name:   "delete request with non positive id",
+			req:    &chatv1.DeleteRequest{Id: 0},
+			fields: []string{"Id"},
+		},
+		{
+			name: "send message request with too long text",
+			req: &chatv1.SendMessageRequest{
+				ChatId: 1,
+				Text:   strings.Repeat("a", 4097),
+			},
+			fields: []string{"Text"},
+		},
+		{
+			name: "list messages request with too big limit",
+			req: &chatv1.ListMessagesRequest{
+				ChatId: 1,
+				Limit:  101,
+			},
+			fields: []string{"Limit"},
+		},
+		{
+			name: "update chat request clears avatar",
+			req: &chatv1.UpdateChatRequest{
+				ChatId:    1,
+				AvatarUrl: proto.String(""),
+				Version:   1,
+			},
+		},
+		{
+			name: "update chat request with empty title and broken avatar url",
+			req: &chatv1.UpdateChatRequest{
+				ChatId:    1,
+				Title:     proto.String(""),
+				AvatarUrl: proto.String("not an url"),
+				Version:   1,
+			},
+			fields: []string{"Title", "AvatarUrl"},
+		},
+		{
+			name: "request without rules is passed as is",
+			req:  struct{}{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			called := false
+
+			_, err := interceptor.Validate()(context.Background(), tt.req, &grpc.UnaryServerInfo{}, func(ctx context.Context, req any) (any, error) {
+				called = true
+
+				return nil, nil
+			})
+
+			if len(tt.fields) == 0 {
+				require.NoError(t, err)
+				require.True(t, called)
+
+				return
+			}
+
+			require.False(t, called)
+
+			appErr, ok := apperr.As(err)
+			require.True(t, ok)
+			require.Equal(t, apperr.CodeInvalidArgument, appErr.Code)
+
+			var fields []string
+			for _, v := range appErr.Violations {
+				fields = append(fields, v.Field)
+			}
+
+			require.Equal(t, tt.fields, fields)
+		})
+	}
+}
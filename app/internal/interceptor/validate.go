@@ -0,0 +1,91 @@
+package interceptor
+
+import (
+	"context"
+	"errors"
+
+	"github.com/defany/chat-server/app/internal/apperr"
+	"google.golang.org/grpc"
+)
+
+// validatorAll реализуют все сообщения, сгенерированные protoc-gen-validate
+type validatorAll interface {
+	ValidateAll() error
+}
+
+// fieldError - общий интерфейс для <Message>ValidationError
+type fieldError interface {
+	error
+
+	Field() string
+	Reason() string
+}
+
+type multiError interface {
+	AllErrors() []error
+}
+
+func Validate() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if err := validate(req); err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+func ValidateStream() grpc.StreamServerInterceptor {
+	return func(srv any, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return handler(srv, &validatingServerStream{ServerStream: stream})
+	}
+}
+
+type validatingServerStream struct {
+	grpc.ServerStream
+}
+
+func (s *validatingServerStream) RecvMsg(m any) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+
+	return validate(m)
+}
+
+func validate(req any) error {
+	v, ok := req.(validatorAll)
+	if !ok {
+		return nil
+	}
+
+	err := v.ValidateAll()
+	if err == nil {
+		return nil
+	}
+
+	return apperr.InvalidArgument("request validation failed", violations(err)...)
+}
+
+func violations(err error) []apperr.FieldViolation {
+	var multi multiError
+	if errors.As(err, &multi) {
+		var out []apperr.FieldViolation
+		for _, e := range multi.AllErrors() {
+			out = append(out, violations(e)...)
+		}
+
+		return out
+	}
+
+	var fe fieldError
+	if errors.As(err, &fe) {
+		return []apperr.FieldViolation{
+			{Field: fe.Field(), Description: fe.Reason()},
+		}
+	}
+
+	return []apperr.FieldViolation{
+		{Description: err.Error()},
+	}
+}
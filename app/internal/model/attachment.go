@@ -0,0 +1,18 @@
+package model
+
+import "time"
+
+type Attachment struct {
+	ID     uint64
+	ChatID int64
+	UserID uint64
+	// MessageID nil, пока автор не прикрепил загруженный файл к сообщению
+	MessageID *uint64
+	Filename  string
+	MimeType  string
+	Size      int64
+	// SHA256 хеш содержимого в hex
+	SHA256     string
+	StorageKey string
+	CreatedAt  time.Time
+}
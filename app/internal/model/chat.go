@@ -1,5 +1,58 @@
 package model
 
+import "time"
+
+type Role string
+
+const (
+	RoleOwner  Role = "owner"
+	RoleAdmin  Role = "admin"
+	RoleMember Role = "member"
+)
+
+type ChatKind string
+
+const (
+	ChatKindDirect  ChatKind = "direct"
+	ChatKindGroup   ChatKind = "group"
+	ChatKindChannel ChatKind = "channel"
+)
+
 type Chat struct {
-	Title string
+	ID          int64
+	Kind        ChatKind
+	Title       string
+	Description string
+	AvatarURL   string
+	// Version меняется при каждом обновлении, по нему ловим параллельные правки
+	Version       uint64
+	LastMessageAt time.Time
+}
+
+type ChatMember struct {
+	UserID uint64
+	Role   Role
+}
+
+// ChatSummary строка в списке чатов пользователя
+type ChatSummary struct {
+	ID            int64
+	Kind          ChatKind
+	Title         string
+	MemberCount   uint64
+	UnreadCount   uint64
+	LastMessageAt time.Time
+	// LastMessage nil, пока в чате нет сообщений. Текст в нем обрезан до превью
+	LastMessage *Message
+}
+
+type ChatCursor struct {
+	LastMessageAt time.Time `json:"ts"`
+	ID            int64     `json:"id"`
+}
+
+type ChatsFilter struct {
+	UserID uint64
+	Cursor *ChatCursor
+	Limit  uint64
 }
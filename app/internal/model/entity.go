@@ -0,0 +1,37 @@
+package model
+
+type EntityType string
+
+const (
+	EntityBold    EntityType = "bold"
+	EntityItalic  EntityType = "italic"
+	EntityCode    EntityType = "code"
+	EntityLink    EntityType = "link"
+	EntityMention EntityType = "mention"
+)
+
+// MessageEntity разметка куска текста. Offset и Length считаются в символах юникода, а не в байтах
+type MessageEntity struct {
+	Type   EntityType `json:"type"`
+	Offset int        `json:"offset"`
+	Length int        `json:"length"`
+	// URL только у ссылок
+	URL string `json:"url,omitempty"`
+	// UserID только у упоминаний
+	UserID uint64 `json:"user_id,omitempty"`
+}
+
+// End первый символ после сущности
+func (e MessageEntity) End() int {
+	return e.Offset + e.Length
+}
+
+type MentionCursor struct {
+	MessageID uint64 `json:"id"`
+}
+
+type MentionsFilter struct {
+	UserID uint64
+	Cursor *MentionCursor
+	Limit  uint64
+}
@@ -1,9 +1,18 @@
 package model
 
 const (
-	LogCreateChat  = "create_chat"
-	LogDeleteChat  = "delete_chat"
-	LogSendMessage = "send_message"
+	LogCreateChat    = "create_chat"
+	LogDeleteChat    = "delete_chat"
+	LogRestoreChat   = "restore_chat"
+	LogUpdateChat    = "update_chat"
+	LogSendMessage   = "send_message"
+	LogEditMessage   = "edit_message"
+	LogDeleteMessage = "delete_message"
+	LogAddMembers    = "add_members"
+	LogRemoveMember  = "remove_member"
+	LogSetMemberRole = "set_member_role"
+	LogLeaveChat     = "leave_chat"
+	LogSubscribe     = "subscribe"
 )
 
 type Log struct {
@@ -0,0 +1,69 @@
+package model
+
+import "time"
+
+type Direction int
+
+const (
+	DirectionBackward Direction = iota
+	DirectionForward
+)
+
+type Message struct {
+	ID        uint64
+	ChatID    int64
+	UserID    uint64
+	Text      string
+	Timestamp time.Time
+	// ClientSentAt время отправки по часам клиента, nil если клиент его не передал
+	ClientSentAt *time.Time
+	// ClientMessageID ключ идемпотентности, с которым клиент отправил сообщение
+	ClientMessageID *string
+	EditedAt        *time.Time
+	DeletedAt       *time.Time
+	// ReplyToMessageID сообщение из того же чата, на которое отвечают
+	ReplyToMessageID *uint64
+	// System служебное сообщение о составе чата, его нельзя редактировать
+	System bool
+	// Entities разметка текста, отсортирована по Offset
+	Entities []MessageEntity
+	// Reactions заполняются только при чтении истории и в событиях о реакциях
+	Reactions []ReactionCount
+	// Attachments заполняются при отправке и при чтении истории
+	Attachments []Attachment
+}
+
+type MessageCursor struct {
+	Timestamp time.Time `json:"ts"`
+	ID        uint64    `json:"id"`
+}
+
+type MessagesFilter struct {
+	ChatID int64
+	// ReplyTo ограничивает выборку ответами на конкретное сообщение
+	ReplyTo   *uint64
+	Cursor    *MessageCursor
+	Direction Direction
+	Limit     uint64
+}
+
+type SearchCursor struct {
+	Rank float32 `json:"rank"`
+	ID   uint64  `json:"id"`
+}
+
+type SearchFilter struct {
+	UserID uint64
+	// ChatID 0, если ищем по всем чатам пользователя
+	ChatID int64
+	Query  string
+	Cursor *SearchCursor
+	Limit  uint64
+}
+
+type SearchHit struct {
+	Message Message
+	// Snippet фрагмент текста вокруг совпадений, найденные слова обернуты в <b></b>
+	Snippet string
+	Rank    float32
+}
@@ -0,0 +1,27 @@
+package model
+
+import "time"
+
+type NotificationKind string
+
+const (
+	NotificationMessage NotificationKind = "message"
+	NotificationMention NotificationKind = "mention"
+)
+
+// Notification уведомление конкретному получателю: кому и о каком сообщении сообщить
+type Notification struct {
+	ID        uint64
+	Kind      NotificationKind
+	UserID    uint64
+	ChatID    int64
+	MessageID uint64
+	AuthorID  uint64
+	// Text текущий текст сообщения, берется при доставке
+	Text string
+	// MessageDeleted сообщение удалили, пока уведомление ждало доставки
+	MessageDeleted bool
+	// Attempts номер текущей попытки доставки, первая попытка - 1
+	Attempts  int
+	CreatedAt time.Time
+}
@@ -0,0 +1,27 @@
+package model
+
+import "time"
+
+type PresenceAction int
+
+const (
+	PresenceActionJoin PresenceAction = iota + 1
+	PresenceActionLeave
+	PresenceActionTypingStart
+	PresenceActionTypingStop
+)
+
+// PresenceEvent состояние участника чата, живет только в памяти инстанса
+type PresenceEvent struct {
+	ChatID int64
+	UserID uint64
+	Online bool
+	Typing bool
+}
+
+type UserPresence struct {
+	UserID uint64
+	Online bool
+	// LastSeenAt nil, пока пользователь онлайн или ни разу не подключался
+	LastSeenAt *time.Time
+}
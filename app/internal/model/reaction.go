@@ -0,0 +1,12 @@
+package model
+
+type Reaction struct {
+	MessageID uint64
+	UserID    uint64
+	Emoji     string
+}
+
+type ReactionCount struct {
+	Emoji string
+	Count uint64
+}
@@ -0,0 +1,7 @@
+package model
+
+type UnreadCount struct {
+	ChatID            int64
+	Count             uint64
+	LastReadMessageID uint64
+}
@@ -0,0 +1,48 @@
+package attachmentrepo
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/defany/chat-server/app/internal/model"
+	repo "github.com/defany/chat-server/app/internal/repository"
+	"github.com/defany/slogger/pkg/logger/sl"
+	"github.com/jackc/pgx/v5"
+)
+
+// Attach не трогает чужие и уже привязанные вложения, поэтому вызывающий сверяет количество с запрошенным
+func (r *repository) Attach(ctx context.Context, messageID uint64, chatID int64, userID uint64, ids []uint64) ([]model.Attachment, error) {
+	op := sl.FnName()
+
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	q := r.qb.Update(chatsAttachments).
+		Set(chatsAttachmentsMessageID, messageID).
+		Where(squirrel.Eq{
+			chatsAttachmentsID:        ids,
+			chatsAttachmentsChatID:    chatID,
+			chatsAttachmentsUserID:    userID,
+			chatsAttachmentsMessageID: nil,
+		}).
+		Suffix("returning " + strings.Join(attachmentColumns, ", "))
+
+	sql, args, err := q.ToSql()
+	if err != nil {
+		return nil, sl.Err(op, err)
+	}
+
+	rows, err := r.db.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, sl.Err(op, repo.TranslateError(err))
+	}
+
+	attachments, err := pgx.CollectRows(rows, scanAttachment)
+	if err != nil {
+		return nil, sl.Err(op, repo.TranslateError(err))
+	}
+
+	return attachments, nil
+}
@@ -0,0 +1,76 @@
+package attachmentrepo
+
+import (
+	"github.com/Masterminds/squirrel"
+	"github.com/defany/chat-server/app/internal/model"
+	repo "github.com/defany/chat-server/app/internal/repository"
+	"github.com/defany/db/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+const (
+	chatsAttachments        = "chats_attachments"
+	chatsAttachmentsOrphans = "chats_attachments_orphans"
+)
+
+const (
+	chatsAttachmentsID         = "id"
+	chatsAttachmentsChatID     = "chat_id"
+	chatsAttachmentsUserID     = "user_id"
+	chatsAttachmentsMessageID  = "message_id"
+	chatsAttachmentsFilename   = "filename"
+	chatsAttachmentsMimeType   = "mime_type"
+	chatsAttachmentsSize       = "size"
+	chatsAttachmentsSHA256     = "sha256"
+	chatsAttachmentsStorageKey = "storage_key"
+	chatsAttachmentsCreatedAt  = "created_at"
+)
+
+const (
+	chatsAttachmentsOrphansStorageKey = "storage_key"
+	chatsAttachmentsOrphansCreatedAt  = "created_at"
+)
+
+var attachmentColumns = []string{
+	chatsAttachmentsID,
+	chatsAttachmentsChatID,
+	chatsAttachmentsUserID,
+	chatsAttachmentsMessageID,
+	chatsAttachmentsFilename,
+	chatsAttachmentsMimeType,
+	chatsAttachmentsSize,
+	chatsAttachmentsSHA256,
+	chatsAttachmentsStorageKey,
+	chatsAttachmentsCreatedAt,
+}
+
+type repository struct {
+	db postgres.Postgres
+	qb squirrel.StatementBuilderType
+}
+
+func NewRepository(db postgres.Postgres) repo.Attachment {
+	return &repository{
+		db: db,
+		qb: squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar),
+	}
+}
+
+func scanAttachment(row pgx.CollectableRow) (model.Attachment, error) {
+	var attachment model.Attachment
+
+	err := row.Scan(
+		&attachment.ID,
+		&attachment.ChatID,
+		&attachment.UserID,
+		&attachment.MessageID,
+		&attachment.Filename,
+		&attachment.MimeType,
+		&attachment.Size,
+		&attachment.SHA256,
+		&attachment.StorageKey,
+		&attachment.CreatedAt,
+	)
+
+	return attachment, err
+}
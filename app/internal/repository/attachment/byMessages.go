@@ -0,0 +1,50 @@
+package attachmentrepo
+
+import (
+	"context"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/defany/chat-server/app/internal/model"
+	repo "github.com/defany/chat-server/app/internal/repository"
+	"github.com/defany/slogger/pkg/logger/sl"
+	"github.com/jackc/pgx/v5"
+)
+
+// ByMessages отдает вложения пачки сообщений одним запросом, в порядке загрузки
+func (r *repository) ByMessages(ctx context.Context, messageIDs []uint64) (map[uint64][]model.Attachment, error) {
+	op := sl.FnName()
+
+	attachments := make(map[uint64][]model.Attachment)
+
+	if len(messageIDs) == 0 {
+		return attachments, nil
+	}
+
+	q := r.qb.Select(attachmentColumns...).
+		From(chatsAttachments).
+		Where(squirrel.Eq{
+			chatsAttachmentsMessageID: messageIDs,
+		}).
+		OrderBy(chatsAttachmentsMessageID, chatsAttachmentsID)
+
+	sql, args, err := q.ToSql()
+	if err != nil {
+		return nil, sl.Err(op, err)
+	}
+
+	rows, err := r.db.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, sl.Err(op, repo.TranslateError(err))
+	}
+
+	list, err := pgx.CollectRows(rows, scanAttachment)
+	if err != nil {
+		return nil, sl.Err(op, repo.TranslateError(err))
+	}
+
+	for _, attachment := range list {
+		attachments[*attachment.MessageID] = append(attachments[*attachment.MessageID], attachment)
+	}
+
+	return attachments, nil
+}
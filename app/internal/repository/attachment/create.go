@@ -0,0 +1,53 @@
+package attachmentrepo
+
+import (
+	"context"
+	"strings"
+
+	"github.com/defany/chat-server/app/internal/model"
+	repo "github.com/defany/chat-server/app/internal/repository"
+	"github.com/defany/slogger/pkg/logger/sl"
+	"github.com/jackc/pgx/v5"
+)
+
+func (r *repository) Create(ctx context.Context, attachment model.Attachment) (model.Attachment, error) {
+	op := sl.FnName()
+
+	q := r.qb.Insert(chatsAttachments).
+		Columns(
+			chatsAttachmentsChatID,
+			chatsAttachmentsUserID,
+			chatsAttachmentsFilename,
+			chatsAttachmentsMimeType,
+			chatsAttachmentsSize,
+			chatsAttachmentsSHA256,
+			chatsAttachmentsStorageKey,
+		).
+		Values(
+			attachment.ChatID,
+			attachment.UserID,
+			attachment.Filename,
+			attachment.MimeType,
+			attachment.Size,
+			attachment.SHA256,
+			attachment.StorageKey,
+		).
+		Suffix("returning " + strings.Join(attachmentColumns, ", "))
+
+	sql, args, err := q.ToSql()
+	if err != nil {
+		return model.Attachment{}, sl.Err(op, err)
+	}
+
+	rows, err := r.db.Query(ctx, sql, args...)
+	if err != nil {
+		return model.Attachment{}, sl.Err(op, repo.TranslateError(err))
+	}
+
+	created, err := pgx.CollectExactlyOneRow(rows, scanAttachment)
+	if err != nil {
+		return model.Attachment{}, sl.Err(op, repo.TranslateError(err))
+	}
+
+	return created, nil
+}
@@ -0,0 +1,30 @@
+package attachmentrepo
+
+import (
+	"context"
+
+	"github.com/Masterminds/squirrel"
+	repo "github.com/defany/chat-server/app/internal/repository"
+	"github.com/defany/slogger/pkg/logger/sl"
+)
+
+func (r *repository) DeleteByMessage(ctx context.Context, messageID uint64) error {
+	op := sl.FnName()
+
+	q := r.qb.Delete(chatsAttachments).
+		Where(squirrel.Eq{
+			chatsAttachmentsMessageID: messageID,
+		})
+
+	sql, args, err := q.ToSql()
+	if err != nil {
+		return sl.Err(op, err)
+	}
+
+	_, err = r.db.Exec(ctx, sql, args...)
+	if err != nil {
+		return sl.Err(op, repo.TranslateError(err))
+	}
+
+	return nil
+}
@@ -0,0 +1,44 @@
+package attachmentrepo
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/defany/chat-server/app/internal/apperr"
+	"github.com/defany/chat-server/app/internal/model"
+	repo "github.com/defany/chat-server/app/internal/repository"
+	"github.com/defany/slogger/pkg/logger/sl"
+	"github.com/jackc/pgx/v5"
+)
+
+func (r *repository) Attachment(ctx context.Context, id uint64) (model.Attachment, error) {
+	op := sl.FnName()
+
+	q := r.qb.Select(attachmentColumns...).
+		From(chatsAttachments).
+		Where(squirrel.Eq{
+			chatsAttachmentsID: id,
+		})
+
+	sql, args, err := q.ToSql()
+	if err != nil {
+		return model.Attachment{}, sl.Err(op, err)
+	}
+
+	rows, err := r.db.Query(ctx, sql, args...)
+	if err != nil {
+		return model.Attachment{}, sl.Err(op, repo.TranslateError(err))
+	}
+
+	attachment, err := pgx.CollectExactlyOneRow(rows, scanAttachment)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.Attachment{}, sl.Err(op, apperr.NotFound("attachment not found"))
+		}
+
+		return model.Attachment{}, sl.Err(op, repo.TranslateError(err))
+	}
+
+	return attachment, nil
+}
@@ -0,0 +1,46 @@
+package attachmentrepo
+
+import (
+	"context"
+
+	"github.com/Masterminds/squirrel"
+	repo "github.com/defany/chat-server/app/internal/repository"
+	"github.com/defany/slogger/pkg/logger/sl"
+)
+
+// OrphanChats запоминает файлы вложений чатов перед их окончательным удалением, сами строки уйдут каскадом
+func (r *repository) OrphanChats(ctx context.Context, chatIDs []int64) error {
+	if len(chatIDs) == 0 {
+		return nil
+	}
+
+	return r.orphan(ctx, sl.FnName(), squirrel.Eq{chatsAttachmentsChatID: chatIDs})
+}
+
+// OrphanMessage запоминает файлы вложений удаленного сообщения, строки потом убирает DeleteByMessage
+func (r *repository) OrphanMessage(ctx context.Context, messageID uint64) error {
+	return r.orphan(ctx, sl.FnName(), squirrel.Eq{chatsAttachmentsMessageID: messageID})
+}
+
+func (r *repository) orphan(ctx context.Context, op string, where squirrel.Eq) error {
+	keys := squirrel.Select(chatsAttachmentsStorageKey).
+		From(chatsAttachments).
+		Where(where)
+
+	q := r.qb.Insert(chatsAttachmentsOrphans).
+		Columns(chatsAttachmentsOrphansStorageKey).
+		Select(keys).
+		Suffix("on conflict do nothing")
+
+	sql, args, err := q.ToSql()
+	if err != nil {
+		return sl.Err(op, err)
+	}
+
+	_, err = r.db.Exec(ctx, sql, args...)
+	if err != nil {
+		return sl.Err(op, repo.TranslateError(err))
+	}
+
+	return nil
+}
@@ -0,0 +1,62 @@
+package attachmentrepo
+
+import (
+	"context"
+
+	"github.com/Masterminds/squirrel"
+	repo "github.com/defany/chat-server/app/internal/repository"
+	"github.com/defany/slogger/pkg/logger/sl"
+	"github.com/jackc/pgx/v5"
+)
+
+func (r *repository) Orphans(ctx context.Context, limit uint64) ([]string, error) {
+	op := sl.FnName()
+
+	q := r.qb.Select(chatsAttachmentsOrphansStorageKey).
+		From(chatsAttachmentsOrphans).
+		OrderBy(chatsAttachmentsOrphansCreatedAt).
+		Limit(limit)
+
+	sql, args, err := q.ToSql()
+	if err != nil {
+		return nil, sl.Err(op, err)
+	}
+
+	rows, err := r.db.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, sl.Err(op, repo.TranslateError(err))
+	}
+
+	keys, err := pgx.CollectRows(rows, pgx.RowTo[string])
+	if err != nil {
+		return nil, sl.Err(op, repo.TranslateError(err))
+	}
+
+	return keys, nil
+}
+
+// RemoveOrphans вызывается после удаления файлов из хранилища, так что при сбое ключи просто останутся до следующего прохода
+func (r *repository) RemoveOrphans(ctx context.Context, keys []string) error {
+	op := sl.FnName()
+
+	if len(keys) == 0 {
+		return nil
+	}
+
+	q := r.qb.Delete(chatsAttachmentsOrphans).
+		Where(squirrel.Eq{
+			chatsAttachmentsOrphansStorageKey: keys,
+		})
+
+	sql, args, err := q.ToSql()
+	if err != nil {
+		return sl.Err(op, err)
+	}
+
+	_, err = r.db.Exec(ctx, sql, args...)
+	if err != nil {
+		return sl.Err(op, repo.TranslateError(err))
+	}
+
+	return nil
+}
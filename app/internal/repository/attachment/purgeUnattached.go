@@ -0,0 +1,53 @@
+package attachmentrepo
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/defany/chat-server/app/internal/model"
+	repo "github.com/defany/chat-server/app/internal/repository"
+	"github.com/defany/slogger/pkg/logger/sl"
+	"github.com/jackc/pgx/v5"
+)
+
+// PurgeUnattached удаляет пачку вложений, которые так и не прикрепили к сообщению, и возвращает их, чтобы вызывающий убрал файлы
+func (r *repository) PurgeUnattached(ctx context.Context, ttl time.Duration, limit uint64) ([]model.Attachment, error) {
+	op := sl.FnName()
+
+	expired := squirrel.Select(chatsAttachmentsID).
+		From(chatsAttachments).
+		Where(squirrel.Eq{chatsAttachmentsMessageID: nil}).
+		Where(squirrel.Expr(chatsAttachmentsCreatedAt+" < now() - ?::interval", ttl)).
+		OrderBy(chatsAttachmentsCreatedAt).
+		Limit(limit).
+		Suffix("for update skip locked")
+
+	expiredSQL, expiredArgs, err := expired.ToSql()
+	if err != nil {
+		return nil, sl.Err(op, err)
+	}
+
+	// Строки, которые прямо сейчас прикрепляют к сообщению, заблокированы Attach'ем и пропускаются
+	q := r.qb.Delete(chatsAttachments).
+		Where(squirrel.Expr(chatsAttachmentsID+" in ("+expiredSQL+")", expiredArgs...)).
+		Suffix("returning " + strings.Join(attachmentColumns, ", "))
+
+	sql, args, err := q.ToSql()
+	if err != nil {
+		return nil, sl.Err(op, err)
+	}
+
+	rows, err := r.db.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, sl.Err(op, repo.TranslateError(err))
+	}
+
+	attachments, err := pgx.CollectRows(rows, scanAttachment)
+	if err != nil {
+		return nil, sl.Err(op, repo.TranslateError(err))
+	}
+
+	return attachments, nil
+}
@@ -0,0 +1,36 @@
+package chatrepo
+
+import (
+	"context"
+
+	"github.com/defany/chat-server/app/internal/model"
+	repo "github.com/defany/chat-server/app/internal/repository"
+	"github.com/defany/slogger/pkg/logger/sl"
+)
+
+func (r *repository) AddMembers(ctx context.Context, chatID uint64, members []model.ChatMember) error {
+	op := sl.FnName()
+
+	if len(members) == 0 {
+		return nil
+	}
+
+	q := r.qb.Insert(usersChats).
+		Columns(usersChatsChatID, usersChatsUserID, usersChatsRole)
+
+	for _, member := range members {
+		q = q.Values(chatID, member.UserID, member.Role)
+	}
+
+	sql, args, err := q.ToSql()
+	if err != nil {
+		return sl.Err(op, err)
+	}
+
+	_, err = r.db.Exec(ctx, sql, args...)
+	if err != nil {
+		return sl.Err(op, repo.TranslateError(err))
+	}
+
+	return nil
+}
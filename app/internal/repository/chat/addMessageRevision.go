@@ -0,0 +1,29 @@
+package chatrepo
+
+import (
+	"context"
+
+	"github.com/defany/chat-server/app/internal/model"
+	repo "github.com/defany/chat-server/app/internal/repository"
+	"github.com/defany/slogger/pkg/logger/sl"
+)
+
+func (r *repository) AddMessageRevision(ctx context.Context, messageID uint64, text string, entities []model.MessageEntity) error {
+	op := sl.FnName()
+
+	q := r.qb.Insert(chatsMessagesRevisions).
+		Columns(chatsMessagesRevisionsMessageID, chatsMessagesRevisionsText, chatsMessagesRevisionsEntities).
+		Values(messageID, text, entitiesValue(entities))
+
+	sql, args, err := q.ToSql()
+	if err != nil {
+		return sl.Err(op, err)
+	}
+
+	_, err = r.db.Exec(ctx, sql, args...)
+	if err != nil {
+		return sl.Err(op, repo.TranslateError(err))
+	}
+
+	return nil
+}
@@ -9,17 +9,60 @@ import (
 const (
 	chats         = "chats"
 	chatsMessages = "chats_messages"
+	usersChats    = "users_chats"
+
+	chatsMessagesRevisions = "chats_messages_revisions"
+	chatsMessagesMentions  = "chats_messages_mentions"
 )
 
 const (
-	chatsID    = "id"
-	chatsTitle = "title"
+	chatsID        = "id"
+	chatsTitle     = "title"
+	chatsDeletedAt = "deleted_at"
+
+	chatsDescription = "description"
+	chatsAvatarURL   = "avatar_url"
+	chatsVersion     = "version"
+	chatsCreatedAt   = "created_at"
+
+	chatsKind           = "kind"
+	chatsDirectUserLow  = "direct_user_low"
+	chatsDirectUserHigh = "direct_user_high"
 )
 
 const (
-	chatsMessagesChatID = "chat_id"
-	chatsMessagesFrom   = "from"
-	chatsMessagesText   = "text"
+	chatsMessagesID              = "id"
+	chatsMessagesChatID          = "chat_id"
+	chatsMessagesUserID          = "user_id"
+	chatsMessagesText            = "text"
+	chatsMessagesTimestamp       = "timestamp"
+	chatsMessagesClientSentAt    = "client_sent_at"
+	chatsMessagesClientMessageID = "client_message_id"
+	chatsMessagesEditedAt        = "edited_at"
+	chatsMessagesDeletedAt       = "deleted_at"
+	chatsMessagesReplyTo         = "reply_to_message_id"
+	chatsMessagesSystem          = "system"
+	chatsMessagesEntities        = "entities"
+)
+
+const (
+	chatsMessagesRevisionsMessageID = "message_id"
+	chatsMessagesRevisionsText      = "text"
+	chatsMessagesRevisionsEntities  = "entities"
+)
+
+const (
+	chatsMessagesMentionsMessageID = "message_id"
+	chatsMessagesMentionsUserID    = "user_id"
+)
+
+const (
+	usersChatsChatID = "chat_id"
+	usersChatsUserID = "user_id"
+	usersChatsRole   = "role"
+	usersChatsMuted  = "muted"
+
+	usersChatsLastReadMessageID = "last_read_message_id"
 )
 
 type repository struct {
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/defany/chat-server/app/internal/model"
+	repo "github.com/defany/chat-server/app/internal/repository"
 	"github.com/defany/slogger/pkg/logger/sl"
 	"github.com/jackc/pgx/v5"
 )
@@ -12,8 +13,8 @@ func (r *repository) Create(ctx context.Context, chat model.Chat) (uint64, error
 	op := sl.FnName()
 
 	q := r.qb.Insert(chats).
-		Columns(chatsTitle).
-		Values(chat.Title).
+		Columns(chatsTitle, chatsDescription, chatsKind).
+		Values(chat.Title, chat.Description, chat.Kind).
 		Suffix("returning id")
 
 	sql, args, err := q.ToSql()
@@ -23,12 +24,12 @@ func (r *repository) Create(ctx context.Context, chat model.Chat) (uint64, error
 
 	rows, err := r.db.Query(ctx, sql, args...)
 	if err != nil {
-		return 0, sl.Err(op, err)
+		return 0, sl.Err(op, repo.TranslateError(err))
 	}
 
 	id, err := pgx.CollectOneRow(rows, pgx.RowTo[uint64])
 	if err != nil {
-		return 0, sl.Err(op, err)
+		return 0, sl.Err(op, repo.TranslateError(err))
 	}
 
 	return id, nil
@@ -4,15 +4,20 @@ import (
 	"context"
 
 	"github.com/Masterminds/squirrel"
+	"github.com/defany/chat-server/app/internal/apperr"
+	repo "github.com/defany/chat-server/app/internal/repository"
 	"github.com/defany/slogger/pkg/logger/sl"
 )
 
+// Delete удаляет чат мягко, сообщения и участники остаются до очистки в PurgeDeleted
 func (r *repository) Delete(ctx context.Context, id int64) error {
 	op := sl.FnName()
 
-	q := r.qb.Delete(chats).
+	q := r.qb.Update(chats).
+		Set(chatsDeletedAt, squirrel.Expr("now()")).
 		Where(squirrel.Eq{
-			chatsID: id,
+			chatsID:        id,
+			chatsDeletedAt: nil,
 		})
 
 	sql, args, err := q.ToSql()
@@ -20,9 +25,13 @@ func (r *repository) Delete(ctx context.Context, id int64) error {
 		return sl.Err(op, err)
 	}
 
-	_, err = r.db.Exec(ctx, sql, args...)
+	tag, err := r.db.Exec(ctx, sql, args...)
 	if err != nil {
-		return sl.Err(op, err)
+		return sl.Err(op, repo.TranslateError(err))
+	}
+
+	if tag.RowsAffected() == 0 {
+		return sl.Err(op, apperr.NotFound("chat not found"))
 	}
 
 	return nil
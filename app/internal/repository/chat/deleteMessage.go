@@ -0,0 +1,43 @@
+package chatrepo
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/defany/chat-server/app/internal/model"
+	repo "github.com/defany/chat-server/app/internal/repository"
+	"github.com/defany/slogger/pkg/logger/sl"
+	"github.com/jackc/pgx/v5"
+)
+
+// DeleteMessage оставляет сообщение в истории без текста, чтобы не ломать курсоры и ответы на него
+func (r *repository) DeleteMessage(ctx context.Context, id uint64) (model.Message, error) {
+	op := sl.FnName()
+
+	q := r.qb.Update(chatsMessages).
+		Set(chatsMessagesText, "").
+		Set(chatsMessagesEntities, squirrel.Expr("'[]'::jsonb")).
+		Set(chatsMessagesDeletedAt, squirrel.Expr("now()")).
+		Where(squirrel.Eq{
+			chatsMessagesID: id,
+		}).
+		Suffix("returning " + strings.Join(messageColumns, ", "))
+
+	sql, args, err := q.ToSql()
+	if err != nil {
+		return model.Message{}, sl.Err(op, err)
+	}
+
+	rows, err := r.db.Query(ctx, sql, args...)
+	if err != nil {
+		return model.Message{}, sl.Err(op, repo.TranslateError(err))
+	}
+
+	msg, err := pgx.CollectExactlyOneRow(rows, scanMessage)
+	if err != nil {
+		return model.Message{}, sl.Err(op, repo.TranslateError(err))
+	}
+
+	return msg, nil
+}
@@ -0,0 +1,74 @@
+package chatrepo
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/defany/chat-server/app/internal/apperr"
+	"github.com/defany/chat-server/app/internal/model"
+	repo "github.com/defany/chat-server/app/internal/repository"
+	"github.com/defany/slogger/pkg/logger/sl"
+	"github.com/jackc/pgx/v5"
+)
+
+var chatColumns = []string{
+	chatsID,
+	chatsKind,
+	chatsTitle,
+	chatsDescription,
+	chatsAvatarURL,
+	chatsVersion,
+	chatLastMessageAt,
+}
+
+// chatLastMessageAt берет время последнего сообщения из индекса, а не из строки чата, которую пришлось бы трогать на каждой отправке
+const chatLastMessageAt = "coalesce((select max(m." + chatsMessagesTimestamp + ") from " + chatsMessages + " m where m." + chatsMessagesChatID + " = " + chats + "." + chatsID + "), " + chats + "." + chatsCreatedAt + ")"
+
+func scanChat(row pgx.CollectableRow) (model.Chat, error) {
+	var chat model.Chat
+
+	err := row.Scan(
+		&chat.ID,
+		&chat.Kind,
+		&chat.Title,
+		&chat.Description,
+		&chat.AvatarURL,
+		&chat.Version,
+		&chat.LastMessageAt,
+	)
+
+	return chat, err
+}
+
+func (r *repository) Chat(ctx context.Context, id int64) (model.Chat, error) {
+	op := sl.FnName()
+
+	q := r.qb.Select(chatColumns...).
+		From(chats).
+		Where(squirrel.Eq{
+			chatsID:        id,
+			chatsDeletedAt: nil,
+		})
+
+	sql, args, err := q.ToSql()
+	if err != nil {
+		return model.Chat{}, sl.Err(op, err)
+	}
+
+	rows, err := r.db.Query(ctx, sql, args...)
+	if err != nil {
+		return model.Chat{}, sl.Err(op, repo.TranslateError(err))
+	}
+
+	chat, err := pgx.CollectExactlyOneRow(rows, scanChat)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.Chat{}, sl.Err(op, apperr.NotFound("chat not found"))
+		}
+
+		return model.Chat{}, sl.Err(op, repo.TranslateError(err))
+	}
+
+	return chat, nil
+}
@@ -0,0 +1,55 @@
+package chatrepo
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/defany/chat-server/app/internal/apperr"
+	"github.com/defany/chat-server/app/internal/model"
+	repo "github.com/defany/chat-server/app/internal/repository"
+	"github.com/defany/slogger/pkg/logger/sl"
+	"github.com/jackc/pgx/v5"
+)
+
+func (r *repository) Message(ctx context.Context, chatID int64, id uint64) (model.Message, error) {
+	return r.message(ctx, chatID, id, "")
+}
+
+// MessageForUpdate блокирует строку сообщения до конца транзакции, чтобы параллельные правки не затерли друг друга
+func (r *repository) MessageForUpdate(ctx context.Context, chatID int64, id uint64) (model.Message, error) {
+	return r.message(ctx, chatID, id, "for update")
+}
+
+func (r *repository) message(ctx context.Context, chatID int64, id uint64, suffix string) (model.Message, error) {
+	op := sl.FnName()
+
+	q := r.qb.Select(messageColumns...).
+		From(chatsMessages).
+		Where(squirrel.Eq{
+			chatsMessagesID:     id,
+			chatsMessagesChatID: chatID,
+		}).
+		Suffix(suffix)
+
+	sql, args, err := q.ToSql()
+	if err != nil {
+		return model.Message{}, sl.Err(op, err)
+	}
+
+	rows, err := r.db.Query(ctx, sql, args...)
+	if err != nil {
+		return model.Message{}, sl.Err(op, repo.TranslateError(err))
+	}
+
+	msg, err := pgx.CollectExactlyOneRow(rows, scanMessage)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.Message{}, sl.Err(op, apperr.NotFound("message not found"))
+		}
+
+		return model.Message{}, sl.Err(op, repo.TranslateError(err))
+	}
+
+	return msg, nil
+}
@@ -0,0 +1,74 @@
+package chatrepo
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/defany/chat-server/app/internal/model"
+	repo "github.com/defany/chat-server/app/internal/repository"
+	"github.com/defany/slogger/pkg/logger/sl"
+	"github.com/jackc/pgx/v5"
+)
+
+// GetOrCreateDirect отдает id личного чата пары пользователей и признак того, что чат создан этим вызовом.
+// Гонку параллельных вызовов решает уникальный индекс по паре: проигравший insert ничего не вставит и найдет чужой чат
+func (r *repository) GetOrCreateDirect(ctx context.Context, userID, peerID uint64) (int64, bool, error) {
+	op := sl.FnName()
+
+	low, high := userID, peerID
+	if low > high {
+		low, high = high, low
+	}
+
+	q := r.qb.Insert(chats).
+		Columns(chatsTitle, chatsKind, chatsDirectUserLow, chatsDirectUserHigh).
+		Values("", model.ChatKindDirect, low, high).
+		Suffix("on conflict (" + chatsDirectUserLow + ", " + chatsDirectUserHigh + ") where " + chatsDeletedAt + " is null do nothing").
+		Suffix("returning " + chatsID)
+
+	sql, args, err := q.ToSql()
+	if err != nil {
+		return 0, false, sl.Err(op, err)
+	}
+
+	rows, err := r.db.Query(ctx, sql, args...)
+	if err != nil {
+		return 0, false, sl.Err(op, repo.TranslateError(err))
+	}
+
+	id, err := pgx.CollectOneRow(rows, pgx.RowTo[int64])
+	if err == nil {
+		return id, true, nil
+	}
+
+	if !errors.Is(err, pgx.ErrNoRows) {
+		return 0, false, sl.Err(op, repo.TranslateError(err))
+	}
+
+	// Отдельный запрос, а не часть insert: в read committed только новый снимок видит чат, закоммиченный параллельным вызовом
+	sq := r.qb.Select(chatsID).
+		From(chats).
+		Where(squirrel.Eq{
+			chatsDirectUserLow:  low,
+			chatsDirectUserHigh: high,
+			chatsDeletedAt:      nil,
+		})
+
+	sql, args, err = sq.ToSql()
+	if err != nil {
+		return 0, false, sl.Err(op, err)
+	}
+
+	rows, err = r.db.Query(ctx, sql, args...)
+	if err != nil {
+		return 0, false, sl.Err(op, repo.TranslateError(err))
+	}
+
+	id, err = pgx.CollectExactlyOneRow(rows, pgx.RowTo[int64])
+	if err != nil {
+		return 0, false, sl.Err(op, repo.TranslateError(err))
+	}
+
+	return id, false, nil
+}
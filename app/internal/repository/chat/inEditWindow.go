@@ -0,0 +1,46 @@
+package chatrepo
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/defany/chat-server/app/internal/apperr"
+	repo "github.com/defany/chat-server/app/internal/repository"
+	"github.com/defany/slogger/pkg/logger/sl"
+	"github.com/jackc/pgx/v5"
+)
+
+// InEditWindow сравнивает возраст сообщения по часам базы, теми же, что ставят timestamp
+func (r *repository) InEditWindow(ctx context.Context, id uint64, window time.Duration) (bool, error) {
+	op := sl.FnName()
+
+	q := r.qb.Select().
+		Column(squirrel.Expr("now() - "+chatsMessagesTimestamp+" <= ?::interval", window)).
+		From(chatsMessages).
+		Where(squirrel.Eq{
+			chatsMessagesID: id,
+		})
+
+	sql, args, err := q.ToSql()
+	if err != nil {
+		return false, sl.Err(op, err)
+	}
+
+	rows, err := r.db.Query(ctx, sql, args...)
+	if err != nil {
+		return false, sl.Err(op, repo.TranslateError(err))
+	}
+
+	ok, err := pgx.CollectExactlyOneRow(rows, pgx.RowTo[bool])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return false, sl.Err(op, apperr.NotFound("message not found"))
+		}
+
+		return false, sl.Err(op, repo.TranslateError(err))
+	}
+
+	return ok, nil
+}
@@ -0,0 +1,119 @@
+package chatrepo
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/defany/chat-server/app/internal/model"
+	repo "github.com/defany/chat-server/app/internal/repository"
+	"github.com/defany/slogger/pkg/logger/sl"
+	"github.com/jackc/pgx/v5"
+)
+
+const chatPreviewLength = 100
+
+// summaryLastMessageAt - время последнего сообщения из lateral join'а, для чата без сообщений - время создания
+const summaryLastMessageAt = "coalesce(lm." + chatsMessagesTimestamp + ", c." + chatsCreatedAt + ")"
+
+// ListChats отдает чаты пользователя от самых активных. Непрочитанные считаются по тем же правилам, что и в UnreadCounts
+func (r *repository) ListChats(ctx context.Context, filter model.ChatsFilter) ([]model.ChatSummary, error) {
+	op := sl.FnName()
+
+	q := r.qb.Select(
+		"c."+chatsID,
+		"c."+chatsKind,
+		"c."+chatsTitle,
+		summaryLastMessageAt,
+		"(select count(*) from "+usersChats+" m where m."+usersChatsChatID+" = c."+chatsID+")",
+		"(select count(*) from "+chatsMessages+" um where um."+chatsMessagesChatID+" = c."+chatsID+
+			" and um."+chatsMessagesID+" > coalesce(uc."+usersChatsLastReadMessageID+", 0)"+
+			" and um."+chatsMessagesUserID+" <> uc."+usersChatsUserID+
+			" and um."+chatsMessagesDeletedAt+" is null)",
+		"lm."+chatsMessagesID,
+		"lm."+chatsMessagesUserID,
+		fmt.Sprintf("left(lm.%s, %d)", chatsMessagesText, chatPreviewLength),
+		"lm."+chatsMessagesTimestamp,
+		"lm."+chatsMessagesDeletedAt,
+		"lm."+chatsMessagesSystem,
+	).
+		From(usersChats+" uc").
+		Join(chats+" c on c."+chatsID+" = uc."+usersChatsChatID+" and c."+chatsDeletedAt+" is null").
+		LeftJoin("lateral (select * from "+chatsMessages+" where "+chatsMessagesChatID+" = c."+chatsID+
+			" order by "+chatsMessagesTimestamp+" desc, "+chatsMessagesID+" desc limit 1) lm on true").
+		Where(squirrel.Eq{
+			"uc." + usersChatsUserID: filter.UserID,
+		}).
+		OrderBy(summaryLastMessageAt+" desc", "c."+chatsID+" desc").
+		Limit(filter.Limit)
+
+	if filter.Cursor != nil {
+		q = q.Where(squirrel.Expr("("+summaryLastMessageAt+", c."+chatsID+") < (?, ?)", filter.Cursor.LastMessageAt, filter.Cursor.ID))
+	}
+
+	sql, args, err := q.ToSql()
+	if err != nil {
+		return nil, sl.Err(op, err)
+	}
+
+	rows, err := r.db.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, sl.Err(op, repo.TranslateError(err))
+	}
+
+	summaries, err := pgx.CollectRows(rows, scanChatSummary)
+	if err != nil {
+		return nil, sl.Err(op, repo.TranslateError(err))
+	}
+
+	return summaries, nil
+}
+
+func scanChatSummary(row pgx.CollectableRow) (model.ChatSummary, error) {
+	var (
+		summary model.ChatSummary
+
+		lastID        *uint64
+		lastUserID    *uint64
+		lastText      *string
+		lastTimestamp *time.Time
+		lastDeletedAt *time.Time
+		lastSystem    *bool
+	)
+
+	err := row.Scan(
+		&summary.ID,
+		&summary.Kind,
+		&summary.Title,
+		&summary.LastMessageAt,
+		&summary.MemberCount,
+		&summary.UnreadCount,
+		&lastID,
+		&lastUserID,
+		&lastText,
+		&lastTimestamp,
+		&lastDeletedAt,
+		&lastSystem,
+	)
+	if err != nil {
+		return model.ChatSummary{}, err
+	}
+
+	// В чате без сообщений lateral join дает null во всех колонках
+	if lastID == nil {
+		return summary, nil
+	}
+
+	summary.LastMessage = &model.Message{
+		ID:        *lastID,
+		ChatID:    summary.ID,
+		UserID:    *lastUserID,
+		Text:      *lastText,
+		Timestamp: *lastTimestamp,
+		DeletedAt: lastDeletedAt,
+		System:    *lastSystem,
+	}
+
+	return summary, nil
+}
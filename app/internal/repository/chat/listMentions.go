@@ -0,0 +1,57 @@
+package chatrepo
+
+import (
+	"context"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/defany/chat-server/app/internal/model"
+	repo "github.com/defany/chat-server/app/internal/repository"
+	"github.com/defany/slogger/pkg/logger/sl"
+	"github.com/jackc/pgx/v5"
+)
+
+// ListMentions отдает упоминания от новых к старым. Из чатов, где пользователя уже нет, и удаленных сообщений ничего не показываем
+func (r *repository) ListMentions(ctx context.Context, filter model.MentionsFilter) ([]model.Message, error) {
+	op := sl.FnName()
+
+	columns := make([]string, 0, len(messageColumns))
+	for _, column := range messageColumns {
+		columns = append(columns, "m."+column)
+	}
+
+	// Порядок по id сообщения совпадает с индексом (user_id, message_id desc)
+	q := r.qb.Select(columns...).
+		From(chatsMessagesMentions + " mm").
+		Join(chatsMessages + " m on m." + chatsMessagesID + " = mm." + chatsMessagesMentionsMessageID).
+		Join(usersChats + " uc on uc." + usersChatsChatID + " = m." + chatsMessagesChatID + " and uc." + usersChatsUserID + " = mm." + chatsMessagesMentionsUserID).
+		Join(chats + " c on c." + chatsID + " = m." + chatsMessagesChatID + " and c." + chatsDeletedAt + " is null").
+		Where(squirrel.Eq{
+			"mm." + chatsMessagesMentionsUserID: filter.UserID,
+			"m." + chatsMessagesDeletedAt:       nil,
+		}).
+		OrderBy("mm." + chatsMessagesMentionsMessageID + " desc").
+		Limit(filter.Limit)
+
+	if filter.Cursor != nil {
+		q = q.Where(squirrel.Lt{
+			"mm." + chatsMessagesMentionsMessageID: filter.Cursor.MessageID,
+		})
+	}
+
+	sql, args, err := q.ToSql()
+	if err != nil {
+		return nil, sl.Err(op, err)
+	}
+
+	rows, err := r.db.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, sl.Err(op, repo.TranslateError(err))
+	}
+
+	messages, err := pgx.CollectRows(rows, scanMessage)
+	if err != nil {
+		return nil, sl.Err(op, repo.TranslateError(err))
+	}
+
+	return messages, nil
+}
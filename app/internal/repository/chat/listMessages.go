@@ -0,0 +1,61 @@
+package chatrepo
+
+import (
+	"context"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/defany/chat-server/app/internal/model"
+	repo "github.com/defany/chat-server/app/internal/repository"
+	"github.com/defany/slogger/pkg/logger/sl"
+	"github.com/jackc/pgx/v5"
+)
+
+func (r *repository) ListMessages(ctx context.Context, filter model.MessagesFilter) ([]model.Message, error) {
+	op := sl.FnName()
+
+	q := r.qb.Select(messageColumns...).
+		From(chatsMessages).
+		Where(squirrel.Eq{
+			chatsMessagesChatID: filter.ChatID,
+		}).
+		Limit(filter.Limit)
+
+	if filter.ReplyTo != nil {
+		q = q.Where(squirrel.Eq{
+			chatsMessagesReplyTo: *filter.ReplyTo,
+		})
+	}
+
+	// Сравниваем кортежем, чтобы постгрес шел по индексу (chat_id, timestamp, id), а не сканировал весь чат
+	switch filter.Direction {
+	case model.DirectionForward:
+		if filter.Cursor != nil {
+			q = q.Where(squirrel.Expr("(timestamp, id) > (?, ?)", filter.Cursor.Timestamp, filter.Cursor.ID))
+		}
+
+		q = q.OrderBy("timestamp asc", "id asc")
+	default:
+		if filter.Cursor != nil {
+			q = q.Where(squirrel.Expr("(timestamp, id) < (?, ?)", filter.Cursor.Timestamp, filter.Cursor.ID))
+		}
+
+		q = q.OrderBy("timestamp desc", "id desc")
+	}
+
+	sql, args, err := q.ToSql()
+	if err != nil {
+		return nil, sl.Err(op, err)
+	}
+
+	rows, err := r.db.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, sl.Err(op, repo.TranslateError(err))
+	}
+
+	messages, err := pgx.CollectRows(rows, scanMessage)
+	if err != nil {
+		return nil, sl.Err(op, repo.TranslateError(err))
+	}
+
+	return messages, nil
+}
@@ -0,0 +1,33 @@
+package chatrepo
+
+import (
+	"context"
+
+	"github.com/Masterminds/squirrel"
+	repo "github.com/defany/chat-server/app/internal/repository"
+	"github.com/defany/slogger/pkg/logger/sl"
+)
+
+// MarkRead двигает отметку только вперед, чтобы запоздавший запрос с другого устройства ее не откатил
+func (r *repository) MarkRead(ctx context.Context, chatID int64, userID uint64, messageID uint64) error {
+	op := sl.FnName()
+
+	q := r.qb.Update(usersChats).
+		Set(usersChatsLastReadMessageID, squirrel.Expr("greatest(coalesce("+usersChatsLastReadMessageID+", 0), ?)", messageID)).
+		Where(squirrel.Eq{
+			usersChatsChatID: chatID,
+			usersChatsUserID: userID,
+		})
+
+	sql, args, err := q.ToSql()
+	if err != nil {
+		return sl.Err(op, err)
+	}
+
+	_, err = r.db.Exec(ctx, sql, args...)
+	if err != nil {
+		return sl.Err(op, repo.TranslateError(err))
+	}
+
+	return nil
+}
@@ -0,0 +1,57 @@
+package chatrepo
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/defany/chat-server/app/internal/model"
+	repo "github.com/defany/chat-server/app/internal/repository"
+	"github.com/defany/slogger/pkg/logger/sl"
+	"github.com/jackc/pgx/v5"
+)
+
+// MemberRole возвращает пустую роль, если пользователь не состоит в чате или чат удален
+func (r *repository) MemberRole(ctx context.Context, chatID int64, userID uint64) (model.Role, error) {
+	return r.memberRole(ctx, chatID, userID, squirrel.Eq{
+		chats + "." + chatsDeletedAt: nil,
+	})
+}
+
+// DeletedMemberRole то же самое, но только для удаленных чатов, нужен для восстановления
+func (r *repository) DeletedMemberRole(ctx context.Context, chatID int64, userID uint64) (model.Role, error) {
+	return r.memberRole(ctx, chatID, userID, squirrel.NotEq{
+		chats + "." + chatsDeletedAt: nil,
+	})
+}
+
+func (r *repository) memberRole(ctx context.Context, chatID int64, userID uint64, deleted squirrel.Sqlizer) (model.Role, error) {
+	op := sl.FnName()
+
+	q := r.qb.Select(usersChats + "." + usersChatsRole).
+		From(usersChats).
+		Join(chats + " on " + chats + "." + chatsID + " = " + usersChats + "." + usersChatsChatID).
+		Where(squirrel.Eq{
+			usersChats + "." + usersChatsChatID: chatID,
+			usersChats + "." + usersChatsUserID: userID,
+		}).
+		Where(deleted)
+
+	sql, args, err := q.ToSql()
+	if err != nil {
+		return "", sl.Err(op, err)
+	}
+
+	var role model.Role
+
+	err = r.db.QueryRow(ctx, sql, args...).Scan(&role)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", nil
+		}
+
+		return "", sl.Err(op, repo.TranslateError(err))
+	}
+
+	return role, nil
+}
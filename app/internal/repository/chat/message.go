@@ -0,0 +1,51 @@
+package chatrepo
+
+import (
+	"github.com/defany/chat-server/app/internal/model"
+	"github.com/jackc/pgx/v5"
+)
+
+var messageColumns = []string{
+	chatsMessagesID,
+	chatsMessagesChatID,
+	chatsMessagesUserID,
+	chatsMessagesText,
+	chatsMessagesTimestamp,
+	chatsMessagesClientSentAt,
+	chatsMessagesClientMessageID,
+	chatsMessagesEditedAt,
+	chatsMessagesDeletedAt,
+	chatsMessagesReplyTo,
+	chatsMessagesSystem,
+	chatsMessagesEntities,
+}
+
+func scanMessage(row pgx.CollectableRow) (model.Message, error) {
+	var msg model.Message
+
+	err := row.Scan(
+		&msg.ID,
+		&msg.ChatID,
+		&msg.UserID,
+		&msg.Text,
+		&msg.Timestamp,
+		&msg.ClientSentAt,
+		&msg.ClientMessageID,
+		&msg.EditedAt,
+		&msg.DeletedAt,
+		&msg.ReplyToMessageID,
+		&msg.System,
+		&msg.Entities,
+	)
+
+	return msg, err
+}
+
+// entitiesValue не дает записать в jsonb null вместо пустого массива
+func entitiesValue(entities []model.MessageEntity) []model.MessageEntity {
+	if entities == nil {
+		return []model.MessageEntity{}
+	}
+
+	return entities
+}
@@ -0,0 +1,40 @@
+package chatrepo
+
+import (
+	"context"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/defany/chat-server/app/internal/model"
+	repo "github.com/defany/chat-server/app/internal/repository"
+	"github.com/defany/slogger/pkg/logger/sl"
+	"github.com/jackc/pgx/v5"
+)
+
+func (r *repository) MessageByClientID(ctx context.Context, chatID int64, userID uint64, clientMessageID string) (model.Message, error) {
+	op := sl.FnName()
+
+	q := r.qb.Select(messageColumns...).
+		From(chatsMessages).
+		Where(squirrel.Eq{
+			chatsMessagesChatID:          chatID,
+			chatsMessagesUserID:          userID,
+			chatsMessagesClientMessageID: clientMessageID,
+		})
+
+	sql, args, err := q.ToSql()
+	if err != nil {
+		return model.Message{}, sl.Err(op, err)
+	}
+
+	rows, err := r.db.Query(ctx, sql, args...)
+	if err != nil {
+		return model.Message{}, sl.Err(op, repo.TranslateError(err))
+	}
+
+	msg, err := pgx.CollectExactlyOneRow(rows, scanMessage)
+	if err != nil {
+		return model.Message{}, sl.Err(op, repo.TranslateError(err))
+	}
+
+	return msg, nil
+}
@@ -0,0 +1,66 @@
+package chatrepo
+
+import (
+	"context"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	repo "github.com/defany/chat-server/app/internal/repository"
+	"github.com/defany/slogger/pkg/logger/sl"
+	"github.com/jackc/pgx/v5"
+)
+
+// ExpiredDeleted блокирует пачку чатов с истекшим сроком восстановления до конца транзакции
+func (r *repository) ExpiredDeleted(ctx context.Context, retention time.Duration, limit uint64) ([]int64, error) {
+	op := sl.FnName()
+
+	q := r.qb.Select(chatsID).
+		From(chats).
+		Where(squirrel.Expr(chatsDeletedAt+" < now() - ?::interval", retention)).
+		OrderBy(chatsDeletedAt).
+		Limit(limit).
+		Suffix("for update skip locked")
+
+	sql, args, err := q.ToSql()
+	if err != nil {
+		return nil, sl.Err(op, err)
+	}
+
+	rows, err := r.db.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, sl.Err(op, repo.TranslateError(err))
+	}
+
+	ids, err := pgx.CollectRows(rows, pgx.RowTo[int64])
+	if err != nil {
+		return nil, sl.Err(op, repo.TranslateError(err))
+	}
+
+	return ids, nil
+}
+
+// PurgeDeleted окончательно удаляет чаты, участники, сообщения и вложения уходят каскадом
+func (r *repository) PurgeDeleted(ctx context.Context, ids []int64) error {
+	op := sl.FnName()
+
+	if len(ids) == 0 {
+		return nil
+	}
+
+	q := r.qb.Delete(chats).
+		Where(squirrel.Eq{
+			chatsID: ids,
+		})
+
+	sql, args, err := q.ToSql()
+	if err != nil {
+		return sl.Err(op, err)
+	}
+
+	_, err = r.db.Exec(ctx, sql, args...)
+	if err != nil {
+		return sl.Err(op, repo.TranslateError(err))
+	}
+
+	return nil
+}
@@ -0,0 +1,36 @@
+package chatrepo
+
+import (
+	"context"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/defany/chat-server/app/internal/apperr"
+	repo "github.com/defany/chat-server/app/internal/repository"
+	"github.com/defany/slogger/pkg/logger/sl"
+)
+
+func (r *repository) RemoveMember(ctx context.Context, chatID int64, userID uint64) error {
+	op := sl.FnName()
+
+	q := r.qb.Delete(usersChats).
+		Where(squirrel.Eq{
+			usersChatsChatID: chatID,
+			usersChatsUserID: userID,
+		})
+
+	sql, args, err := q.ToSql()
+	if err != nil {
+		return sl.Err(op, err)
+	}
+
+	tag, err := r.db.Exec(ctx, sql, args...)
+	if err != nil {
+		return sl.Err(op, repo.TranslateError(err))
+	}
+
+	if tag.RowsAffected() == 0 {
+		return sl.Err(op, apperr.NotFound("chat member not found"))
+	}
+
+	return nil
+}
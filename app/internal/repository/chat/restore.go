@@ -0,0 +1,38 @@
+package chatrepo
+
+import (
+	"context"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/defany/chat-server/app/internal/apperr"
+	repo "github.com/defany/chat-server/app/internal/repository"
+	"github.com/defany/slogger/pkg/logger/sl"
+)
+
+func (r *repository) Restore(ctx context.Context, id int64, retention time.Duration) error {
+	op := sl.FnName()
+
+	q := r.qb.Update(chats).
+		Set(chatsDeletedAt, nil).
+		Where(squirrel.Eq{
+			chatsID: id,
+		}).
+		Where(squirrel.Expr(chatsDeletedAt+" > now() - ?::interval", retention))
+
+	sql, args, err := q.ToSql()
+	if err != nil {
+		return sl.Err(op, err)
+	}
+
+	tag, err := r.db.Exec(ctx, sql, args...)
+	if err != nil {
+		return sl.Err(op, repo.TranslateError(err))
+	}
+
+	if tag.RowsAffected() == 0 {
+		return sl.Err(op, apperr.FailedPrecondition("chat retention period is over"))
+	}
+
+	return nil
+}
@@ -0,0 +1,107 @@
+package chatrepo
+
+import (
+	"context"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/defany/chat-server/app/internal/model"
+	repo "github.com/defany/chat-server/app/internal/repository"
+	"github.com/defany/slogger/pkg/logger/sl"
+	"github.com/jackc/pgx/v5"
+)
+
+const (
+	searchConfig = "simple"
+
+	searchHeadlineOptions = "MaxFragments=2, MaxWords=20, MinWords=5"
+
+	// searchVector должен совпадать с выражением chats_messages_search_idx, иначе постгрес не возьмет индекс
+	searchVector = "to_tsvector('" + searchConfig + "', m." + chatsMessagesText + ")"
+)
+
+// SearchMessages ищет по чатам пользователя, подсветку считает только для строк страницы
+func (r *repository) SearchMessages(ctx context.Context, filter model.SearchFilter) ([]model.SearchHit, error) {
+	op := sl.FnName()
+
+	columns := make([]string, 0, len(messageColumns)+2)
+	for _, column := range messageColumns {
+		columns = append(columns, "m."+column)
+	}
+
+	columns = append(columns, "ts_rank("+searchVector+", q) as rank", "q")
+
+	// Вложенный запрос собирается с плейсхолдерами "?", внешний построитель сам пронумерует их для постгреса
+	inner := squirrel.Select(columns...).
+		From(chatsMessages+" m").
+		Join(usersChats+" uc on uc."+usersChatsChatID+" = m."+chatsMessagesChatID).
+		Join(chats+" c on c."+chatsID+" = m."+chatsMessagesChatID+" and c."+chatsDeletedAt+" is null").
+		JoinClause("cross join websearch_to_tsquery('"+searchConfig+"', ?) q", filter.Query).
+		Where(squirrel.Eq{
+			"uc." + usersChatsUserID:      filter.UserID,
+			"m." + chatsMessagesDeletedAt: nil,
+			"m." + chatsMessagesSystem:    false,
+		}).
+		Where(searchVector + " @@ q")
+
+	if filter.ChatID != 0 {
+		inner = inner.Where(squirrel.Eq{
+			"m." + chatsMessagesChatID: filter.ChatID,
+		})
+	}
+
+	outer := make([]string, 0, len(messageColumns)+2)
+	for _, column := range messageColumns {
+		outer = append(outer, "s."+column)
+	}
+
+	outer = append(outer, "ts_headline('"+searchConfig+"', s."+chatsMessagesText+", s.q, '"+searchHeadlineOptions+"')", "s.rank")
+
+	q := r.qb.Select(outer...).
+		FromSelect(inner, "s").
+		OrderBy("s.rank desc", "s."+chatsMessagesID+" desc").
+		Limit(filter.Limit)
+
+	if filter.Cursor != nil {
+		q = q.Where(squirrel.Expr("(s.rank, s."+chatsMessagesID+") < (?::real, ?)", filter.Cursor.Rank, filter.Cursor.ID))
+	}
+
+	sql, args, err := q.ToSql()
+	if err != nil {
+		return nil, sl.Err(op, err)
+	}
+
+	rows, err := r.db.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, sl.Err(op, repo.TranslateError(err))
+	}
+
+	hits, err := pgx.CollectRows(rows, scanSearchHit)
+	if err != nil {
+		return nil, sl.Err(op, repo.TranslateError(err))
+	}
+
+	return hits, nil
+}
+
+func scanSearchHit(row pgx.CollectableRow) (model.SearchHit, error) {
+	var hit model.SearchHit
+
+	err := row.Scan(
+		&hit.Message.ID,
+		&hit.Message.ChatID,
+		&hit.Message.UserID,
+		&hit.Message.Text,
+		&hit.Message.Timestamp,
+		&hit.Message.ClientSentAt,
+		&hit.Message.ClientMessageID,
+		&hit.Message.EditedAt,
+		&hit.Message.DeletedAt,
+		&hit.Message.ReplyToMessageID,
+		&hit.Message.System,
+		&hit.Message.Entities,
+		&hit.Snippet,
+		&hit.Rank,
+	)
+
+	return hit, err
+}
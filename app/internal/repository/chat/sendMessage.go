@@ -2,27 +2,57 @@ package chatrepo
 
 import (
 	"context"
+	"errors"
+	"strings"
 
+	"github.com/defany/chat-server/app/internal/apperr"
 	"github.com/defany/chat-server/app/internal/converter"
+	"github.com/defany/chat-server/app/internal/model"
+	repo "github.com/defany/chat-server/app/internal/repository"
 	"github.com/defany/slogger/pkg/logger/sl"
+	"github.com/jackc/pgx/v5"
 )
 
-func (r *repository) SendMessage(ctx context.Context, input converter.SendMessageInput) error {
+// SendMessage сохраняет сообщение, время проставляет сама база.
+// Если сообщение с таким же ключом идемпотентности уже есть, возвращает apperr.ErrAlreadyExists
+func (r *repository) SendMessage(ctx context.Context, input converter.SendMessageInput) (model.Message, error) {
 	op := sl.FnName()
 
+	var clientMessageID *string
+	if input.ClientMessageID != "" {
+		clientMessageID = &input.ClientMessageID
+	}
+
+	var replyTo *uint64
+	if input.ReplyToMessageID != 0 {
+		replyTo = &input.ReplyToMessageID
+	}
+
 	q := r.qb.Insert(chatsMessages).
-		Columns(chatsMessagesChatID, chatsMessagesFrom, chatsMessagesText).
-		Values(input.ChatID, input.From, input.Text)
+		Columns(chatsMessagesChatID, chatsMessagesUserID, chatsMessagesText, chatsMessagesClientSentAt, chatsMessagesClientMessageID, chatsMessagesReplyTo, chatsMessagesEntities).
+		Values(input.ChatID, input.From, input.Text, input.ClientSentAt, clientMessageID, replyTo, entitiesValue(input.Entities)).
+		Suffix("on conflict (" + chatsMessagesChatID + ", " + chatsMessagesUserID + ", " + chatsMessagesClientMessageID + ") " +
+			"where " + chatsMessagesClientMessageID + " is not null do nothing " +
+			"returning " + strings.Join(messageColumns, ", "))
 
 	sql, args, err := q.ToSql()
 	if err != nil {
-		return sl.Err(op, err)
+		return model.Message{}, sl.Err(op, err)
 	}
 
-	_, err = r.db.Exec(ctx, sql, args...)
+	rows, err := r.db.Query(ctx, sql, args...)
 	if err != nil {
-		return sl.Err(op, err)
+		return model.Message{}, sl.Err(op, repo.TranslateError(err))
+	}
+
+	msg, err := pgx.CollectExactlyOneRow(rows, scanMessage)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.Message{}, sl.Err(op, apperr.AlreadyExists("message with this client message id already exists"))
+		}
+
+		return model.Message{}, sl.Err(op, repo.TranslateError(err))
 	}
 
-	return nil
+	return msg, nil
 }
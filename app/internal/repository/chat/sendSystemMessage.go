@@ -0,0 +1,38 @@
+package chatrepo
+
+import (
+	"context"
+	"strings"
+
+	"github.com/defany/chat-server/app/internal/model"
+	repo "github.com/defany/chat-server/app/internal/repository"
+	"github.com/defany/slogger/pkg/logger/sl"
+	"github.com/jackc/pgx/v5"
+)
+
+// SendSystemMessage сохраняет служебное сообщение от имени пользователя, совершившего действие
+func (r *repository) SendSystemMessage(ctx context.Context, chatID int64, userID uint64, text string) (model.Message, error) {
+	op := sl.FnName()
+
+	q := r.qb.Insert(chatsMessages).
+		Columns(chatsMessagesChatID, chatsMessagesUserID, chatsMessagesText, chatsMessagesSystem).
+		Values(chatID, userID, text, true).
+		Suffix("returning " + strings.Join(messageColumns, ", "))
+
+	sql, args, err := q.ToSql()
+	if err != nil {
+		return model.Message{}, sl.Err(op, err)
+	}
+
+	rows, err := r.db.Query(ctx, sql, args...)
+	if err != nil {
+		return model.Message{}, sl.Err(op, repo.TranslateError(err))
+	}
+
+	msg, err := pgx.CollectExactlyOneRow(rows, scanMessage)
+	if err != nil {
+		return model.Message{}, sl.Err(op, repo.TranslateError(err))
+	}
+
+	return msg, nil
+}
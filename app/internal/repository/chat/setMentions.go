@@ -0,0 +1,63 @@
+package chatrepo
+
+import (
+	"context"
+
+	"github.com/Masterminds/squirrel"
+	repo "github.com/defany/chat-server/app/internal/repository"
+	"github.com/defany/slogger/pkg/logger/sl"
+	"github.com/jackc/pgx/v5"
+)
+
+// SetMentions заменяет упоминания сообщения, вставляя только участников чата
+func (r *repository) SetMentions(ctx context.Context, messageID uint64, chatID int64, userIDs []uint64) ([]uint64, error) {
+	op := sl.FnName()
+
+	sql, args, err := r.qb.Delete(chatsMessagesMentions).
+		Where(squirrel.Eq{
+			chatsMessagesMentionsMessageID: messageID,
+		}).
+		ToSql()
+	if err != nil {
+		return nil, sl.Err(op, err)
+	}
+
+	_, err = r.db.Exec(ctx, sql, args...)
+	if err != nil {
+		return nil, sl.Err(op, repo.TranslateError(err))
+	}
+
+	if len(userIDs) == 0 {
+		return nil, nil
+	}
+
+	members := squirrel.Select().
+		Column("?::bigint", messageID).
+		Column(usersChatsUserID).
+		From(usersChats).
+		Where(squirrel.Eq{
+			usersChatsChatID: chatID,
+			usersChatsUserID: userIDs,
+		})
+
+	sql, args, err = r.qb.Insert(chatsMessagesMentions).
+		Columns(chatsMessagesMentionsMessageID, chatsMessagesMentionsUserID).
+		Select(members).
+		Suffix("returning " + chatsMessagesMentionsUserID).
+		ToSql()
+	if err != nil {
+		return nil, sl.Err(op, err)
+	}
+
+	rows, err := r.db.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, sl.Err(op, repo.TranslateError(err))
+	}
+
+	mentioned, err := pgx.CollectRows(rows, pgx.RowTo[uint64])
+	if err != nil {
+		return nil, sl.Err(op, repo.TranslateError(err))
+	}
+
+	return mentioned, nil
+}
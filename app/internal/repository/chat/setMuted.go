@@ -0,0 +1,37 @@
+package chatrepo
+
+import (
+	"context"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/defany/chat-server/app/internal/apperr"
+	repo "github.com/defany/chat-server/app/internal/repository"
+	"github.com/defany/slogger/pkg/logger/sl"
+)
+
+func (r *repository) SetMuted(ctx context.Context, chatID int64, userID uint64, muted bool) error {
+	op := sl.FnName()
+
+	q := r.qb.Update(usersChats).
+		Set(usersChatsMuted, muted).
+		Where(squirrel.Eq{
+			usersChatsChatID: chatID,
+			usersChatsUserID: userID,
+		})
+
+	sql, args, err := q.ToSql()
+	if err != nil {
+		return sl.Err(op, err)
+	}
+
+	tag, err := r.db.Exec(ctx, sql, args...)
+	if err != nil {
+		return sl.Err(op, repo.TranslateError(err))
+	}
+
+	if tag.RowsAffected() == 0 {
+		return sl.Err(op, apperr.NotFound("chat member not found"))
+	}
+
+	return nil
+}
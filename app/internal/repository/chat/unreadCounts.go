@@ -0,0 +1,57 @@
+package chatrepo
+
+import (
+	"context"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/defany/chat-server/app/internal/model"
+	repo "github.com/defany/chat-server/app/internal/repository"
+	"github.com/defany/slogger/pkg/logger/sl"
+	"github.com/jackc/pgx/v5"
+)
+
+// UnreadCounts считает непрочитанные по всем чатам пользователя одним запросом.
+// Свои и удаленные сообщения непрочитанными не считаются
+func (r *repository) UnreadCounts(ctx context.Context, userID uint64) ([]model.UnreadCount, error) {
+	op := sl.FnName()
+
+	q := r.qb.Select(
+		"uc."+usersChatsChatID,
+		"count(m."+chatsMessagesID+")",
+		"coalesce(uc."+usersChatsLastReadMessageID+", 0)",
+	).
+		From(usersChats+" uc").
+		Join(chats+" c on c."+chatsID+" = uc."+usersChatsChatID+" and c."+chatsDeletedAt+" is null").
+		LeftJoin(chatsMessages+" m on m."+chatsMessagesChatID+" = uc."+usersChatsChatID+
+			" and m."+chatsMessagesID+" > coalesce(uc."+usersChatsLastReadMessageID+", 0)"+
+			" and m."+chatsMessagesUserID+" <> uc."+usersChatsUserID+
+			" and m."+chatsMessagesDeletedAt+" is null").
+		Where(squirrel.Eq{
+			"uc." + usersChatsUserID: userID,
+		}).
+		GroupBy("uc."+usersChatsChatID, "uc."+usersChatsLastReadMessageID).
+		OrderBy("uc." + usersChatsChatID)
+
+	sql, args, err := q.ToSql()
+	if err != nil {
+		return nil, sl.Err(op, err)
+	}
+
+	rows, err := r.db.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, sl.Err(op, repo.TranslateError(err))
+	}
+
+	counts, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (model.UnreadCount, error) {
+		var count model.UnreadCount
+
+		err := row.Scan(&count.ChatID, &count.Count, &count.LastReadMessageID)
+
+		return count, err
+	})
+	if err != nil {
+		return nil, sl.Err(op, repo.TranslateError(err))
+	}
+
+	return counts, nil
+}
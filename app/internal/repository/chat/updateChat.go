@@ -0,0 +1,52 @@
+package chatrepo
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/defany/chat-server/app/internal/apperr"
+	"github.com/defany/chat-server/app/internal/model"
+	repo "github.com/defany/chat-server/app/internal/repository"
+	"github.com/defany/slogger/pkg/logger/sl"
+	"github.com/jackc/pgx/v5"
+)
+
+// UpdateChat сохраняет метаданные, только если версия в базе совпадает с chat.Version, и увеличивает ее
+func (r *repository) UpdateChat(ctx context.Context, chat model.Chat) (model.Chat, error) {
+	op := sl.FnName()
+
+	q := r.qb.Update(chats).
+		Set(chatsTitle, chat.Title).
+		Set(chatsDescription, chat.Description).
+		Set(chatsAvatarURL, chat.AvatarURL).
+		Set(chatsVersion, squirrel.Expr(chatsVersion+" + 1")).
+		Where(squirrel.Eq{
+			chatsID:        chat.ID,
+			chatsVersion:   chat.Version,
+			chatsDeletedAt: nil,
+		}).
+		Suffix("returning " + strings.Join(chatColumns, ", "))
+
+	sql, args, err := q.ToSql()
+	if err != nil {
+		return model.Chat{}, sl.Err(op, err)
+	}
+
+	rows, err := r.db.Query(ctx, sql, args...)
+	if err != nil {
+		return model.Chat{}, sl.Err(op, repo.TranslateError(err))
+	}
+
+	updated, err := pgx.CollectExactlyOneRow(rows, scanChat)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.Chat{}, sl.Err(op, apperr.FailedPrecondition("chat was changed by someone else, reload it and try again"))
+		}
+
+		return model.Chat{}, sl.Err(op, repo.TranslateError(err))
+	}
+
+	return updated, nil
+}
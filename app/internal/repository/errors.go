@@ -0,0 +1,76 @@
+package repository
+
+import (
+	"errors"
+
+	"github.com/defany/chat-server/app/internal/apperr"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// https://www.postgresql.org/docs/current/errcodes-appendix.html
+const (
+	pgNotNullViolation    = "23502"
+	pgForeignKeyViolation = "23503"
+	pgUniqueViolation     = "23505"
+	pgCheckViolation      = "23514"
+)
+
+type constraint struct {
+	field   string
+	message string
+}
+
+var constraints = map[string]constraint{
+	"users_chats_chat_id_fkey":       {field: "chat_id", message: "chat not found"},
+	"chats_messages_chat_id_fkey":    {field: "chat_id", message: "chat not found"},
+	"users_chats_pkey":               {field: "user_id", message: "user is already a chat member"},
+	"positive_users_chats_user_id":   {field: "user_id", message: "user id must be positive"},
+	"users_chats_role_check":         {field: "role", message: "unknown chat role"},
+	"chats_messages_reply_to_fkey":   {field: "reply_to_message_id", message: "replied message not found in this chat"},
+	"messages_reactions_emoji_check": {field: "emoji", message: "emoji must be from 1 to 32 bytes long"},
+	"chats_kind_check":               {field: "kind", message: "unknown chat kind"},
+	"chats_direct_pair_key":          {field: "user_id", message: "direct chat with this user already exists"},
+	"chats_attachments_chat_id_fkey": {field: "chat_id", message: "chat not found"},
+	"chats_attachments_size_check":   {field: "size", message: "attachment size must not be negative"},
+}
+
+// TranslateError превращает ошибки постгреса в доменные, чтобы сервисный слой и апи не знали про pgx
+func TranslateError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	if errors.Is(err, pgx.ErrNoRows) {
+		return apperr.Wrap(apperr.CodeNotFound, "not found", err)
+	}
+
+	var pgErr *pgconn.PgError
+	if !errors.As(err, &pgErr) {
+		return err
+	}
+
+	c, ok := constraints[pgErr.ConstraintName]
+	if !ok {
+		c = constraint{
+			field:   pgErr.ColumnName,
+			message: pgErr.Message,
+		}
+	}
+
+	switch pgErr.Code {
+	case pgForeignKeyViolation:
+		return apperr.Wrap(apperr.CodeNotFound, c.message, err)
+	case pgUniqueViolation:
+		return apperr.Wrap(apperr.CodeAlreadyExists, c.message, err)
+	case pgCheckViolation, pgNotNullViolation:
+		e := apperr.Wrap(apperr.CodeInvalidArgument, c.message, err)
+		e.Violations = []apperr.FieldViolation{
+			{Field: c.field, Description: c.message},
+		}
+
+		return e
+	default:
+		return err
+	}
+}
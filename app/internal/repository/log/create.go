@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/defany/chat-server/app/internal/model"
+	repo "github.com/defany/chat-server/app/internal/repository"
 )
 
 func (r *repository) Log(ctx context.Context, log model.Log) error {
@@ -18,7 +19,7 @@ func (r *repository) Log(ctx context.Context, log model.Log) error {
 
 	_, err = r.db.Exec(ctx, sql, args...)
 	if err != nil {
-		return err
+		return repo.TranslateError(err)
 	}
 
 	return nil
@@ -0,0 +1,581 @@
+// Code generated by mockery v2.42.0. DO NOT EDIT.
+
+package mockrepository
+
+import (
+	context "context"
+
+	model "github.com/defany/chat-server/app/internal/model"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// MockAttachment is an autogenerated mock type for the Attachment type
+type MockAttachment struct {
+	mock.Mock
+}
+
+type MockAttachment_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockAttachment) EXPECT() *MockAttachment_Expecter {
+	return &MockAttachment_Expecter{mock: &_m.Mock}
+}
+
+// Attach provides a mock function with given fields: ctx, messageID, chatID, userID, ids
+func (_m *MockAttachment) Attach(ctx context.Context, messageID uint64, chatID int64, userID uint64, ids []uint64) ([]model.Attachment, error) {
+	ret := _m.Called(ctx, messageID, chatID, userID, ids)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Attach")
+	}
+
+	var r0 []model.Attachment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, int64, uint64, []uint64) ([]model.Attachment, error)); ok {
+		return rf(ctx, messageID, chatID, userID, ids)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, int64, uint64, []uint64) []model.Attachment); ok {
+		r0 = rf(ctx, messageID, chatID, userID, ids)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Attachment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, int64, uint64, []uint64) error); ok {
+		r1 = rf(ctx, messageID, chatID, userID, ids)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAttachment_Attach_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Attach'
+type MockAttachment_Attach_Call struct {
+	*mock.Call
+}
+
+// Attach is a helper method to define mock.On call
+//   - ctx context.Context
+//   - messageID uint64
+//   - chatID int64
+//   - userID uint64
+//   - ids []uint64
+func (_e *MockAttachment_Expecter) Attach(ctx interface{}, messageID interface{}, chatID interface{}, userID interface{}, ids interface{}) *MockAttachment_Attach_Call {
+	return &MockAttachment_Attach_Call{Call: _e.mock.On("Attach", ctx, messageID, chatID, userID, ids)}
+}
+
+func (_c *MockAttachment_Attach_Call) Run(run func(ctx context.Context, messageID uint64, chatID int64, userID uint64, ids []uint64)) *MockAttachment_Attach_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(int64), args[3].(uint64), args[4].([]uint64))
+	})
+	return _c
+}
+
+func (_c *MockAttachment_Attach_Call) Return(_a0 []model.Attachment, _a1 error) *MockAttachment_Attach_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAttachment_Attach_Call) RunAndReturn(run func(context.Context, uint64, int64, uint64, []uint64) ([]model.Attachment, error)) *MockAttachment_Attach_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Attachment provides a mock function with given fields: ctx, id
+func (_m *MockAttachment) Attachment(ctx context.Context, id uint64) (model.Attachment, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Attachment")
+	}
+
+	var r0 model.Attachment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) (model.Attachment, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) model.Attachment); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(model.Attachment)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAttachment_Attachment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Attachment'
+type MockAttachment_Attachment_Call struct {
+	*mock.Call
+}
+
+// Attachment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uint64
+func (_e *MockAttachment_Expecter) Attachment(ctx interface{}, id interface{}) *MockAttachment_Attachment_Call {
+	return &MockAttachment_Attachment_Call{Call: _e.mock.On("Attachment", ctx, id)}
+}
+
+func (_c *MockAttachment_Attachment_Call) Run(run func(ctx context.Context, id uint64)) *MockAttachment_Attachment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockAttachment_Attachment_Call) Return(_a0 model.Attachment, _a1 error) *MockAttachment_Attachment_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAttachment_Attachment_Call) RunAndReturn(run func(context.Context, uint64) (model.Attachment, error)) *MockAttachment_Attachment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ByMessages provides a mock function with given fields: ctx, messageIDs
+func (_m *MockAttachment) ByMessages(ctx context.Context, messageIDs []uint64) (map[uint64][]model.Attachment, error) {
+	ret := _m.Called(ctx, messageIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ByMessages")
+	}
+
+	var r0 map[uint64][]model.Attachment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []uint64) (map[uint64][]model.Attachment, error)); ok {
+		return rf(ctx, messageIDs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []uint64) map[uint64][]model.Attachment); ok {
+		r0 = rf(ctx, messageIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[uint64][]model.Attachment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []uint64) error); ok {
+		r1 = rf(ctx, messageIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAttachment_ByMessages_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ByMessages'
+type MockAttachment_ByMessages_Call struct {
+	*mock.Call
+}
+
+// ByMessages is a helper method to define mock.On call
+//   - ctx context.Context
+//   - messageIDs []uint64
+func (_e *MockAttachment_Expecter) ByMessages(ctx interface{}, messageIDs interface{}) *MockAttachment_ByMessages_Call {
+	return &MockAttachment_ByMessages_Call{Call: _e.mock.On("ByMessages", ctx, messageIDs)}
+}
+
+func (_c *MockAttachment_ByMessages_Call) Run(run func(ctx context.Context, messageIDs []uint64)) *MockAttachment_ByMessages_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]uint64))
+	})
+	return _c
+}
+
+func (_c *MockAttachment_ByMessages_Call) Return(_a0 map[uint64][]model.Attachment, _a1 error) *MockAttachment_ByMessages_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAttachment_ByMessages_Call) RunAndReturn(run func(context.Context, []uint64) (map[uint64][]model.Attachment, error)) *MockAttachment_ByMessages_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Create provides a mock function with given fields: ctx, attachment
+func (_m *MockAttachment) Create(ctx context.Context, attachment model.Attachment) (model.Attachment, error) {
+	ret := _m.Called(ctx, attachment)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 model.Attachment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.Attachment) (model.Attachment, error)); ok {
+		return rf(ctx, attachment)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.Attachment) model.Attachment); ok {
+		r0 = rf(ctx, attachment)
+	} else {
+		r0 = ret.Get(0).(model.Attachment)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.Attachment) error); ok {
+		r1 = rf(ctx, attachment)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAttachment_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockAttachment_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - attachment model.Attachment
+func (_e *MockAttachment_Expecter) Create(ctx interface{}, attachment interface{}) *MockAttachment_Create_Call {
+	return &MockAttachment_Create_Call{Call: _e.mock.On("Create", ctx, attachment)}
+}
+
+func (_c *MockAttachment_Create_Call) Run(run func(ctx context.Context, attachment model.Attachment)) *MockAttachment_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.Attachment))
+	})
+	return _c
+}
+
+func (_c *MockAttachment_Create_Call) Return(_a0 model.Attachment, _a1 error) *MockAttachment_Create_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAttachment_Create_Call) RunAndReturn(run func(context.Context, model.Attachment) (model.Attachment, error)) *MockAttachment_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteByMessage provides a mock function with given fields: ctx, messageID
+func (_m *MockAttachment) DeleteByMessage(ctx context.Context, messageID uint64) error {
+	ret := _m.Called(ctx, messageID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteByMessage")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) error); ok {
+		r0 = rf(ctx, messageID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockAttachment_DeleteByMessage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteByMessage'
+type MockAttachment_DeleteByMessage_Call struct {
+	*mock.Call
+}
+
+// DeleteByMessage is a helper method to define mock.On call
+//   - ctx context.Context
+//   - messageID uint64
+func (_e *MockAttachment_Expecter) DeleteByMessage(ctx interface{}, messageID interface{}) *MockAttachment_DeleteByMessage_Call {
+	return &MockAttachment_DeleteByMessage_Call{Call: _e.mock.On("DeleteByMessage", ctx, messageID)}
+}
+
+func (_c *MockAttachment_DeleteByMessage_Call) Run(run func(ctx context.Context, messageID uint64)) *MockAttachment_DeleteByMessage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockAttachment_DeleteByMessage_Call) Return(_a0 error) *MockAttachment_DeleteByMessage_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAttachment_DeleteByMessage_Call) RunAndReturn(run func(context.Context, uint64) error) *MockAttachment_DeleteByMessage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// OrphanChats provides a mock function with given fields: ctx, chatIDs
+func (_m *MockAttachment) OrphanChats(ctx context.Context, chatIDs []int64) error {
+	ret := _m.Called(ctx, chatIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for OrphanChats")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []int64) error); ok {
+		r0 = rf(ctx, chatIDs)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockAttachment_OrphanChats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OrphanChats'
+type MockAttachment_OrphanChats_Call struct {
+	*mock.Call
+}
+
+// OrphanChats is a helper method to define mock.On call
+//   - ctx context.Context
+//   - chatIDs []int64
+func (_e *MockAttachment_Expecter) OrphanChats(ctx interface{}, chatIDs interface{}) *MockAttachment_OrphanChats_Call {
+	return &MockAttachment_OrphanChats_Call{Call: _e.mock.On("OrphanChats", ctx, chatIDs)}
+}
+
+func (_c *MockAttachment_OrphanChats_Call) Run(run func(ctx context.Context, chatIDs []int64)) *MockAttachment_OrphanChats_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]int64))
+	})
+	return _c
+}
+
+func (_c *MockAttachment_OrphanChats_Call) Return(_a0 error) *MockAttachment_OrphanChats_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAttachment_OrphanChats_Call) RunAndReturn(run func(context.Context, []int64) error) *MockAttachment_OrphanChats_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// OrphanMessage provides a mock function with given fields: ctx, messageID
+func (_m *MockAttachment) OrphanMessage(ctx context.Context, messageID uint64) error {
+	ret := _m.Called(ctx, messageID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for OrphanMessage")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) error); ok {
+		r0 = rf(ctx, messageID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockAttachment_OrphanMessage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OrphanMessage'
+type MockAttachment_OrphanMessage_Call struct {
+	*mock.Call
+}
+
+// OrphanMessage is a helper method to define mock.On call
+//   - ctx context.Context
+//   - messageID uint64
+func (_e *MockAttachment_Expecter) OrphanMessage(ctx interface{}, messageID interface{}) *MockAttachment_OrphanMessage_Call {
+	return &MockAttachment_OrphanMessage_Call{Call: _e.mock.On("OrphanMessage", ctx, messageID)}
+}
+
+func (_c *MockAttachment_OrphanMessage_Call) Run(run func(ctx context.Context, messageID uint64)) *MockAttachment_OrphanMessage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockAttachment_OrphanMessage_Call) Return(_a0 error) *MockAttachment_OrphanMessage_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAttachment_OrphanMessage_Call) RunAndReturn(run func(context.Context, uint64) error) *MockAttachment_OrphanMessage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Orphans provides a mock function with given fields: ctx, limit
+func (_m *MockAttachment) Orphans(ctx context.Context, limit uint64) ([]string, error) {
+	ret := _m.Called(ctx, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Orphans")
+	}
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) ([]string, error)); ok {
+		return rf(ctx, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) []string); ok {
+		r0 = rf(ctx, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAttachment_Orphans_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Orphans'
+type MockAttachment_Orphans_Call struct {
+	*mock.Call
+}
+
+// Orphans is a helper method to define mock.On call
+//   - ctx context.Context
+//   - limit uint64
+func (_e *MockAttachment_Expecter) Orphans(ctx interface{}, limit interface{}) *MockAttachment_Orphans_Call {
+	return &MockAttachment_Orphans_Call{Call: _e.mock.On("Orphans", ctx, limit)}
+}
+
+func (_c *MockAttachment_Orphans_Call) Run(run func(ctx context.Context, limit uint64)) *MockAttachment_Orphans_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockAttachment_Orphans_Call) Return(_a0 []string, _a1 error) *MockAttachment_Orphans_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAttachment_Orphans_Call) RunAndReturn(run func(context.Context, uint64) ([]string, error)) *MockAttachment_Orphans_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PurgeUnattached provides a mock function with given fields: ctx, ttl, limit
+func (_m *MockAttachment) PurgeUnattached(ctx context.Context, ttl time.Duration, limit uint64) ([]model.Attachment, error) {
+	ret := _m.Called(ctx, ttl, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PurgeUnattached")
+	}
+
+	var r0 []model.Attachment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Duration, uint64) ([]model.Attachment, error)); ok {
+		return rf(ctx, ttl, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Duration, uint64) []model.Attachment); ok {
+		r0 = rf(ctx, ttl, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Attachment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Duration, uint64) error); ok {
+		r1 = rf(ctx, ttl, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAttachment_PurgeUnattached_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PurgeUnattached'
+type MockAttachment_PurgeUnattached_Call struct {
+	*mock.Call
+}
+
+// PurgeUnattached is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ttl time.Duration
+//   - limit uint64
+func (_e *MockAttachment_Expecter) PurgeUnattached(ctx interface{}, ttl interface{}, limit interface{}) *MockAttachment_PurgeUnattached_Call {
+	return &MockAttachment_PurgeUnattached_Call{Call: _e.mock.On("PurgeUnattached", ctx, ttl, limit)}
+}
+
+func (_c *MockAttachment_PurgeUnattached_Call) Run(run func(ctx context.Context, ttl time.Duration, limit uint64)) *MockAttachment_PurgeUnattached_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Duration), args[2].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockAttachment_PurgeUnattached_Call) Return(_a0 []model.Attachment, _a1 error) *MockAttachment_PurgeUnattached_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAttachment_PurgeUnattached_Call) RunAndReturn(run func(context.Context, time.Duration, uint64) ([]model.Attachment, error)) *MockAttachment_PurgeUnattached_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveOrphans provides a mock function with given fields: ctx, keys
+func (_m *MockAttachment) RemoveOrphans(ctx context.Context, keys []string) error {
+	ret := _m.Called(ctx, keys)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveOrphans")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []string) error); ok {
+		r0 = rf(ctx, keys)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockAttachment_RemoveOrphans_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveOrphans'
+type MockAttachment_RemoveOrphans_Call struct {
+	*mock.Call
+}
+
+// RemoveOrphans is a helper method to define mock.On call
+//   - ctx context.Context
+//   - keys []string
+func (_e *MockAttachment_Expecter) RemoveOrphans(ctx interface{}, keys interface{}) *MockAttachment_RemoveOrphans_Call {
+	return &MockAttachment_RemoveOrphans_Call{Call: _e.mock.On("RemoveOrphans", ctx, keys)}
+}
+
+func (_c *MockAttachment_RemoveOrphans_Call) Run(run func(ctx context.Context, keys []string)) *MockAttachment_RemoveOrphans_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]string))
+	})
+	return _c
+}
+
+func (_c *MockAttachment_RemoveOrphans_Call) Return(_a0 error) *MockAttachment_RemoveOrphans_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAttachment_RemoveOrphans_Call) RunAndReturn(run func(context.Context, []string) error) *MockAttachment_RemoveOrphans_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockAttachment creates a new instance of MockAttachment. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockAttachment(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockAttachment {
+	mock := &MockAttachment{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -9,6 +9,8 @@ import (
 	mock "github.com/stretchr/testify/mock"
 
 	model "github.com/defany/chat-server/app/internal/model"
+
+	time "time"
 )
 
 // MockChat is an autogenerated mock type for the Chat type
@@ -24,6 +26,160 @@ func (_m *MockChat) EXPECT() *MockChat_Expecter {
 	return &MockChat_Expecter{mock: &_m.Mock}
 }
 
+// AddMembers provides a mock function with given fields: ctx, chatID, members
+func (_m *MockChat) AddMembers(ctx context.Context, chatID uint64, members []model.ChatMember) error {
+	ret := _m.Called(ctx, chatID, members)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddMembers")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, []model.ChatMember) error); ok {
+		r0 = rf(ctx, chatID, members)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockChat_AddMembers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddMembers'
+type MockChat_AddMembers_Call struct {
+	*mock.Call
+}
+
+// AddMembers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - chatID uint64
+//   - members []model.ChatMember
+func (_e *MockChat_Expecter) AddMembers(ctx interface{}, chatID interface{}, members interface{}) *MockChat_AddMembers_Call {
+	return &MockChat_AddMembers_Call{Call: _e.mock.On("AddMembers", ctx, chatID, members)}
+}
+
+func (_c *MockChat_AddMembers_Call) Run(run func(ctx context.Context, chatID uint64, members []model.ChatMember)) *MockChat_AddMembers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].([]model.ChatMember))
+	})
+	return _c
+}
+
+func (_c *MockChat_AddMembers_Call) Return(_a0 error) *MockChat_AddMembers_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockChat_AddMembers_Call) RunAndReturn(run func(context.Context, uint64, []model.ChatMember) error) *MockChat_AddMembers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AddMessageRevision provides a mock function with given fields: ctx, messageID, text, entities
+func (_m *MockChat) AddMessageRevision(ctx context.Context, messageID uint64, text string, entities []model.MessageEntity) error {
+	ret := _m.Called(ctx, messageID, text, entities)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddMessageRevision")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, string, []model.MessageEntity) error); ok {
+		r0 = rf(ctx, messageID, text, entities)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockChat_AddMessageRevision_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddMessageRevision'
+type MockChat_AddMessageRevision_Call struct {
+	*mock.Call
+}
+
+// AddMessageRevision is a helper method to define mock.On call
+//   - ctx context.Context
+//   - messageID uint64
+//   - text string
+//   - entities []model.MessageEntity
+func (_e *MockChat_Expecter) AddMessageRevision(ctx interface{}, messageID interface{}, text interface{}, entities interface{}) *MockChat_AddMessageRevision_Call {
+	return &MockChat_AddMessageRevision_Call{Call: _e.mock.On("AddMessageRevision", ctx, messageID, text, entities)}
+}
+
+func (_c *MockChat_AddMessageRevision_Call) Run(run func(ctx context.Context, messageID uint64, text string, entities []model.MessageEntity)) *MockChat_AddMessageRevision_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(string), args[3].([]model.MessageEntity))
+	})
+	return _c
+}
+
+func (_c *MockChat_AddMessageRevision_Call) Return(_a0 error) *MockChat_AddMessageRevision_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockChat_AddMessageRevision_Call) RunAndReturn(run func(context.Context, uint64, string, []model.MessageEntity) error) *MockChat_AddMessageRevision_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Chat provides a mock function with given fields: ctx, id
+func (_m *MockChat) Chat(ctx context.Context, id int64) (model.Chat, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Chat")
+	}
+
+	var r0 model.Chat
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (model.Chat, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) model.Chat); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(model.Chat)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockChat_Chat_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Chat'
+type MockChat_Chat_Call struct {
+	*mock.Call
+}
+
+// Chat is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+func (_e *MockChat_Expecter) Chat(ctx interface{}, id interface{}) *MockChat_Chat_Call {
+	return &MockChat_Chat_Call{Call: _e.mock.On("Chat", ctx, id)}
+}
+
+func (_c *MockChat_Chat_Call) Run(run func(ctx context.Context, id int64)) *MockChat_Chat_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockChat_Chat_Call) Return(_a0 model.Chat, _a1 error) *MockChat_Chat_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockChat_Chat_Call) RunAndReturn(run func(context.Context, int64) (model.Chat, error)) *MockChat_Chat_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Create provides a mock function with given fields: ctx, chat
 func (_m *MockChat) Create(ctx context.Context, chat model.Chat) (uint64, error) {
 	ret := _m.Called(ctx, chat)
@@ -128,49 +284,1411 @@ func (_c *MockChat_Delete_Call) RunAndReturn(run func(context.Context, int64) er
 	return _c
 }
 
-// SendMessage provides a mock function with given fields: ctx, input
-func (_m *MockChat) SendMessage(ctx context.Context, input converter.SendMessageInput) error {
-	ret := _m.Called(ctx, input)
+// DeleteMessage provides a mock function with given fields: ctx, id
+func (_m *MockChat) DeleteMessage(ctx context.Context, id uint64) (model.Message, error) {
+	ret := _m.Called(ctx, id)
 
 	if len(ret) == 0 {
-		panic("no return value specified for SendMessage")
+		panic("no return value specified for DeleteMessage")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, converter.SendMessageInput) error); ok {
-		r0 = rf(ctx, input)
+	var r0 model.Message
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) (model.Message, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) model.Message); ok {
+		r0 = rf(ctx, id)
 	} else {
-		r0 = ret.Error(0)
+		r0 = ret.Get(0).(model.Message)
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// MockChat_SendMessage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SendMessage'
-type MockChat_SendMessage_Call struct {
+// MockChat_DeleteMessage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteMessage'
+type MockChat_DeleteMessage_Call struct {
 	*mock.Call
 }
 
-// SendMessage is a helper method to define mock.On call
+// DeleteMessage is a helper method to define mock.On call
 //   - ctx context.Context
-//   - input converter.SendMessageInput
-func (_e *MockChat_Expecter) SendMessage(ctx interface{}, input interface{}) *MockChat_SendMessage_Call {
-	return &MockChat_SendMessage_Call{Call: _e.mock.On("SendMessage", ctx, input)}
+//   - id uint64
+func (_e *MockChat_Expecter) DeleteMessage(ctx interface{}, id interface{}) *MockChat_DeleteMessage_Call {
+	return &MockChat_DeleteMessage_Call{Call: _e.mock.On("DeleteMessage", ctx, id)}
 }
 
-func (_c *MockChat_SendMessage_Call) Run(run func(ctx context.Context, input converter.SendMessageInput)) *MockChat_SendMessage_Call {
+func (_c *MockChat_DeleteMessage_Call) Run(run func(ctx context.Context, id uint64)) *MockChat_DeleteMessage_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(converter.SendMessageInput))
+		run(args[0].(context.Context), args[1].(uint64))
 	})
 	return _c
 }
 
-func (_c *MockChat_SendMessage_Call) Return(_a0 error) *MockChat_SendMessage_Call {
-	_c.Call.Return(_a0)
+func (_c *MockChat_DeleteMessage_Call) Return(_a0 model.Message, _a1 error) *MockChat_DeleteMessage_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockChat_DeleteMessage_Call) RunAndReturn(run func(context.Context, uint64) (model.Message, error)) *MockChat_DeleteMessage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeletedMemberRole provides a mock function with given fields: ctx, chatID, userID
+func (_m *MockChat) DeletedMemberRole(ctx context.Context, chatID int64, userID uint64) (model.Role, error) {
+	ret := _m.Called(ctx, chatID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeletedMemberRole")
+	}
+
+	var r0 model.Role
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, uint64) (model.Role, error)); ok {
+		return rf(ctx, chatID, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, uint64) model.Role); ok {
+		r0 = rf(ctx, chatID, userID)
+	} else {
+		r0 = ret.Get(0).(model.Role)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, uint64) error); ok {
+		r1 = rf(ctx, chatID, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockChat_DeletedMemberRole_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeletedMemberRole'
+type MockChat_DeletedMemberRole_Call struct {
+	*mock.Call
+}
+
+// DeletedMemberRole is a helper method to define mock.On call
+//   - ctx context.Context
+//   - chatID int64
+//   - userID uint64
+func (_e *MockChat_Expecter) DeletedMemberRole(ctx interface{}, chatID interface{}, userID interface{}) *MockChat_DeletedMemberRole_Call {
+	return &MockChat_DeletedMemberRole_Call{Call: _e.mock.On("DeletedMemberRole", ctx, chatID, userID)}
+}
+
+func (_c *MockChat_DeletedMemberRole_Call) Run(run func(ctx context.Context, chatID int64, userID uint64)) *MockChat_DeletedMemberRole_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockChat_DeletedMemberRole_Call) Return(_a0 model.Role, _a1 error) *MockChat_DeletedMemberRole_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockChat_DeletedMemberRole_Call) RunAndReturn(run func(context.Context, int64, uint64) (model.Role, error)) *MockChat_DeletedMemberRole_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// EditMessage provides a mock function with given fields: ctx, id, text, entities
+func (_m *MockChat) EditMessage(ctx context.Context, id uint64, text string, entities []model.MessageEntity) (model.Message, error) {
+	ret := _m.Called(ctx, id, text, entities)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EditMessage")
+	}
+
+	var r0 model.Message
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, string, []model.MessageEntity) (model.Message, error)); ok {
+		return rf(ctx, id, text, entities)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, string, []model.MessageEntity) model.Message); ok {
+		r0 = rf(ctx, id, text, entities)
+	} else {
+		r0 = ret.Get(0).(model.Message)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, string, []model.MessageEntity) error); ok {
+		r1 = rf(ctx, id, text, entities)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockChat_EditMessage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EditMessage'
+type MockChat_EditMessage_Call struct {
+	*mock.Call
+}
+
+// EditMessage is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uint64
+//   - text string
+//   - entities []model.MessageEntity
+func (_e *MockChat_Expecter) EditMessage(ctx interface{}, id interface{}, text interface{}, entities interface{}) *MockChat_EditMessage_Call {
+	return &MockChat_EditMessage_Call{Call: _e.mock.On("EditMessage", ctx, id, text, entities)}
+}
+
+func (_c *MockChat_EditMessage_Call) Run(run func(ctx context.Context, id uint64, text string, entities []model.MessageEntity)) *MockChat_EditMessage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(string), args[3].([]model.MessageEntity))
+	})
+	return _c
+}
+
+func (_c *MockChat_EditMessage_Call) Return(_a0 model.Message, _a1 error) *MockChat_EditMessage_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockChat_EditMessage_Call) RunAndReturn(run func(context.Context, uint64, string, []model.MessageEntity) (model.Message, error)) *MockChat_EditMessage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExpiredDeleted provides a mock function with given fields: ctx, retention, limit
+func (_m *MockChat) ExpiredDeleted(ctx context.Context, retention time.Duration, limit uint64) ([]int64, error) {
+	ret := _m.Called(ctx, retention, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExpiredDeleted")
+	}
+
+	var r0 []int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Duration, uint64) ([]int64, error)); ok {
+		return rf(ctx, retention, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Duration, uint64) []int64); ok {
+		r0 = rf(ctx, retention, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]int64)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Duration, uint64) error); ok {
+		r1 = rf(ctx, retention, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockChat_ExpiredDeleted_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExpiredDeleted'
+type MockChat_ExpiredDeleted_Call struct {
+	*mock.Call
+}
+
+// ExpiredDeleted is a helper method to define mock.On call
+//   - ctx context.Context
+//   - retention time.Duration
+//   - limit uint64
+func (_e *MockChat_Expecter) ExpiredDeleted(ctx interface{}, retention interface{}, limit interface{}) *MockChat_ExpiredDeleted_Call {
+	return &MockChat_ExpiredDeleted_Call{Call: _e.mock.On("ExpiredDeleted", ctx, retention, limit)}
+}
+
+func (_c *MockChat_ExpiredDeleted_Call) Run(run func(ctx context.Context, retention time.Duration, limit uint64)) *MockChat_ExpiredDeleted_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Duration), args[2].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockChat_ExpiredDeleted_Call) Return(_a0 []int64, _a1 error) *MockChat_ExpiredDeleted_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockChat_ExpiredDeleted_Call) RunAndReturn(run func(context.Context, time.Duration, uint64) ([]int64, error)) *MockChat_ExpiredDeleted_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOrCreateDirect provides a mock function with given fields: ctx, userID, peerID
+func (_m *MockChat) GetOrCreateDirect(ctx context.Context, userID uint64, peerID uint64) (int64, bool, error) {
+	ret := _m.Called(ctx, userID, peerID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrCreateDirect")
+	}
+
+	var r0 int64
+	var r1 bool
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64) (int64, bool, error)); ok {
+		return rf(ctx, userID, peerID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64) int64); ok {
+		r0 = rf(ctx, userID, peerID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, uint64) bool); ok {
+		r1 = rf(ctx, userID, peerID)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, uint64, uint64) error); ok {
+		r2 = rf(ctx, userID, peerID)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockChat_GetOrCreateDirect_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrCreateDirect'
+type MockChat_GetOrCreateDirect_Call struct {
+	*mock.Call
+}
+
+// GetOrCreateDirect is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint64
+//   - peerID uint64
+func (_e *MockChat_Expecter) GetOrCreateDirect(ctx interface{}, userID interface{}, peerID interface{}) *MockChat_GetOrCreateDirect_Call {
+	return &MockChat_GetOrCreateDirect_Call{Call: _e.mock.On("GetOrCreateDirect", ctx, userID, peerID)}
+}
+
+func (_c *MockChat_GetOrCreateDirect_Call) Run(run func(ctx context.Context, userID uint64, peerID uint64)) *MockChat_GetOrCreateDirect_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockChat_GetOrCreateDirect_Call) Return(_a0 int64, _a1 bool, _a2 error) *MockChat_GetOrCreateDirect_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockChat_GetOrCreateDirect_Call) RunAndReturn(run func(context.Context, uint64, uint64) (int64, bool, error)) *MockChat_GetOrCreateDirect_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// InEditWindow provides a mock function with given fields: ctx, id, window
+func (_m *MockChat) InEditWindow(ctx context.Context, id uint64, window time.Duration) (bool, error) {
+	ret := _m.Called(ctx, id, window)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InEditWindow")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, time.Duration) (bool, error)); ok {
+		return rf(ctx, id, window)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, time.Duration) bool); ok {
+		r0 = rf(ctx, id, window)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, time.Duration) error); ok {
+		r1 = rf(ctx, id, window)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockChat_InEditWindow_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InEditWindow'
+type MockChat_InEditWindow_Call struct {
+	*mock.Call
+}
+
+// InEditWindow is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uint64
+//   - window time.Duration
+func (_e *MockChat_Expecter) InEditWindow(ctx interface{}, id interface{}, window interface{}) *MockChat_InEditWindow_Call {
+	return &MockChat_InEditWindow_Call{Call: _e.mock.On("InEditWindow", ctx, id, window)}
+}
+
+func (_c *MockChat_InEditWindow_Call) Run(run func(ctx context.Context, id uint64, window time.Duration)) *MockChat_InEditWindow_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(time.Duration))
+	})
+	return _c
+}
+
+func (_c *MockChat_InEditWindow_Call) Return(_a0 bool, _a1 error) *MockChat_InEditWindow_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockChat_InEditWindow_Call) RunAndReturn(run func(context.Context, uint64, time.Duration) (bool, error)) *MockChat_InEditWindow_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListChats provides a mock function with given fields: ctx, filter
+func (_m *MockChat) ListChats(ctx context.Context, filter model.ChatsFilter) ([]model.ChatSummary, error) {
+	ret := _m.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListChats")
+	}
+
+	var r0 []model.ChatSummary
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.ChatsFilter) ([]model.ChatSummary, error)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.ChatsFilter) []model.ChatSummary); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.ChatSummary)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.ChatsFilter) error); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockChat_ListChats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListChats'
+type MockChat_ListChats_Call struct {
+	*mock.Call
+}
+
+// ListChats is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter model.ChatsFilter
+func (_e *MockChat_Expecter) ListChats(ctx interface{}, filter interface{}) *MockChat_ListChats_Call {
+	return &MockChat_ListChats_Call{Call: _e.mock.On("ListChats", ctx, filter)}
+}
+
+func (_c *MockChat_ListChats_Call) Run(run func(ctx context.Context, filter model.ChatsFilter)) *MockChat_ListChats_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.ChatsFilter))
+	})
+	return _c
+}
+
+func (_c *MockChat_ListChats_Call) Return(_a0 []model.ChatSummary, _a1 error) *MockChat_ListChats_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockChat_ListChats_Call) RunAndReturn(run func(context.Context, model.ChatsFilter) ([]model.ChatSummary, error)) *MockChat_ListChats_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListMentions provides a mock function with given fields: ctx, filter
+func (_m *MockChat) ListMentions(ctx context.Context, filter model.MentionsFilter) ([]model.Message, error) {
+	ret := _m.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListMentions")
+	}
+
+	var r0 []model.Message
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.MentionsFilter) ([]model.Message, error)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.MentionsFilter) []model.Message); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Message)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.MentionsFilter) error); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockChat_ListMentions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListMentions'
+type MockChat_ListMentions_Call struct {
+	*mock.Call
+}
+
+// ListMentions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter model.MentionsFilter
+func (_e *MockChat_Expecter) ListMentions(ctx interface{}, filter interface{}) *MockChat_ListMentions_Call {
+	return &MockChat_ListMentions_Call{Call: _e.mock.On("ListMentions", ctx, filter)}
+}
+
+func (_c *MockChat_ListMentions_Call) Run(run func(ctx context.Context, filter model.MentionsFilter)) *MockChat_ListMentions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.MentionsFilter))
+	})
+	return _c
+}
+
+func (_c *MockChat_ListMentions_Call) Return(_a0 []model.Message, _a1 error) *MockChat_ListMentions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockChat_ListMentions_Call) RunAndReturn(run func(context.Context, model.MentionsFilter) ([]model.Message, error)) *MockChat_ListMentions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListMessages provides a mock function with given fields: ctx, filter
+func (_m *MockChat) ListMessages(ctx context.Context, filter model.MessagesFilter) ([]model.Message, error) {
+	ret := _m.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListMessages")
+	}
+
+	var r0 []model.Message
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.MessagesFilter) ([]model.Message, error)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.MessagesFilter) []model.Message); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Message)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.MessagesFilter) error); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockChat_ListMessages_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListMessages'
+type MockChat_ListMessages_Call struct {
+	*mock.Call
+}
+
+// ListMessages is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter model.MessagesFilter
+func (_e *MockChat_Expecter) ListMessages(ctx interface{}, filter interface{}) *MockChat_ListMessages_Call {
+	return &MockChat_ListMessages_Call{Call: _e.mock.On("ListMessages", ctx, filter)}
+}
+
+func (_c *MockChat_ListMessages_Call) Run(run func(ctx context.Context, filter model.MessagesFilter)) *MockChat_ListMessages_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.MessagesFilter))
+	})
+	return _c
+}
+
+func (_c *MockChat_ListMessages_Call) Return(_a0 []model.Message, _a1 error) *MockChat_ListMessages_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockChat_ListMessages_Call) RunAndReturn(run func(context.Context, model.MessagesFilter) ([]model.Message, error)) *MockChat_ListMessages_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkRead provides a mock function with given fields: ctx, chatID, userID, messageID
+func (_m *MockChat) MarkRead(ctx context.Context, chatID int64, userID uint64, messageID uint64) error {
+	ret := _m.Called(ctx, chatID, userID, messageID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkRead")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, uint64, uint64) error); ok {
+		r0 = rf(ctx, chatID, userID, messageID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockChat_MarkRead_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkRead'
+type MockChat_MarkRead_Call struct {
+	*mock.Call
+}
+
+// MarkRead is a helper method to define mock.On call
+//   - ctx context.Context
+//   - chatID int64
+//   - userID uint64
+//   - messageID uint64
+func (_e *MockChat_Expecter) MarkRead(ctx interface{}, chatID interface{}, userID interface{}, messageID interface{}) *MockChat_MarkRead_Call {
+	return &MockChat_MarkRead_Call{Call: _e.mock.On("MarkRead", ctx, chatID, userID, messageID)}
+}
+
+func (_c *MockChat_MarkRead_Call) Run(run func(ctx context.Context, chatID int64, userID uint64, messageID uint64)) *MockChat_MarkRead_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(uint64), args[3].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockChat_MarkRead_Call) Return(_a0 error) *MockChat_MarkRead_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockChat_MarkRead_Call) RunAndReturn(run func(context.Context, int64, uint64, uint64) error) *MockChat_MarkRead_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MemberRole provides a mock function with given fields: ctx, chatID, userID
+func (_m *MockChat) MemberRole(ctx context.Context, chatID int64, userID uint64) (model.Role, error) {
+	ret := _m.Called(ctx, chatID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MemberRole")
+	}
+
+	var r0 model.Role
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, uint64) (model.Role, error)); ok {
+		return rf(ctx, chatID, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, uint64) model.Role); ok {
+		r0 = rf(ctx, chatID, userID)
+	} else {
+		r0 = ret.Get(0).(model.Role)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, uint64) error); ok {
+		r1 = rf(ctx, chatID, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockChat_MemberRole_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MemberRole'
+type MockChat_MemberRole_Call struct {
+	*mock.Call
+}
+
+// MemberRole is a helper method to define mock.On call
+//   - ctx context.Context
+//   - chatID int64
+//   - userID uint64
+func (_e *MockChat_Expecter) MemberRole(ctx interface{}, chatID interface{}, userID interface{}) *MockChat_MemberRole_Call {
+	return &MockChat_MemberRole_Call{Call: _e.mock.On("MemberRole", ctx, chatID, userID)}
+}
+
+func (_c *MockChat_MemberRole_Call) Run(run func(ctx context.Context, chatID int64, userID uint64)) *MockChat_MemberRole_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockChat_MemberRole_Call) Return(_a0 model.Role, _a1 error) *MockChat_MemberRole_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockChat_MemberRole_Call) RunAndReturn(run func(context.Context, int64, uint64) (model.Role, error)) *MockChat_MemberRole_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Message provides a mock function with given fields: ctx, chatID, id
+func (_m *MockChat) Message(ctx context.Context, chatID int64, id uint64) (model.Message, error) {
+	ret := _m.Called(ctx, chatID, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Message")
+	}
+
+	var r0 model.Message
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, uint64) (model.Message, error)); ok {
+		return rf(ctx, chatID, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, uint64) model.Message); ok {
+		r0 = rf(ctx, chatID, id)
+	} else {
+		r0 = ret.Get(0).(model.Message)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, uint64) error); ok {
+		r1 = rf(ctx, chatID, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockChat_Message_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Message'
+type MockChat_Message_Call struct {
+	*mock.Call
+}
+
+// Message is a helper method to define mock.On call
+//   - ctx context.Context
+//   - chatID int64
+//   - id uint64
+func (_e *MockChat_Expecter) Message(ctx interface{}, chatID interface{}, id interface{}) *MockChat_Message_Call {
+	return &MockChat_Message_Call{Call: _e.mock.On("Message", ctx, chatID, id)}
+}
+
+func (_c *MockChat_Message_Call) Run(run func(ctx context.Context, chatID int64, id uint64)) *MockChat_Message_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockChat_Message_Call) Return(_a0 model.Message, _a1 error) *MockChat_Message_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockChat_Message_Call) RunAndReturn(run func(context.Context, int64, uint64) (model.Message, error)) *MockChat_Message_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MessageByClientID provides a mock function with given fields: ctx, chatID, userID, clientMessageID
+func (_m *MockChat) MessageByClientID(ctx context.Context, chatID int64, userID uint64, clientMessageID string) (model.Message, error) {
+	ret := _m.Called(ctx, chatID, userID, clientMessageID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MessageByClientID")
+	}
+
+	var r0 model.Message
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, uint64, string) (model.Message, error)); ok {
+		return rf(ctx, chatID, userID, clientMessageID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, uint64, string) model.Message); ok {
+		r0 = rf(ctx, chatID, userID, clientMessageID)
+	} else {
+		r0 = ret.Get(0).(model.Message)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, uint64, string) error); ok {
+		r1 = rf(ctx, chatID, userID, clientMessageID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockChat_MessageByClientID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MessageByClientID'
+type MockChat_MessageByClientID_Call struct {
+	*mock.Call
+}
+
+// MessageByClientID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - chatID int64
+//   - userID uint64
+//   - clientMessageID string
+func (_e *MockChat_Expecter) MessageByClientID(ctx interface{}, chatID interface{}, userID interface{}, clientMessageID interface{}) *MockChat_MessageByClientID_Call {
+	return &MockChat_MessageByClientID_Call{Call: _e.mock.On("MessageByClientID", ctx, chatID, userID, clientMessageID)}
+}
+
+func (_c *MockChat_MessageByClientID_Call) Run(run func(ctx context.Context, chatID int64, userID uint64, clientMessageID string)) *MockChat_MessageByClientID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(uint64), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockChat_MessageByClientID_Call) Return(_a0 model.Message, _a1 error) *MockChat_MessageByClientID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockChat_MessageByClientID_Call) RunAndReturn(run func(context.Context, int64, uint64, string) (model.Message, error)) *MockChat_MessageByClientID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MessageForUpdate provides a mock function with given fields: ctx, chatID, id
+func (_m *MockChat) MessageForUpdate(ctx context.Context, chatID int64, id uint64) (model.Message, error) {
+	ret := _m.Called(ctx, chatID, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MessageForUpdate")
+	}
+
+	var r0 model.Message
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, uint64) (model.Message, error)); ok {
+		return rf(ctx, chatID, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, uint64) model.Message); ok {
+		r0 = rf(ctx, chatID, id)
+	} else {
+		r0 = ret.Get(0).(model.Message)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, uint64) error); ok {
+		r1 = rf(ctx, chatID, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockChat_MessageForUpdate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MessageForUpdate'
+type MockChat_MessageForUpdate_Call struct {
+	*mock.Call
+}
+
+// MessageForUpdate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - chatID int64
+//   - id uint64
+func (_e *MockChat_Expecter) MessageForUpdate(ctx interface{}, chatID interface{}, id interface{}) *MockChat_MessageForUpdate_Call {
+	return &MockChat_MessageForUpdate_Call{Call: _e.mock.On("MessageForUpdate", ctx, chatID, id)}
+}
+
+func (_c *MockChat_MessageForUpdate_Call) Run(run func(ctx context.Context, chatID int64, id uint64)) *MockChat_MessageForUpdate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockChat_MessageForUpdate_Call) Return(_a0 model.Message, _a1 error) *MockChat_MessageForUpdate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockChat_MessageForUpdate_Call) RunAndReturn(run func(context.Context, int64, uint64) (model.Message, error)) *MockChat_MessageForUpdate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PurgeDeleted provides a mock function with given fields: ctx, ids
+func (_m *MockChat) PurgeDeleted(ctx context.Context, ids []int64) error {
+	ret := _m.Called(ctx, ids)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PurgeDeleted")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []int64) error); ok {
+		r0 = rf(ctx, ids)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockChat_PurgeDeleted_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PurgeDeleted'
+type MockChat_PurgeDeleted_Call struct {
+	*mock.Call
+}
+
+// PurgeDeleted is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ids []int64
+func (_e *MockChat_Expecter) PurgeDeleted(ctx interface{}, ids interface{}) *MockChat_PurgeDeleted_Call {
+	return &MockChat_PurgeDeleted_Call{Call: _e.mock.On("PurgeDeleted", ctx, ids)}
+}
+
+func (_c *MockChat_PurgeDeleted_Call) Run(run func(ctx context.Context, ids []int64)) *MockChat_PurgeDeleted_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]int64))
+	})
+	return _c
+}
+
+func (_c *MockChat_PurgeDeleted_Call) Return(_a0 error) *MockChat_PurgeDeleted_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockChat_PurgeDeleted_Call) RunAndReturn(run func(context.Context, []int64) error) *MockChat_PurgeDeleted_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveMember provides a mock function with given fields: ctx, chatID, userID
+func (_m *MockChat) RemoveMember(ctx context.Context, chatID int64, userID uint64) error {
+	ret := _m.Called(ctx, chatID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveMember")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, uint64) error); ok {
+		r0 = rf(ctx, chatID, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockChat_RemoveMember_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveMember'
+type MockChat_RemoveMember_Call struct {
+	*mock.Call
+}
+
+// RemoveMember is a helper method to define mock.On call
+//   - ctx context.Context
+//   - chatID int64
+//   - userID uint64
+func (_e *MockChat_Expecter) RemoveMember(ctx interface{}, chatID interface{}, userID interface{}) *MockChat_RemoveMember_Call {
+	return &MockChat_RemoveMember_Call{Call: _e.mock.On("RemoveMember", ctx, chatID, userID)}
+}
+
+func (_c *MockChat_RemoveMember_Call) Run(run func(ctx context.Context, chatID int64, userID uint64)) *MockChat_RemoveMember_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockChat_RemoveMember_Call) Return(_a0 error) *MockChat_RemoveMember_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockChat_RemoveMember_Call) RunAndReturn(run func(context.Context, int64, uint64) error) *MockChat_RemoveMember_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Restore provides a mock function with given fields: ctx, id, retention
+func (_m *MockChat) Restore(ctx context.Context, id int64, retention time.Duration) error {
+	ret := _m.Called(ctx, id, retention)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Restore")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, time.Duration) error); ok {
+		r0 = rf(ctx, id, retention)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockChat_Restore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Restore'
+type MockChat_Restore_Call struct {
+	*mock.Call
+}
+
+// Restore is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+//   - retention time.Duration
+func (_e *MockChat_Expecter) Restore(ctx interface{}, id interface{}, retention interface{}) *MockChat_Restore_Call {
+	return &MockChat_Restore_Call{Call: _e.mock.On("Restore", ctx, id, retention)}
+}
+
+func (_c *MockChat_Restore_Call) Run(run func(ctx context.Context, id int64, retention time.Duration)) *MockChat_Restore_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(time.Duration))
+	})
+	return _c
+}
+
+func (_c *MockChat_Restore_Call) Return(_a0 error) *MockChat_Restore_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockChat_Restore_Call) RunAndReturn(run func(context.Context, int64, time.Duration) error) *MockChat_Restore_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SearchMessages provides a mock function with given fields: ctx, filter
+func (_m *MockChat) SearchMessages(ctx context.Context, filter model.SearchFilter) ([]model.SearchHit, error) {
+	ret := _m.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchMessages")
+	}
+
+	var r0 []model.SearchHit
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.SearchFilter) ([]model.SearchHit, error)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.SearchFilter) []model.SearchHit); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.SearchHit)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.SearchFilter) error); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockChat_SearchMessages_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchMessages'
+type MockChat_SearchMessages_Call struct {
+	*mock.Call
+}
+
+// SearchMessages is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter model.SearchFilter
+func (_e *MockChat_Expecter) SearchMessages(ctx interface{}, filter interface{}) *MockChat_SearchMessages_Call {
+	return &MockChat_SearchMessages_Call{Call: _e.mock.On("SearchMessages", ctx, filter)}
+}
+
+func (_c *MockChat_SearchMessages_Call) Run(run func(ctx context.Context, filter model.SearchFilter)) *MockChat_SearchMessages_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.SearchFilter))
+	})
+	return _c
+}
+
+func (_c *MockChat_SearchMessages_Call) Return(_a0 []model.SearchHit, _a1 error) *MockChat_SearchMessages_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockChat_SearchMessages_Call) RunAndReturn(run func(context.Context, model.SearchFilter) ([]model.SearchHit, error)) *MockChat_SearchMessages_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SendMessage provides a mock function with given fields: ctx, input
+func (_m *MockChat) SendMessage(ctx context.Context, input converter.SendMessageInput) (model.Message, error) {
+	ret := _m.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SendMessage")
+	}
+
+	var r0 model.Message
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, converter.SendMessageInput) (model.Message, error)); ok {
+		return rf(ctx, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, converter.SendMessageInput) model.Message); ok {
+		r0 = rf(ctx, input)
+	} else {
+		r0 = ret.Get(0).(model.Message)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, converter.SendMessageInput) error); ok {
+		r1 = rf(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockChat_SendMessage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SendMessage'
+type MockChat_SendMessage_Call struct {
+	*mock.Call
+}
+
+// SendMessage is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input converter.SendMessageInput
+func (_e *MockChat_Expecter) SendMessage(ctx interface{}, input interface{}) *MockChat_SendMessage_Call {
+	return &MockChat_SendMessage_Call{Call: _e.mock.On("SendMessage", ctx, input)}
+}
+
+func (_c *MockChat_SendMessage_Call) Run(run func(ctx context.Context, input converter.SendMessageInput)) *MockChat_SendMessage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(converter.SendMessageInput))
+	})
+	return _c
+}
+
+func (_c *MockChat_SendMessage_Call) Return(_a0 model.Message, _a1 error) *MockChat_SendMessage_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockChat_SendMessage_Call) RunAndReturn(run func(context.Context, converter.SendMessageInput) (model.Message, error)) *MockChat_SendMessage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SendSystemMessage provides a mock function with given fields: ctx, chatID, userID, text
+func (_m *MockChat) SendSystemMessage(ctx context.Context, chatID int64, userID uint64, text string) (model.Message, error) {
+	ret := _m.Called(ctx, chatID, userID, text)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SendSystemMessage")
+	}
+
+	var r0 model.Message
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, uint64, string) (model.Message, error)); ok {
+		return rf(ctx, chatID, userID, text)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, uint64, string) model.Message); ok {
+		r0 = rf(ctx, chatID, userID, text)
+	} else {
+		r0 = ret.Get(0).(model.Message)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, uint64, string) error); ok {
+		r1 = rf(ctx, chatID, userID, text)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockChat_SendSystemMessage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SendSystemMessage'
+type MockChat_SendSystemMessage_Call struct {
+	*mock.Call
+}
+
+// SendSystemMessage is a helper method to define mock.On call
+//   - ctx context.Context
+//   - chatID int64
+//   - userID uint64
+//   - text string
+func (_e *MockChat_Expecter) SendSystemMessage(ctx interface{}, chatID interface{}, userID interface{}, text interface{}) *MockChat_SendSystemMessage_Call {
+	return &MockChat_SendSystemMessage_Call{Call: _e.mock.On("SendSystemMessage", ctx, chatID, userID, text)}
+}
+
+func (_c *MockChat_SendSystemMessage_Call) Run(run func(ctx context.Context, chatID int64, userID uint64, text string)) *MockChat_SendSystemMessage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(uint64), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockChat_SendSystemMessage_Call) Return(_a0 model.Message, _a1 error) *MockChat_SendSystemMessage_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockChat_SendSystemMessage_Call) RunAndReturn(run func(context.Context, int64, uint64, string) (model.Message, error)) *MockChat_SendSystemMessage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetMemberRole provides a mock function with given fields: ctx, chatID, userID, role
+func (_m *MockChat) SetMemberRole(ctx context.Context, chatID int64, userID uint64, role model.Role) error {
+	ret := _m.Called(ctx, chatID, userID, role)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetMemberRole")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, uint64, model.Role) error); ok {
+		r0 = rf(ctx, chatID, userID, role)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockChat_SetMemberRole_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetMemberRole'
+type MockChat_SetMemberRole_Call struct {
+	*mock.Call
+}
+
+// SetMemberRole is a helper method to define mock.On call
+//   - ctx context.Context
+//   - chatID int64
+//   - userID uint64
+//   - role model.Role
+func (_e *MockChat_Expecter) SetMemberRole(ctx interface{}, chatID interface{}, userID interface{}, role interface{}) *MockChat_SetMemberRole_Call {
+	return &MockChat_SetMemberRole_Call{Call: _e.mock.On("SetMemberRole", ctx, chatID, userID, role)}
+}
+
+func (_c *MockChat_SetMemberRole_Call) Run(run func(ctx context.Context, chatID int64, userID uint64, role model.Role)) *MockChat_SetMemberRole_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(uint64), args[3].(model.Role))
+	})
+	return _c
+}
+
+func (_c *MockChat_SetMemberRole_Call) Return(_a0 error) *MockChat_SetMemberRole_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockChat_SetMemberRole_Call) RunAndReturn(run func(context.Context, int64, uint64, model.Role) error) *MockChat_SetMemberRole_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetMentions provides a mock function with given fields: ctx, messageID, chatID, userIDs
+func (_m *MockChat) SetMentions(ctx context.Context, messageID uint64, chatID int64, userIDs []uint64) ([]uint64, error) {
+	ret := _m.Called(ctx, messageID, chatID, userIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetMentions")
+	}
+
+	var r0 []uint64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, int64, []uint64) ([]uint64, error)); ok {
+		return rf(ctx, messageID, chatID, userIDs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, int64, []uint64) []uint64); ok {
+		r0 = rf(ctx, messageID, chatID, userIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]uint64)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, int64, []uint64) error); ok {
+		r1 = rf(ctx, messageID, chatID, userIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockChat_SetMentions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetMentions'
+type MockChat_SetMentions_Call struct {
+	*mock.Call
+}
+
+// SetMentions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - messageID uint64
+//   - chatID int64
+//   - userIDs []uint64
+func (_e *MockChat_Expecter) SetMentions(ctx interface{}, messageID interface{}, chatID interface{}, userIDs interface{}) *MockChat_SetMentions_Call {
+	return &MockChat_SetMentions_Call{Call: _e.mock.On("SetMentions", ctx, messageID, chatID, userIDs)}
+}
+
+func (_c *MockChat_SetMentions_Call) Run(run func(ctx context.Context, messageID uint64, chatID int64, userIDs []uint64)) *MockChat_SetMentions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(int64), args[3].([]uint64))
+	})
+	return _c
+}
+
+func (_c *MockChat_SetMentions_Call) Return(_a0 []uint64, _a1 error) *MockChat_SetMentions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockChat_SetMentions_Call) RunAndReturn(run func(context.Context, uint64, int64, []uint64) ([]uint64, error)) *MockChat_SetMentions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetMuted provides a mock function with given fields: ctx, chatID, userID, muted
+func (_m *MockChat) SetMuted(ctx context.Context, chatID int64, userID uint64, muted bool) error {
+	ret := _m.Called(ctx, chatID, userID, muted)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetMuted")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, uint64, bool) error); ok {
+		r0 = rf(ctx, chatID, userID, muted)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockChat_SetMuted_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetMuted'
+type MockChat_SetMuted_Call struct {
+	*mock.Call
+}
+
+// SetMuted is a helper method to define mock.On call
+//   - ctx context.Context
+//   - chatID int64
+//   - userID uint64
+//   - muted bool
+func (_e *MockChat_Expecter) SetMuted(ctx interface{}, chatID interface{}, userID interface{}, muted interface{}) *MockChat_SetMuted_Call {
+	return &MockChat_SetMuted_Call{Call: _e.mock.On("SetMuted", ctx, chatID, userID, muted)}
+}
+
+func (_c *MockChat_SetMuted_Call) Run(run func(ctx context.Context, chatID int64, userID uint64, muted bool)) *MockChat_SetMuted_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(uint64), args[3].(bool))
+	})
+	return _c
+}
+
+func (_c *MockChat_SetMuted_Call) Return(_a0 error) *MockChat_SetMuted_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockChat_SetMuted_Call) RunAndReturn(run func(context.Context, int64, uint64, bool) error) *MockChat_SetMuted_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UnreadCounts provides a mock function with given fields: ctx, userID
+func (_m *MockChat) UnreadCounts(ctx context.Context, userID uint64) ([]model.UnreadCount, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UnreadCounts")
+	}
+
+	var r0 []model.UnreadCount
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) ([]model.UnreadCount, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) []model.UnreadCount); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.UnreadCount)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockChat_UnreadCounts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UnreadCounts'
+type MockChat_UnreadCounts_Call struct {
+	*mock.Call
+}
+
+// UnreadCounts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint64
+func (_e *MockChat_Expecter) UnreadCounts(ctx interface{}, userID interface{}) *MockChat_UnreadCounts_Call {
+	return &MockChat_UnreadCounts_Call{Call: _e.mock.On("UnreadCounts", ctx, userID)}
+}
+
+func (_c *MockChat_UnreadCounts_Call) Run(run func(ctx context.Context, userID uint64)) *MockChat_UnreadCounts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockChat_UnreadCounts_Call) Return(_a0 []model.UnreadCount, _a1 error) *MockChat_UnreadCounts_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockChat_UnreadCounts_Call) RunAndReturn(run func(context.Context, uint64) ([]model.UnreadCount, error)) *MockChat_UnreadCounts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateChat provides a mock function with given fields: ctx, chat
+func (_m *MockChat) UpdateChat(ctx context.Context, chat model.Chat) (model.Chat, error) {
+	ret := _m.Called(ctx, chat)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateChat")
+	}
+
+	var r0 model.Chat
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.Chat) (model.Chat, error)); ok {
+		return rf(ctx, chat)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.Chat) model.Chat); ok {
+		r0 = rf(ctx, chat)
+	} else {
+		r0 = ret.Get(0).(model.Chat)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.Chat) error); ok {
+		r1 = rf(ctx, chat)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockChat_UpdateChat_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateChat'
+type MockChat_UpdateChat_Call struct {
+	*mock.Call
+}
+
+// UpdateChat is a helper method to define mock.On call
+//   - ctx context.Context
+//   - chat model.Chat
+func (_e *MockChat_Expecter) UpdateChat(ctx interface{}, chat interface{}) *MockChat_UpdateChat_Call {
+	return &MockChat_UpdateChat_Call{Call: _e.mock.On("UpdateChat", ctx, chat)}
+}
+
+func (_c *MockChat_UpdateChat_Call) Run(run func(ctx context.Context, chat model.Chat)) *MockChat_UpdateChat_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.Chat))
+	})
+	return _c
+}
+
+func (_c *MockChat_UpdateChat_Call) Return(_a0 model.Chat, _a1 error) *MockChat_UpdateChat_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockChat_SendMessage_Call) RunAndReturn(run func(context.Context, converter.SendMessageInput) error) *MockChat_SendMessage_Call {
+func (_c *MockChat_UpdateChat_Call) RunAndReturn(run func(context.Context, model.Chat) (model.Chat, error)) *MockChat_UpdateChat_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -0,0 +1,455 @@
+// Code generated by mockery v2.42.0. DO NOT EDIT.
+
+package mockrepository
+
+import (
+	context "context"
+
+	model "github.com/defany/chat-server/app/internal/model"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// MockNotification is an autogenerated mock type for the Notification type
+type MockNotification struct {
+	mock.Mock
+}
+
+type MockNotification_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockNotification) EXPECT() *MockNotification_Expecter {
+	return &MockNotification_Expecter{mock: &_m.Mock}
+}
+
+// Claim provides a mock function with given fields: ctx, limit, maxAttempts, lease
+func (_m *MockNotification) Claim(ctx context.Context, limit uint64, maxAttempts int, lease time.Duration) ([]model.Notification, error) {
+	ret := _m.Called(ctx, limit, maxAttempts, lease)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Claim")
+	}
+
+	var r0 []model.Notification
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, int, time.Duration) ([]model.Notification, error)); ok {
+		return rf(ctx, limit, maxAttempts, lease)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, int, time.Duration) []model.Notification); ok {
+		r0 = rf(ctx, limit, maxAttempts, lease)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Notification)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, int, time.Duration) error); ok {
+		r1 = rf(ctx, limit, maxAttempts, lease)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockNotification_Claim_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Claim'
+type MockNotification_Claim_Call struct {
+	*mock.Call
+}
+
+// Claim is a helper method to define mock.On call
+//   - ctx context.Context
+//   - limit uint64
+//   - maxAttempts int
+//   - lease time.Duration
+func (_e *MockNotification_Expecter) Claim(ctx interface{}, limit interface{}, maxAttempts interface{}, lease interface{}) *MockNotification_Claim_Call {
+	return &MockNotification_Claim_Call{Call: _e.mock.On("Claim", ctx, limit, maxAttempts, lease)}
+}
+
+func (_c *MockNotification_Claim_Call) Run(run func(ctx context.Context, limit uint64, maxAttempts int, lease time.Duration)) *MockNotification_Claim_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(int), args[3].(time.Duration))
+	})
+	return _c
+}
+
+func (_c *MockNotification_Claim_Call) Return(_a0 []model.Notification, _a1 error) *MockNotification_Claim_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockNotification_Claim_Call) RunAndReturn(run func(context.Context, uint64, int, time.Duration) ([]model.Notification, error)) *MockNotification_Claim_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Enqueue provides a mock function with given fields: ctx, msg, mentioned
+func (_m *MockNotification) Enqueue(ctx context.Context, msg model.Message, mentioned []uint64) error {
+	ret := _m.Called(ctx, msg, mentioned)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Enqueue")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.Message, []uint64) error); ok {
+		r0 = rf(ctx, msg, mentioned)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockNotification_Enqueue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Enqueue'
+type MockNotification_Enqueue_Call struct {
+	*mock.Call
+}
+
+// Enqueue is a helper method to define mock.On call
+//   - ctx context.Context
+//   - msg model.Message
+//   - mentioned []uint64
+func (_e *MockNotification_Expecter) Enqueue(ctx interface{}, msg interface{}, mentioned interface{}) *MockNotification_Enqueue_Call {
+	return &MockNotification_Enqueue_Call{Call: _e.mock.On("Enqueue", ctx, msg, mentioned)}
+}
+
+func (_c *MockNotification_Enqueue_Call) Run(run func(ctx context.Context, msg model.Message, mentioned []uint64)) *MockNotification_Enqueue_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.Message), args[2].([]uint64))
+	})
+	return _c
+}
+
+func (_c *MockNotification_Enqueue_Call) Return(_a0 error) *MockNotification_Enqueue_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockNotification_Enqueue_Call) RunAndReturn(run func(context.Context, model.Message, []uint64) error) *MockNotification_Enqueue_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// EnqueueMentions provides a mock function with given fields: ctx, msg, mentioned
+func (_m *MockNotification) EnqueueMentions(ctx context.Context, msg model.Message, mentioned []uint64) error {
+	ret := _m.Called(ctx, msg, mentioned)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EnqueueMentions")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.Message, []uint64) error); ok {
+		r0 = rf(ctx, msg, mentioned)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockNotification_EnqueueMentions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EnqueueMentions'
+type MockNotification_EnqueueMentions_Call struct {
+	*mock.Call
+}
+
+// EnqueueMentions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - msg model.Message
+//   - mentioned []uint64
+func (_e *MockNotification_Expecter) EnqueueMentions(ctx interface{}, msg interface{}, mentioned interface{}) *MockNotification_EnqueueMentions_Call {
+	return &MockNotification_EnqueueMentions_Call{Call: _e.mock.On("EnqueueMentions", ctx, msg, mentioned)}
+}
+
+func (_c *MockNotification_EnqueueMentions_Call) Run(run func(ctx context.Context, msg model.Message, mentioned []uint64)) *MockNotification_EnqueueMentions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.Message), args[2].([]uint64))
+	})
+	return _c
+}
+
+func (_c *MockNotification_EnqueueMentions_Call) Return(_a0 error) *MockNotification_EnqueueMentions_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockNotification_EnqueueMentions_Call) RunAndReturn(run func(context.Context, model.Message, []uint64) error) *MockNotification_EnqueueMentions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Expand provides a mock function with given fields: ctx, ids
+func (_m *MockNotification) Expand(ctx context.Context, ids []uint64) (int64, error) {
+	ret := _m.Called(ctx, ids)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Expand")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []uint64) (int64, error)); ok {
+		return rf(ctx, ids)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []uint64) int64); ok {
+		r0 = rf(ctx, ids)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []uint64) error); ok {
+		r1 = rf(ctx, ids)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockNotification_Expand_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Expand'
+type MockNotification_Expand_Call struct {
+	*mock.Call
+}
+
+// Expand is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ids []uint64
+func (_e *MockNotification_Expecter) Expand(ctx interface{}, ids interface{}) *MockNotification_Expand_Call {
+	return &MockNotification_Expand_Call{Call: _e.mock.On("Expand", ctx, ids)}
+}
+
+func (_c *MockNotification_Expand_Call) Run(run func(ctx context.Context, ids []uint64)) *MockNotification_Expand_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]uint64))
+	})
+	return _c
+}
+
+func (_c *MockNotification_Expand_Call) Return(_a0 int64, _a1 error) *MockNotification_Expand_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockNotification_Expand_Call) RunAndReturn(run func(context.Context, []uint64) (int64, error)) *MockNotification_Expand_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkSent provides a mock function with given fields: ctx, ids
+func (_m *MockNotification) MarkSent(ctx context.Context, ids []uint64) error {
+	ret := _m.Called(ctx, ids)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkSent")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []uint64) error); ok {
+		r0 = rf(ctx, ids)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockNotification_MarkSent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkSent'
+type MockNotification_MarkSent_Call struct {
+	*mock.Call
+}
+
+// MarkSent is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ids []uint64
+func (_e *MockNotification_Expecter) MarkSent(ctx interface{}, ids interface{}) *MockNotification_MarkSent_Call {
+	return &MockNotification_MarkSent_Call{Call: _e.mock.On("MarkSent", ctx, ids)}
+}
+
+func (_c *MockNotification_MarkSent_Call) Run(run func(ctx context.Context, ids []uint64)) *MockNotification_MarkSent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]uint64))
+	})
+	return _c
+}
+
+func (_c *MockNotification_MarkSent_Call) Return(_a0 error) *MockNotification_MarkSent_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockNotification_MarkSent_Call) RunAndReturn(run func(context.Context, []uint64) error) *MockNotification_MarkSent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Pending provides a mock function with given fields: ctx, limit
+func (_m *MockNotification) Pending(ctx context.Context, limit uint64) ([]uint64, error) {
+	ret := _m.Called(ctx, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Pending")
+	}
+
+	var r0 []uint64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) ([]uint64, error)); ok {
+		return rf(ctx, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) []uint64); ok {
+		r0 = rf(ctx, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]uint64)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockNotification_Pending_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Pending'
+type MockNotification_Pending_Call struct {
+	*mock.Call
+}
+
+// Pending is a helper method to define mock.On call
+//   - ctx context.Context
+//   - limit uint64
+func (_e *MockNotification_Expecter) Pending(ctx interface{}, limit interface{}) *MockNotification_Pending_Call {
+	return &MockNotification_Pending_Call{Call: _e.mock.On("Pending", ctx, limit)}
+}
+
+func (_c *MockNotification_Pending_Call) Run(run func(ctx context.Context, limit uint64)) *MockNotification_Pending_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockNotification_Pending_Call) Return(_a0 []uint64, _a1 error) *MockNotification_Pending_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockNotification_Pending_Call) RunAndReturn(run func(context.Context, uint64) ([]uint64, error)) *MockNotification_Pending_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Remove provides a mock function with given fields: ctx, ids
+func (_m *MockNotification) Remove(ctx context.Context, ids []uint64) error {
+	ret := _m.Called(ctx, ids)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Remove")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []uint64) error); ok {
+		r0 = rf(ctx, ids)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockNotification_Remove_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Remove'
+type MockNotification_Remove_Call struct {
+	*mock.Call
+}
+
+// Remove is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ids []uint64
+func (_e *MockNotification_Expecter) Remove(ctx interface{}, ids interface{}) *MockNotification_Remove_Call {
+	return &MockNotification_Remove_Call{Call: _e.mock.On("Remove", ctx, ids)}
+}
+
+func (_c *MockNotification_Remove_Call) Run(run func(ctx context.Context, ids []uint64)) *MockNotification_Remove_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]uint64))
+	})
+	return _c
+}
+
+func (_c *MockNotification_Remove_Call) Return(_a0 error) *MockNotification_Remove_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockNotification_Remove_Call) RunAndReturn(run func(context.Context, []uint64) error) *MockNotification_Remove_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Retry provides a mock function with given fields: ctx, id, delay, reason
+func (_m *MockNotification) Retry(ctx context.Context, id uint64, delay time.Duration, reason string) error {
+	ret := _m.Called(ctx, id, delay, reason)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Retry")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, time.Duration, string) error); ok {
+		r0 = rf(ctx, id, delay, reason)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockNotification_Retry_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Retry'
+type MockNotification_Retry_Call struct {
+	*mock.Call
+}
+
+// Retry is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uint64
+//   - delay time.Duration
+//   - reason string
+func (_e *MockNotification_Expecter) Retry(ctx interface{}, id interface{}, delay interface{}, reason interface{}) *MockNotification_Retry_Call {
+	return &MockNotification_Retry_Call{Call: _e.mock.On("Retry", ctx, id, delay, reason)}
+}
+
+func (_c *MockNotification_Retry_Call) Run(run func(ctx context.Context, id uint64, delay time.Duration, reason string)) *MockNotification_Retry_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(time.Duration), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockNotification_Retry_Call) Return(_a0 error) *MockNotification_Retry_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockNotification_Retry_Call) RunAndReturn(run func(context.Context, uint64, time.Duration, string) error) *MockNotification_Retry_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockNotification creates a new instance of MockNotification. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockNotification(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockNotification {
+	mock := &MockNotification{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,205 @@
+// Code generated by mockery v2.42.0. DO NOT EDIT.
+
+package mockrepository
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// MockPresence is an autogenerated mock type for the Presence type
+type MockPresence struct {
+	mock.Mock
+}
+
+type MockPresence_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockPresence) EXPECT() *MockPresence_Expecter {
+	return &MockPresence_Expecter{mock: &_m.Mock}
+}
+
+// Contacts provides a mock function with given fields: ctx, userID, userIDs
+func (_m *MockPresence) Contacts(ctx context.Context, userID uint64, userIDs []uint64) ([]uint64, error) {
+	ret := _m.Called(ctx, userID, userIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Contacts")
+	}
+
+	var r0 []uint64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, []uint64) ([]uint64, error)); ok {
+		return rf(ctx, userID, userIDs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, []uint64) []uint64); ok {
+		r0 = rf(ctx, userID, userIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]uint64)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, []uint64) error); ok {
+		r1 = rf(ctx, userID, userIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockPresence_Contacts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Contacts'
+type MockPresence_Contacts_Call struct {
+	*mock.Call
+}
+
+// Contacts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint64
+//   - userIDs []uint64
+func (_e *MockPresence_Expecter) Contacts(ctx interface{}, userID interface{}, userIDs interface{}) *MockPresence_Contacts_Call {
+	return &MockPresence_Contacts_Call{Call: _e.mock.On("Contacts", ctx, userID, userIDs)}
+}
+
+func (_c *MockPresence_Contacts_Call) Run(run func(ctx context.Context, userID uint64, userIDs []uint64)) *MockPresence_Contacts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].([]uint64))
+	})
+	return _c
+}
+
+func (_c *MockPresence_Contacts_Call) Return(_a0 []uint64, _a1 error) *MockPresence_Contacts_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockPresence_Contacts_Call) RunAndReturn(run func(context.Context, uint64, []uint64) ([]uint64, error)) *MockPresence_Contacts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LastSeen provides a mock function with given fields: ctx, userIDs
+func (_m *MockPresence) LastSeen(ctx context.Context, userIDs []uint64) (map[uint64]time.Time, error) {
+	ret := _m.Called(ctx, userIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LastSeen")
+	}
+
+	var r0 map[uint64]time.Time
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []uint64) (map[uint64]time.Time, error)); ok {
+		return rf(ctx, userIDs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []uint64) map[uint64]time.Time); ok {
+		r0 = rf(ctx, userIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[uint64]time.Time)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []uint64) error); ok {
+		r1 = rf(ctx, userIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockPresence_LastSeen_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LastSeen'
+type MockPresence_LastSeen_Call struct {
+	*mock.Call
+}
+
+// LastSeen is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userIDs []uint64
+func (_e *MockPresence_Expecter) LastSeen(ctx interface{}, userIDs interface{}) *MockPresence_LastSeen_Call {
+	return &MockPresence_LastSeen_Call{Call: _e.mock.On("LastSeen", ctx, userIDs)}
+}
+
+func (_c *MockPresence_LastSeen_Call) Run(run func(ctx context.Context, userIDs []uint64)) *MockPresence_LastSeen_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]uint64))
+	})
+	return _c
+}
+
+func (_c *MockPresence_LastSeen_Call) Return(_a0 map[uint64]time.Time, _a1 error) *MockPresence_LastSeen_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockPresence_LastSeen_Call) RunAndReturn(run func(context.Context, []uint64) (map[uint64]time.Time, error)) *MockPresence_LastSeen_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetLastSeen provides a mock function with given fields: ctx, userID, at
+func (_m *MockPresence) SetLastSeen(ctx context.Context, userID uint64, at time.Time) error {
+	ret := _m.Called(ctx, userID, at)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetLastSeen")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, time.Time) error); ok {
+		r0 = rf(ctx, userID, at)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockPresence_SetLastSeen_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetLastSeen'
+type MockPresence_SetLastSeen_Call struct {
+	*mock.Call
+}
+
+// SetLastSeen is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint64
+//   - at time.Time
+func (_e *MockPresence_Expecter) SetLastSeen(ctx interface{}, userID interface{}, at interface{}) *MockPresence_SetLastSeen_Call {
+	return &MockPresence_SetLastSeen_Call{Call: _e.mock.On("SetLastSeen", ctx, userID, at)}
+}
+
+func (_c *MockPresence_SetLastSeen_Call) Run(run func(ctx context.Context, userID uint64, at time.Time)) *MockPresence_SetLastSeen_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockPresence_SetLastSeen_Call) Return(_a0 error) *MockPresence_SetLastSeen_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockPresence_SetLastSeen_Call) RunAndReturn(run func(context.Context, uint64, time.Time) error) *MockPresence_SetLastSeen_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockPresence creates a new instance of MockPresence. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockPresence(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockPresence {
+	mock := &MockPresence{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
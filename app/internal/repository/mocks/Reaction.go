@@ -0,0 +1,190 @@
+// Code generated by mockery v2.42.0. DO NOT EDIT.
+
+package mockrepository
+
+import (
+	context "context"
+
+	model "github.com/defany/chat-server/app/internal/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockReaction is an autogenerated mock type for the Reaction type
+type MockReaction struct {
+	mock.Mock
+}
+
+type MockReaction_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockReaction) EXPECT() *MockReaction_Expecter {
+	return &MockReaction_Expecter{mock: &_m.Mock}
+}
+
+// Add provides a mock function with given fields: ctx, reaction
+func (_m *MockReaction) Add(ctx context.Context, reaction model.Reaction) error {
+	ret := _m.Called(ctx, reaction)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Add")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.Reaction) error); ok {
+		r0 = rf(ctx, reaction)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockReaction_Add_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Add'
+type MockReaction_Add_Call struct {
+	*mock.Call
+}
+
+// Add is a helper method to define mock.On call
+//   - ctx context.Context
+//   - reaction model.Reaction
+func (_e *MockReaction_Expecter) Add(ctx interface{}, reaction interface{}) *MockReaction_Add_Call {
+	return &MockReaction_Add_Call{Call: _e.mock.On("Add", ctx, reaction)}
+}
+
+func (_c *MockReaction_Add_Call) Run(run func(ctx context.Context, reaction model.Reaction)) *MockReaction_Add_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.Reaction))
+	})
+	return _c
+}
+
+func (_c *MockReaction_Add_Call) Return(_a0 error) *MockReaction_Add_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockReaction_Add_Call) RunAndReturn(run func(context.Context, model.Reaction) error) *MockReaction_Add_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Counts provides a mock function with given fields: ctx, messageIDs
+func (_m *MockReaction) Counts(ctx context.Context, messageIDs []uint64) (map[uint64][]model.ReactionCount, error) {
+	ret := _m.Called(ctx, messageIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Counts")
+	}
+
+	var r0 map[uint64][]model.ReactionCount
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []uint64) (map[uint64][]model.ReactionCount, error)); ok {
+		return rf(ctx, messageIDs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []uint64) map[uint64][]model.ReactionCount); ok {
+		r0 = rf(ctx, messageIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[uint64][]model.ReactionCount)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []uint64) error); ok {
+		r1 = rf(ctx, messageIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockReaction_Counts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Counts'
+type MockReaction_Counts_Call struct {
+	*mock.Call
+}
+
+// Counts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - messageIDs []uint64
+func (_e *MockReaction_Expecter) Counts(ctx interface{}, messageIDs interface{}) *MockReaction_Counts_Call {
+	return &MockReaction_Counts_Call{Call: _e.mock.On("Counts", ctx, messageIDs)}
+}
+
+func (_c *MockReaction_Counts_Call) Run(run func(ctx context.Context, messageIDs []uint64)) *MockReaction_Counts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]uint64))
+	})
+	return _c
+}
+
+func (_c *MockReaction_Counts_Call) Return(_a0 map[uint64][]model.ReactionCount, _a1 error) *MockReaction_Counts_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockReaction_Counts_Call) RunAndReturn(run func(context.Context, []uint64) (map[uint64][]model.ReactionCount, error)) *MockReaction_Counts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Remove provides a mock function with given fields: ctx, reaction
+func (_m *MockReaction) Remove(ctx context.Context, reaction model.Reaction) error {
+	ret := _m.Called(ctx, reaction)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Remove")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.Reaction) error); ok {
+		r0 = rf(ctx, reaction)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockReaction_Remove_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Remove'
+type MockReaction_Remove_Call struct {
+	*mock.Call
+}
+
+// Remove is a helper method to define mock.On call
+//   - ctx context.Context
+//   - reaction model.Reaction
+func (_e *MockReaction_Expecter) Remove(ctx interface{}, reaction interface{}) *MockReaction_Remove_Call {
+	return &MockReaction_Remove_Call{Call: _e.mock.On("Remove", ctx, reaction)}
+}
+
+func (_c *MockReaction_Remove_Call) Run(run func(ctx context.Context, reaction model.Reaction)) *MockReaction_Remove_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.Reaction))
+	})
+	return _c
+}
+
+func (_c *MockReaction_Remove_Call) Return(_a0 error) *MockReaction_Remove_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockReaction_Remove_Call) RunAndReturn(run func(context.Context, model.Reaction) error) *MockReaction_Remove_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockReaction creates a new instance of MockReaction. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockReaction(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockReaction {
+	mock := &MockReaction{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
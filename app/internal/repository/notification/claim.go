@@ -0,0 +1,66 @@
+package notificationrepo
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/defany/chat-server/app/internal/model"
+	repo "github.com/defany/chat-server/app/internal/repository"
+	"github.com/defany/slogger/pkg/logger/sl"
+	"github.com/jackc/pgx/v5"
+)
+
+// Claim арендует готовые строки на lease по часам базы, если воркер упадет - они вернутся в очередь сами
+func (r *repository) Claim(ctx context.Context, limit uint64, maxAttempts int, lease time.Duration) ([]model.Notification, error) {
+	op := sl.FnName()
+
+	ready := squirrel.Select(notificationsID).
+		From(notifications).
+		Where(squirrel.Eq{
+			notificationsSentAt: nil,
+		}).
+		Where(squirrel.Expr(notificationsNextAttemptAt+" <= clock_timestamp()")).
+		Where(squirrel.Lt{
+			notificationsAttempts: maxAttempts,
+		}).
+		OrderBy(notificationsNextAttemptAt, notificationsID).
+		Limit(limit).
+		Suffix("for update skip locked")
+
+	returning := make([]string, 0, len(notificationColumns)+2)
+	for _, column := range notificationColumns {
+		returning = append(returning, notifications+"."+column)
+	}
+
+	returning = append(returning,
+		chatsMessages+"."+chatsMessagesText,
+		chatsMessages+"."+chatsMessagesDeletedAt+" is not null",
+	)
+
+	q := r.qb.Update(notifications).
+		Set(notificationsAttempts, squirrel.Expr(notifications+"."+notificationsAttempts+" + 1")).
+		Set(notificationsNextAttemptAt, squirrel.Expr("clock_timestamp() + ?::interval", lease)).
+		From(chatsMessages).
+		Where(chatsMessages + "." + chatsMessagesID + " = " + notifications + "." + notificationsMessageID).
+		Where(squirrel.Expr(notifications+"."+notificationsID+" in (?)", ready)).
+		Suffix("returning " + strings.Join(returning, ", "))
+
+	sql, args, err := q.ToSql()
+	if err != nil {
+		return nil, sl.Err(op, err)
+	}
+
+	rows, err := r.db.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, sl.Err(op, repo.TranslateError(err))
+	}
+
+	claimed, err := pgx.CollectRows(rows, scanNotification)
+	if err != nil {
+		return nil, sl.Err(op, repo.TranslateError(err))
+	}
+
+	return claimed, nil
+}
@@ -0,0 +1,47 @@
+package notificationrepo
+
+import (
+	"context"
+
+	"github.com/defany/chat-server/app/internal/model"
+	repo "github.com/defany/chat-server/app/internal/repository"
+	"github.com/defany/slogger/pkg/logger/sl"
+)
+
+// Enqueue пишет одну строку на сообщение, сколько бы участников ни было в чате. По получателям ее раскладывает Expand
+func (r *repository) Enqueue(ctx context.Context, msg model.Message, mentioned []uint64) error {
+	return r.enqueue(ctx, sl.FnName(), msg, mentioned, false)
+}
+
+// EnqueueMentions то же самое, но уведомлены будут только упомянутые
+func (r *repository) EnqueueMentions(ctx context.Context, msg model.Message, mentioned []uint64) error {
+	return r.enqueue(ctx, sl.FnName(), msg, mentioned, true)
+}
+
+func (r *repository) enqueue(ctx context.Context, op string, msg model.Message, mentioned []uint64, mentionsOnly bool) error {
+	if mentioned == nil {
+		mentioned = []uint64{}
+	}
+
+	q := r.qb.Insert(notificationsOutbox).
+		Columns(
+			notificationsOutboxChatID,
+			notificationsOutboxMessageID,
+			notificationsOutboxAuthorID,
+			notificationsOutboxMentioned,
+			notificationsOutboxMentionsOnly,
+		).
+		Values(msg.ChatID, msg.ID, msg.UserID, mentioned, mentionsOnly)
+
+	sql, args, err := q.ToSql()
+	if err != nil {
+		return sl.Err(op, err)
+	}
+
+	_, err = r.db.Exec(ctx, sql, args...)
+	if err != nil {
+		return sl.Err(op, repo.TranslateError(err))
+	}
+
+	return nil
+}
@@ -0,0 +1,56 @@
+package notificationrepo
+
+import (
+	"context"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/defany/chat-server/app/internal/model"
+	repo "github.com/defany/chat-server/app/internal/repository"
+	"github.com/defany/slogger/pkg/logger/sl"
+)
+
+// Expand раскладывает outbox по участникам чата, кроме автора, в заглушенном чате и для правок - только упомянутым
+func (r *repository) Expand(ctx context.Context, ids []uint64) (int64, error) {
+	op := sl.FnName()
+
+	if len(ids) == 0 {
+		return 0, nil
+	}
+
+	isMentioned := "uc." + usersChatsUserID + " = any(o." + notificationsOutboxMentioned + ")"
+
+	recipients := squirrel.Select().
+		Column("case when " + isMentioned + " then '" + string(model.NotificationMention) + "' else '" + string(model.NotificationMessage) + "' end").
+		Column("uc." + usersChatsUserID).
+		Column("o." + notificationsOutboxChatID).
+		Column("o." + notificationsOutboxMessageID).
+		Column("o." + notificationsOutboxAuthorID).
+		From(notificationsOutbox + " o").
+		Join(usersChats + " uc on uc." + usersChatsChatID + " = o." + notificationsOutboxChatID).
+		Where(squirrel.Eq{
+			"o." + notificationsOutboxID: ids,
+		}).
+		Where("uc." + usersChatsUserID + " <> o." + notificationsOutboxAuthorID).
+		Where("((not uc." + usersChatsMuted + " and not o." + notificationsOutboxMentionsOnly + ") or " + isMentioned + ")")
+
+	sql, args, err := r.qb.Insert(notifications).
+		Columns(
+			notificationsKind,
+			notificationsUserID,
+			notificationsChatID,
+			notificationsMessageID,
+			notificationsAuthorID,
+		).
+		Select(recipients).
+		ToSql()
+	if err != nil {
+		return 0, sl.Err(op, err)
+	}
+
+	tag, err := r.db.Exec(ctx, sql, args...)
+	if err != nil {
+		return 0, sl.Err(op, repo.TranslateError(err))
+	}
+
+	return tag.RowsAffected(), nil
+}
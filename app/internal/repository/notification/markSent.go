@@ -0,0 +1,36 @@
+package notificationrepo
+
+import (
+	"context"
+
+	"github.com/Masterminds/squirrel"
+	repo "github.com/defany/chat-server/app/internal/repository"
+	"github.com/defany/slogger/pkg/logger/sl"
+)
+
+func (r *repository) MarkSent(ctx context.Context, ids []uint64) error {
+	op := sl.FnName()
+
+	if len(ids) == 0 {
+		return nil
+	}
+
+	q := r.qb.Update(notifications).
+		Set(notificationsSentAt, squirrel.Expr("clock_timestamp()")).
+		Set(notificationsLastError, nil).
+		Where(squirrel.Eq{
+			notificationsID: ids,
+		})
+
+	sql, args, err := q.ToSql()
+	if err != nil {
+		return sl.Err(op, err)
+	}
+
+	_, err = r.db.Exec(ctx, sql, args...)
+	if err != nil {
+		return sl.Err(op, repo.TranslateError(err))
+	}
+
+	return nil
+}
@@ -0,0 +1,94 @@
+package notificationrepo
+
+import (
+	"github.com/Masterminds/squirrel"
+	"github.com/defany/chat-server/app/internal/model"
+	repo "github.com/defany/chat-server/app/internal/repository"
+	"github.com/defany/db/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+const (
+	notificationsOutbox = "notifications_outbox"
+	notifications       = "notifications"
+	usersChats          = "users_chats"
+	chatsMessages       = "chats_messages"
+)
+
+const (
+	notificationsOutboxID           = "id"
+	notificationsOutboxChatID       = "chat_id"
+	notificationsOutboxMessageID    = "message_id"
+	notificationsOutboxAuthorID     = "author_id"
+	notificationsOutboxMentioned    = "mentioned"
+	notificationsOutboxMentionsOnly = "mentions_only"
+)
+
+const (
+	notificationsID            = "id"
+	notificationsKind          = "kind"
+	notificationsUserID        = "user_id"
+	notificationsChatID        = "chat_id"
+	notificationsMessageID     = "message_id"
+	notificationsAuthorID      = "author_id"
+	notificationsAttempts      = "attempts"
+	notificationsNextAttemptAt = "next_attempt_at"
+	notificationsLastError     = "last_error"
+	notificationsSentAt        = "sent_at"
+	notificationsCreatedAt     = "created_at"
+)
+
+const (
+	usersChatsUserID = "user_id"
+	usersChatsChatID = "chat_id"
+	usersChatsMuted  = "muted"
+)
+
+const (
+	chatsMessagesID        = "id"
+	chatsMessagesText      = "text"
+	chatsMessagesDeletedAt = "deleted_at"
+)
+
+var notificationColumns = []string{
+	notificationsID,
+	notificationsKind,
+	notificationsUserID,
+	notificationsChatID,
+	notificationsMessageID,
+	notificationsAuthorID,
+	notificationsAttempts,
+	notificationsCreatedAt,
+}
+
+type repository struct {
+	db postgres.Postgres
+	qb squirrel.StatementBuilderType
+}
+
+func NewRepository(db postgres.Postgres) repo.Notification {
+	return &repository{
+		db: db,
+		qb: squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar),
+	}
+}
+
+// scanNotification ждет колонки notificationColumns, а за ними текст сообщения и признак его удаления
+func scanNotification(row pgx.CollectableRow) (model.Notification, error) {
+	var notification model.Notification
+
+	err := row.Scan(
+		&notification.ID,
+		&notification.Kind,
+		&notification.UserID,
+		&notification.ChatID,
+		&notification.MessageID,
+		&notification.AuthorID,
+		&notification.Attempts,
+		&notification.CreatedAt,
+		&notification.Text,
+		&notification.MessageDeleted,
+	)
+
+	return notification, err
+}
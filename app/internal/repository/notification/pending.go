@@ -0,0 +1,37 @@
+package notificationrepo
+
+import (
+	"context"
+
+	repo "github.com/defany/chat-server/app/internal/repository"
+	"github.com/defany/slogger/pkg/logger/sl"
+	"github.com/jackc/pgx/v5"
+)
+
+// Pending блокирует до limit еще не разложенных строк outbox'а до конца транзакции. skip locked не дает двум воркерам взять одно и то же
+func (r *repository) Pending(ctx context.Context, limit uint64) ([]uint64, error) {
+	op := sl.FnName()
+
+	q := r.qb.Select(notificationsOutboxID).
+		From(notificationsOutbox).
+		OrderBy(notificationsOutboxID).
+		Limit(limit).
+		Suffix("for update skip locked")
+
+	sql, args, err := q.ToSql()
+	if err != nil {
+		return nil, sl.Err(op, err)
+	}
+
+	rows, err := r.db.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, sl.Err(op, repo.TranslateError(err))
+	}
+
+	ids, err := pgx.CollectRows(rows, pgx.RowTo[uint64])
+	if err != nil {
+		return nil, sl.Err(op, repo.TranslateError(err))
+	}
+
+	return ids, nil
+}
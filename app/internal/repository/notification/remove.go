@@ -0,0 +1,35 @@
+package notificationrepo
+
+import (
+	"context"
+
+	"github.com/Masterminds/squirrel"
+	repo "github.com/defany/chat-server/app/internal/repository"
+	"github.com/defany/slogger/pkg/logger/sl"
+)
+
+// Remove убирает из outbox'а уже разложенные строки
+func (r *repository) Remove(ctx context.Context, ids []uint64) error {
+	op := sl.FnName()
+
+	if len(ids) == 0 {
+		return nil
+	}
+
+	q := r.qb.Delete(notificationsOutbox).
+		Where(squirrel.Eq{
+			notificationsOutboxID: ids,
+		})
+
+	sql, args, err := q.ToSql()
+	if err != nil {
+		return sl.Err(op, err)
+	}
+
+	_, err = r.db.Exec(ctx, sql, args...)
+	if err != nil {
+		return sl.Err(op, repo.TranslateError(err))
+	}
+
+	return nil
+}
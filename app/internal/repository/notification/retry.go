@@ -0,0 +1,34 @@
+package notificationrepo
+
+import (
+	"context"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	repo "github.com/defany/chat-server/app/internal/repository"
+	"github.com/defany/slogger/pkg/logger/sl"
+)
+
+// Retry запоминает ошибку доставки и переносит следующую попытку. Счетчик попыток уже увеличен в Claim
+func (r *repository) Retry(ctx context.Context, id uint64, delay time.Duration, reason string) error {
+	op := sl.FnName()
+
+	q := r.qb.Update(notifications).
+		Set(notificationsNextAttemptAt, squirrel.Expr("clock_timestamp() + ?::interval", delay)).
+		Set(notificationsLastError, reason).
+		Where(squirrel.Eq{
+			notificationsID: id,
+		})
+
+	sql, args, err := q.ToSql()
+	if err != nil {
+		return sl.Err(op, err)
+	}
+
+	_, err = r.db.Exec(ctx, sql, args...)
+	if err != nil {
+		return sl.Err(op, repo.TranslateError(err))
+	}
+
+	return nil
+}
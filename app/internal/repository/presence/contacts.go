@@ -0,0 +1,46 @@
+package presencerepo
+
+import (
+	"context"
+
+	"github.com/Masterminds/squirrel"
+	repo "github.com/defany/chat-server/app/internal/repository"
+	"github.com/defany/slogger/pkg/logger/sl"
+	"github.com/jackc/pgx/v5"
+)
+
+// Contacts оставляет из userIDs только тех, с кем userID состоит хотя бы в одном неудаленном чате
+func (r *repository) Contacts(ctx context.Context, userID uint64, userIDs []uint64) ([]uint64, error) {
+	op := sl.FnName()
+
+	if len(userIDs) == 0 {
+		return nil, nil
+	}
+
+	q := r.qb.Select("distinct other." + usersChatsUserID).
+		From(usersChats + " me").
+		Join(usersChats + " other on other." + usersChatsChatID + " = me." + usersChatsChatID).
+		Join(chats + " c on c." + chatsID + " = me." + usersChatsChatID).
+		Where(squirrel.Eq{
+			"me." + usersChatsUserID:    userID,
+			"other." + usersChatsUserID: userIDs,
+			"c." + chatsDeletedAt:       nil,
+		})
+
+	sql, args, err := q.ToSql()
+	if err != nil {
+		return nil, sl.Err(op, err)
+	}
+
+	rows, err := r.db.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, sl.Err(op, repo.TranslateError(err))
+	}
+
+	contacts, err := pgx.CollectRows(rows, pgx.RowTo[uint64])
+	if err != nil {
+		return nil, sl.Err(op, repo.TranslateError(err))
+	}
+
+	return contacts, nil
+}
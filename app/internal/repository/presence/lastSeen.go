@@ -0,0 +1,57 @@
+package presencerepo
+
+import (
+	"context"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	repo "github.com/defany/chat-server/app/internal/repository"
+	"github.com/defany/slogger/pkg/logger/sl"
+)
+
+// LastSeen возвращает отметки только для тех, кто хотя бы раз отключался
+func (r *repository) LastSeen(ctx context.Context, userIDs []uint64) (map[uint64]time.Time, error) {
+	op := sl.FnName()
+
+	lastSeen := make(map[uint64]time.Time)
+
+	if len(userIDs) == 0 {
+		return lastSeen, nil
+	}
+
+	q := r.qb.Select(usersPresenceUserID, usersPresenceLastSeenAt).
+		From(usersPresence).
+		Where(squirrel.Eq{
+			usersPresenceUserID: userIDs,
+		})
+
+	sql, args, err := q.ToSql()
+	if err != nil {
+		return nil, sl.Err(op, err)
+	}
+
+	rows, err := r.db.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, sl.Err(op, repo.TranslateError(err))
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			userID uint64
+			at     time.Time
+		)
+
+		if err := rows.Scan(&userID, &at); err != nil {
+			return nil, sl.Err(op, err)
+		}
+
+		lastSeen[userID] = at
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, sl.Err(op, repo.TranslateError(err))
+	}
+
+	return lastSeen, nil
+}
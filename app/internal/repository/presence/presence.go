@@ -0,0 +1,37 @@
+package presencerepo
+
+import (
+	"github.com/Masterminds/squirrel"
+	repo "github.com/defany/chat-server/app/internal/repository"
+	"github.com/defany/db/pkg/postgres"
+)
+
+const (
+	usersPresence = "users_presence"
+	usersChats    = "users_chats"
+	chats         = "chats"
+)
+
+const (
+	usersPresenceUserID     = "user_id"
+	usersPresenceLastSeenAt = "last_seen_at"
+)
+
+const (
+	usersChatsUserID = "user_id"
+	usersChatsChatID = "chat_id"
+	chatsID          = "id"
+	chatsDeletedAt   = "deleted_at"
+)
+
+type repository struct {
+	db postgres.Postgres
+	qb squirrel.StatementBuilderType
+}
+
+func NewRepository(db postgres.Postgres) repo.Presence {
+	return &repository{
+		db: db,
+		qb: squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar),
+	}
+}
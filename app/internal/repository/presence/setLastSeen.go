@@ -0,0 +1,35 @@
+package presencerepo
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	repo "github.com/defany/chat-server/app/internal/repository"
+	"github.com/defany/slogger/pkg/logger/sl"
+)
+
+// SetLastSeen не двигает отметку назад, если другой инстанс успел записать более позднее отключение
+func (r *repository) SetLastSeen(ctx context.Context, userID uint64, at time.Time) error {
+	op := sl.FnName()
+
+	q := r.qb.Insert(usersPresence).
+		Columns(usersPresenceUserID, usersPresenceLastSeenAt).
+		Values(userID, at).
+		Suffix(fmt.Sprintf(
+			"on conflict (%[1]s) do update set %[2]s = greatest(%[3]s.%[2]s, excluded.%[2]s)",
+			usersPresenceUserID, usersPresenceLastSeenAt, usersPresence,
+		))
+
+	sql, args, err := q.ToSql()
+	if err != nil {
+		return sl.Err(op, err)
+	}
+
+	_, err = r.db.Exec(ctx, sql, args...)
+	if err != nil {
+		return sl.Err(op, repo.TranslateError(err))
+	}
+
+	return nil
+}
@@ -0,0 +1,31 @@
+package reactionrepo
+
+import (
+	"context"
+
+	"github.com/defany/chat-server/app/internal/model"
+	repo "github.com/defany/chat-server/app/internal/repository"
+	"github.com/defany/slogger/pkg/logger/sl"
+)
+
+// Add не считает повторную реакцию ошибкой, клиенты часто дублируют нажатия
+func (r *repository) Add(ctx context.Context, reaction model.Reaction) error {
+	op := sl.FnName()
+
+	q := r.qb.Insert(messagesReactions).
+		Columns(messagesReactionsMessageID, messagesReactionsUserID, messagesReactionsEmoji).
+		Values(reaction.MessageID, reaction.UserID, reaction.Emoji).
+		Suffix("on conflict do nothing")
+
+	sql, args, err := q.ToSql()
+	if err != nil {
+		return sl.Err(op, err)
+	}
+
+	_, err = r.db.Exec(ctx, sql, args...)
+	if err != nil {
+		return sl.Err(op, repo.TranslateError(err))
+	}
+
+	return nil
+}
@@ -0,0 +1,59 @@
+package reactionrepo
+
+import (
+	"context"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/defany/chat-server/app/internal/model"
+	repo "github.com/defany/chat-server/app/internal/repository"
+	"github.com/defany/slogger/pkg/logger/sl"
+)
+
+// Counts возвращает количество реакций по каждому эмодзи для пачки сообщений одним запросом
+func (r *repository) Counts(ctx context.Context, messageIDs []uint64) (map[uint64][]model.ReactionCount, error) {
+	op := sl.FnName()
+
+	counts := make(map[uint64][]model.ReactionCount)
+
+	if len(messageIDs) == 0 {
+		return counts, nil
+	}
+
+	q := r.qb.Select(messagesReactionsMessageID, messagesReactionsEmoji, "count(*)").
+		From(messagesReactions).
+		Where(squirrel.Eq{
+			messagesReactionsMessageID: messageIDs,
+		}).
+		GroupBy(messagesReactionsMessageID, messagesReactionsEmoji).
+		OrderBy(messagesReactionsMessageID, "count(*) desc", messagesReactionsEmoji)
+
+	sql, args, err := q.ToSql()
+	if err != nil {
+		return nil, sl.Err(op, err)
+	}
+
+	rows, err := r.db.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, sl.Err(op, repo.TranslateError(err))
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			messageID uint64
+			count     model.ReactionCount
+		)
+
+		if err := rows.Scan(&messageID, &count.Emoji, &count.Count); err != nil {
+			return nil, sl.Err(op, err)
+		}
+
+		counts[messageID] = append(counts[messageID], count)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, sl.Err(op, repo.TranslateError(err))
+	}
+
+	return counts, nil
+}
@@ -0,0 +1,29 @@
+package reactionrepo
+
+import (
+	"github.com/Masterminds/squirrel"
+	repo "github.com/defany/chat-server/app/internal/repository"
+	"github.com/defany/db/pkg/postgres"
+)
+
+const (
+	messagesReactions = "messages_reactions"
+)
+
+const (
+	messagesReactionsMessageID = "message_id"
+	messagesReactionsUserID    = "user_id"
+	messagesReactionsEmoji     = "emoji"
+)
+
+type repository struct {
+	db postgres.Postgres
+	qb squirrel.StatementBuilderType
+}
+
+func NewRepository(db postgres.Postgres) repo.Reaction {
+	return &repository{
+		db: db,
+		qb: squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar),
+	}
+}
@@ -0,0 +1,33 @@
+package reactionrepo
+
+import (
+	"context"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/defany/chat-server/app/internal/model"
+	repo "github.com/defany/chat-server/app/internal/repository"
+	"github.com/defany/slogger/pkg/logger/sl"
+)
+
+func (r *repository) Remove(ctx context.Context, reaction model.Reaction) error {
+	op := sl.FnName()
+
+	q := r.qb.Delete(messagesReactions).
+		Where(squirrel.Eq{
+			messagesReactionsMessageID: reaction.MessageID,
+			messagesReactionsUserID:    reaction.UserID,
+			messagesReactionsEmoji:     reaction.Emoji,
+		})
+
+	sql, args, err := q.ToSql()
+	if err != nil {
+		return sl.Err(op, err)
+	}
+
+	_, err = r.db.Exec(ctx, sql, args...)
+	if err != nil {
+		return sl.Err(op, repo.TranslateError(err))
+	}
+
+	return nil
+}
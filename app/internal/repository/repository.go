@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/defany/chat-server/app/internal/converter"
 	"github.com/defany/chat-server/app/internal/model"
@@ -9,8 +10,81 @@ import (
 
 type Chat interface {
 	Create(ctx context.Context, chat model.Chat) (uint64, error)
+	GetOrCreateDirect(ctx context.Context, userID, peerID uint64) (int64, bool, error)
+	Chat(ctx context.Context, id int64) (model.Chat, error)
+	UpdateChat(ctx context.Context, chat model.Chat) (model.Chat, error)
+	AddMembers(ctx context.Context, chatID uint64, members []model.ChatMember) error
+	RemoveMember(ctx context.Context, chatID int64, userID uint64) error
+	SetMemberRole(ctx context.Context, chatID int64, userID uint64, role model.Role) error
+	MemberRole(ctx context.Context, chatID int64, userID uint64) (model.Role, error)
+	DeletedMemberRole(ctx context.Context, chatID int64, userID uint64) (model.Role, error)
 	Delete(ctx context.Context, id int64) error
-	SendMessage(ctx context.Context, input converter.SendMessageInput) error
+	// Restore и ExpiredDeleted считают срок хранения по часам базы, теми же, что ставят deleted_at
+	Restore(ctx context.Context, id int64, retention time.Duration) error
+	ExpiredDeleted(ctx context.Context, retention time.Duration, limit uint64) ([]int64, error)
+	PurgeDeleted(ctx context.Context, ids []int64) error
+	SendMessage(ctx context.Context, input converter.SendMessageInput) (model.Message, error)
+	SendSystemMessage(ctx context.Context, chatID int64, userID uint64, text string) (model.Message, error)
+	MessageByClientID(ctx context.Context, chatID int64, userID uint64, clientMessageID string) (model.Message, error)
+	Message(ctx context.Context, chatID int64, id uint64) (model.Message, error)
+	MessageForUpdate(ctx context.Context, chatID int64, id uint64) (model.Message, error)
+	InEditWindow(ctx context.Context, id uint64, window time.Duration) (bool, error)
+	AddMessageRevision(ctx context.Context, messageID uint64, text string, entities []model.MessageEntity) error
+	EditMessage(ctx context.Context, id uint64, text string, entities []model.MessageEntity) (model.Message, error)
+	DeleteMessage(ctx context.Context, id uint64) (model.Message, error)
+	MarkRead(ctx context.Context, chatID int64, userID uint64, messageID uint64) error
+	SetMuted(ctx context.Context, chatID int64, userID uint64, muted bool) error
+	UnreadCounts(ctx context.Context, userID uint64) ([]model.UnreadCount, error)
+	ListMessages(ctx context.Context, filter model.MessagesFilter) ([]model.Message, error)
+	ListChats(ctx context.Context, filter model.ChatsFilter) ([]model.ChatSummary, error)
+	SearchMessages(ctx context.Context, filter model.SearchFilter) ([]model.SearchHit, error)
+	// SetMentions заменяет упоминания в сообщении и возвращает только тех, кто состоит в чате
+	SetMentions(ctx context.Context, messageID uint64, chatID int64, userIDs []uint64) ([]uint64, error)
+	ListMentions(ctx context.Context, filter model.MentionsFilter) ([]model.Message, error)
+}
+
+type Reaction interface {
+	Add(ctx context.Context, reaction model.Reaction) error
+	Remove(ctx context.Context, reaction model.Reaction) error
+	Counts(ctx context.Context, messageIDs []uint64) (map[uint64][]model.ReactionCount, error)
+}
+
+type Attachment interface {
+	Create(ctx context.Context, attachment model.Attachment) (model.Attachment, error)
+	Attachment(ctx context.Context, id uint64) (model.Attachment, error)
+	// Attach привязывает к сообщению только еще свободные вложения автора из этого чата и возвращает привязанные
+	Attach(ctx context.Context, messageID uint64, chatID int64, userID uint64, ids []uint64) ([]model.Attachment, error)
+	ByMessages(ctx context.Context, messageIDs []uint64) (map[uint64][]model.Attachment, error)
+	PurgeUnattached(ctx context.Context, ttl time.Duration, limit uint64) ([]model.Attachment, error)
+	// OrphanChats и OrphanMessage переносят ключи файлов в очередь на удаление, из которой их забирают Orphans
+	OrphanChats(ctx context.Context, chatIDs []int64) error
+	OrphanMessage(ctx context.Context, messageID uint64) error
+	DeleteByMessage(ctx context.Context, messageID uint64) error
+	Orphans(ctx context.Context, limit uint64) ([]string, error)
+	RemoveOrphans(ctx context.Context, keys []string) error
+}
+
+// Notification outbox уведомлений. Enqueue вызывается в транзакции отправки сообщения, остальное нужно воркеру доставки
+type Notification interface {
+	// Enqueue пишет в outbox одну строку на сообщение, вызывается в транзакции отправки
+	Enqueue(ctx context.Context, msg model.Message, mentioned []uint64) error
+	// EnqueueMentions для правок: уведомление получат только упомянутые
+	EnqueueMentions(ctx context.Context, msg model.Message, mentioned []uint64) error
+	// Pending, Expand и Remove вызываются в одной транзакции: Expand пишет уведомления всем участникам чата, кроме автора.
+	// Упомянутым всегда, остальным только если чат не заглушен
+	Pending(ctx context.Context, limit uint64) ([]uint64, error)
+	Expand(ctx context.Context, ids []uint64) (int64, error)
+	Remove(ctx context.Context, ids []uint64) error
+	// Claim и Retry откладывают строки по часам базы, с ними же сравнивается next_attempt_at
+	Claim(ctx context.Context, limit uint64, maxAttempts int, lease time.Duration) ([]model.Notification, error)
+	MarkSent(ctx context.Context, ids []uint64) error
+	Retry(ctx context.Context, id uint64, delay time.Duration, reason string) error
+}
+
+type Presence interface {
+	SetLastSeen(ctx context.Context, userID uint64, at time.Time) error
+	LastSeen(ctx context.Context, userIDs []uint64) (map[uint64]time.Time, error)
+	Contacts(ctx context.Context, userID uint64, userIDs []uint64) ([]uint64, error)
 }
 
 type Log interface {
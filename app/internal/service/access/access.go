@@ -0,0 +1,70 @@
+package access
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/defany/chat-server/app/internal/apperr"
+	"github.com/defany/chat-server/app/internal/model"
+	"github.com/defany/chat-server/app/internal/repository"
+)
+
+// Checker - единые правила доступа к чату для всех сервисов, чтобы участник, читатель канала и посторонний везде различались одинаково
+type Checker struct {
+	chats repository.Chat
+}
+
+func New(chats repository.Chat) *Checker {
+	return &Checker{
+		chats: chats,
+	}
+}
+
+// RequireRole проверяет, что пользователь состоит в чате, а если переданы роли - что у него одна из них
+func (c *Checker) RequireRole(ctx context.Context, chatID int64, userID uint64, roles ...model.Role) error {
+	_, err := c.MemberRole(ctx, chatID, userID, roles...)
+
+	return err
+}
+
+// MemberRole то же самое, что RequireRole, но еще отдает роль для более тонких проверок
+func (c *Checker) MemberRole(ctx context.Context, chatID int64, userID uint64, roles ...model.Role) (model.Role, error) {
+	role, err := c.chats.MemberRole(ctx, chatID, userID)
+	if err != nil {
+		return "", err
+	}
+
+	if role == "" {
+		return "", apperr.PermissionDenied("user is not a member of the chat")
+	}
+
+	if len(roles) != 0 && !slices.Contains(roles, role) {
+		return "", apperr.PermissionDenied(fmt.Sprintf("action is not allowed for chat %s", role))
+	}
+
+	return role, nil
+}
+
+// RequirePoster проверяет, что пользователь может писать в чат. В канале пишут только владелец и админы, остальные его читают
+func (c *Checker) RequirePoster(ctx context.Context, chatID int64, userID uint64) error {
+	role, err := c.MemberRole(ctx, chatID, userID)
+	if err != nil {
+		return err
+	}
+
+	if role != model.RoleMember {
+		return nil
+	}
+
+	chat, err := c.chats.Chat(ctx, chatID)
+	if err != nil {
+		return err
+	}
+
+	if chat.Kind == model.ChatKindChannel {
+		return apperr.PermissionDenied("only channel admins can post")
+	}
+
+	return nil
+}
@@ -0,0 +1,49 @@
+package attachmentservice
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/defany/chat-server/app/internal/client"
+	"github.com/defany/chat-server/app/internal/config"
+	"github.com/defany/chat-server/app/internal/repository"
+	servicedef "github.com/defany/chat-server/app/internal/service"
+	"github.com/defany/chat-server/app/internal/service/access"
+)
+
+type service struct {
+	chats       repository.Chat
+	attachments repository.Attachment
+	blobs       client.BlobStore
+	access      *access.Checker
+	cfg         config.Attachments
+}
+
+func NewService(chats repository.Chat, attachments repository.Attachment, blobs client.BlobStore, cfg config.Attachments) servicedef.Attachment {
+	return &service{
+		chats:       chats,
+		attachments: attachments,
+		blobs:       blobs,
+		access:      access.New(chats),
+		cfg:         cfg,
+	}
+}
+
+// mimeAllowed понимает точные типы и маски вида image/*, пустой список разрешает все
+func (s *service) mimeAllowed(mimeType string) bool {
+	if len(s.cfg.AllowedMimeTypes) == 0 {
+		return true
+	}
+
+	mimeType = strings.ToLower(mimeType)
+
+	return slices.ContainsFunc(s.cfg.AllowedMimeTypes, func(allowed string) bool {
+		allowed = strings.ToLower(strings.TrimSpace(allowed))
+
+		if prefix, ok := strings.CutSuffix(allowed, "/*"); ok {
+			return strings.HasPrefix(mimeType, prefix+"/")
+		}
+
+		return allowed == mimeType
+	})
+}
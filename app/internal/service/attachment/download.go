@@ -0,0 +1,53 @@
+package attachmentservice
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	"github.com/defany/chat-server/app/internal/apperr"
+	"github.com/defany/chat-server/app/internal/client"
+	"github.com/defany/chat-server/app/internal/converter"
+	"github.com/defany/chat-server/app/internal/model"
+	"github.com/defany/slogger/pkg/logger/sl"
+)
+
+// Download отдает вложение участникам чата. Еще не отправленное вложение видит только тот, кто его загрузил
+func (s *service) Download(ctx context.Context, input converter.DownloadAttachmentInput) (model.Attachment, io.ReadCloser, error) {
+	op := sl.FnName()
+
+	attachment, err := s.attachments.Attachment(ctx, input.AttachmentID)
+	if err != nil {
+		return model.Attachment{}, nil, sl.Err(op, err)
+	}
+
+	err = s.access.RequireRole(ctx, attachment.ChatID, input.UserID)
+	if err != nil {
+		return model.Attachment{}, nil, sl.Err(op, err)
+	}
+
+	if attachment.MessageID == nil && attachment.UserID != input.UserID {
+		return model.Attachment{}, nil, sl.Err(op, apperr.NotFound("attachment not found"))
+	}
+
+	if attachment.MessageID != nil {
+		msg, err := s.chats.Message(ctx, attachment.ChatID, *attachment.MessageID)
+		if err != nil {
+			return model.Attachment{}, nil, sl.Err(op, err)
+		}
+
+		if msg.DeletedAt != nil {
+			return model.Attachment{}, nil, sl.Err(op, apperr.NotFound("attachment not found"))
+		}
+	}
+
+	body, err := s.blobs.Get(ctx, attachment.StorageKey)
+	if errors.Is(err, client.ErrBlobNotFound) {
+		return model.Attachment{}, nil, sl.Err(op, apperr.Wrap(apperr.CodeNotFound, "attachment not found", err))
+	}
+	if err != nil {
+		return model.Attachment{}, nil, sl.Err(op, err)
+	}
+
+	return attachment, body, nil
+}
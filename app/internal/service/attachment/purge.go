@@ -0,0 +1,91 @@
+package attachmentservice
+
+import (
+	"context"
+	"errors"
+
+	"github.com/defany/slogger/pkg/logger/sl"
+)
+
+const defaultPurgeBatchSize = 100
+
+// PurgeUnattached сначала удаляет строки, а потом файлы: наоборот можно снести файл вложения, которое как раз прикрепляют к сообщению
+func (s *service) PurgeUnattached(ctx context.Context) (int64, error) {
+	op := sl.FnName()
+
+	batchSize := s.cfg.PurgeBatchSize
+	if batchSize == 0 {
+		batchSize = defaultPurgeBatchSize
+	}
+
+	var total int64
+
+	for {
+		attachments, err := s.attachments.PurgeUnattached(ctx, s.cfg.UnattachedTTL, batchSize)
+		if err != nil {
+			return total, sl.Err(op, err)
+		}
+
+		total += int64(len(attachments))
+
+		var errs []error
+		for _, attachment := range attachments {
+			errs = append(errs, s.blobs.Delete(ctx, attachment.StorageKey))
+		}
+
+		if err := errors.Join(errs...); err != nil {
+			return total, sl.Err(op, err)
+		}
+
+		if uint64(len(attachments)) < batchSize {
+			return total, nil
+		}
+	}
+}
+
+// PurgeOrphans убирает из хранилища файлы удаленных чатов и сообщений. Ключ забываем только после удаления файла,
+// так что при сбое хранилища файл удалится на следующем проходе
+func (s *service) PurgeOrphans(ctx context.Context) (int64, error) {
+	op := sl.FnName()
+
+	batchSize := s.cfg.PurgeBatchSize
+	if batchSize == 0 {
+		batchSize = defaultPurgeBatchSize
+	}
+
+	var total int64
+
+	for {
+		keys, err := s.attachments.Orphans(ctx, batchSize)
+		if err != nil {
+			return total, sl.Err(op, err)
+		}
+
+		removed := make([]string, 0, len(keys))
+
+		var errs []error
+		for _, key := range keys {
+			if err := s.blobs.Delete(ctx, key); err != nil {
+				errs = append(errs, err)
+
+				continue
+			}
+
+			removed = append(removed, key)
+		}
+
+		if err := s.attachments.RemoveOrphans(ctx, removed); err != nil {
+			return total, sl.Err(op, err)
+		}
+
+		total += int64(len(removed))
+
+		if err := errors.Join(errs...); err != nil {
+			return total, sl.Err(op, err)
+		}
+
+		if uint64(len(keys)) < batchSize {
+			return total, nil
+		}
+	}
+}
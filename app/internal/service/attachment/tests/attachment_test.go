@@ -0,0 +1,237 @@
+package attachmenttests
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/defany/chat-server/app/internal/apperr"
+	blobclient "github.com/defany/chat-server/app/internal/client/blob"
+	"github.com/defany/chat-server/app/internal/config"
+	"github.com/defany/chat-server/app/internal/converter"
+	"github.com/defany/chat-server/app/internal/model"
+	mockrepository "github.com/defany/chat-server/app/internal/repository/mocks"
+	attachmentservice "github.com/defany/chat-server/app/internal/service/attachment"
+	"github.com/defany/slogger/pkg/logger/sl"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService_Upload(t *testing.T) {
+	var (
+		ctx = context.Background()
+
+		input = converter.UploadAttachmentInput{
+			ChatID:   gofakeit.Int64(),
+			UserID:   gofakeit.Uint64(),
+			Filename: "photo.png",
+			MimeType: "image/png",
+		}
+
+		// Сигнатура PNG, по ней сервис и определит тип
+		content = append([]byte("\x89PNG\r\n\x1a\n"), gofakeit.Paragraph(3, 5, 10, " ")...)
+
+		script = []byte("#!/bin/sh\necho hello\n")
+
+		sum = sha256.Sum256(content)
+
+		cfg = config.Attachments{
+			MaxSize:          int64(len(content)),
+			AllowedMimeTypes: []string{"image/*", "application/pdf"},
+		}
+	)
+
+	tests := []struct {
+		name    string
+		input   converter.UploadAttachmentInput
+		content []byte
+		err     error
+		mocker  func(chats *mockrepository.MockChat, attachments *mockrepository.MockAttachment)
+	}{
+		{
+			name:    "member uploads file",
+			input:   input,
+			content: content,
+			mocker: func(chats *mockrepository.MockChat, attachments *mockrepository.MockAttachment) {
+				chats.On("MemberRole", ctx, input.ChatID, input.UserID).Return(model.RoleMember, nil)
+				chats.On("Chat", ctx, input.ChatID).Return(model.Chat{ID: input.ChatID, Kind: model.ChatKindGroup}, nil)
+				attachments.On("Create", ctx, mock.MatchedBy(func(attachment model.Attachment) bool {
+					return attachment.ChatID == input.ChatID &&
+						attachment.UserID == input.UserID &&
+						attachment.Filename == input.Filename &&
+						attachment.MimeType == "image/png" &&
+						attachment.Size == int64(len(content)) &&
+						attachment.SHA256 == hex.EncodeToString(sum[:]) &&
+						strings.HasPrefix(attachment.StorageKey, fmt.Sprintf("%d/", input.ChatID))
+				})).Return(model.Attachment{ID: gofakeit.Uint64()}, nil)
+			},
+		},
+		{
+			name:    "declared mime type is ignored",
+			input:   input,
+			content: script,
+			err: sl.Err("service.Upload", apperr.InvalidArgument("mime type is not allowed", apperr.FieldViolation{
+				Field:       "mime_type",
+				Description: "text/plain files can not be uploaded",
+			})),
+			mocker: func(chats *mockrepository.MockChat, attachments *mockrepository.MockAttachment) {
+				chats.On("MemberRole", ctx, input.ChatID, input.UserID).Return(model.RoleAdmin, nil)
+			},
+		},
+		{
+			name:    "file is larger than limit",
+			input:   input,
+			content: append(bytes.Clone(content), 'x'),
+			err: sl.Err("service.Upload", apperr.InvalidArgument("attachment is too large", apperr.FieldViolation{
+				Field:       "chunk",
+				Description: fmt.Sprintf("attachment must not be larger than %d bytes", len(content)),
+			})),
+			mocker: func(chats *mockrepository.MockChat, attachments *mockrepository.MockAttachment) {
+				chats.On("MemberRole", ctx, input.ChatID, input.UserID).Return(model.RoleAdmin, nil)
+			},
+		},
+		{
+			name:  "subscriber can not upload to channel",
+			input: input,
+			err:   sl.Err("service.Upload", apperr.PermissionDenied("only channel admins can post")),
+			mocker: func(chats *mockrepository.MockChat, attachments *mockrepository.MockAttachment) {
+				chats.On("MemberRole", ctx, input.ChatID, input.UserID).Return(model.RoleMember, nil)
+				chats.On("Chat", ctx, input.ChatID).Return(model.Chat{ID: input.ChatID, Kind: model.ChatKindChannel}, nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			chats := mockrepository.NewMockChat(t)
+			attachments := mockrepository.NewMockAttachment(t)
+
+			tt.mocker(chats, attachments)
+
+			blobs, err := blobclient.NewLocalStore(t.TempDir())
+			require.NoError(t, err)
+
+			service := attachmentservice.NewService(chats, attachments, blobs, cfg)
+
+			_, err = service.Upload(ctx, tt.input, bytes.NewReader(tt.content))
+
+			require.Equal(t, tt.err, err)
+		})
+	}
+}
+
+func TestService_Download(t *testing.T) {
+	var (
+		ctx = context.Background()
+
+		userID = gofakeit.Uint64()
+
+		messageID = gofakeit.Uint64()
+
+		content = []byte(gofakeit.Paragraph(1, 3, 10, " "))
+
+		sent = model.Attachment{
+			ID:         gofakeit.Uint64(),
+			ChatID:     gofakeit.Int64(),
+			UserID:     gofakeit.Uint64(),
+			MessageID:  &messageID,
+			Filename:   "report.pdf",
+			MimeType:   "application/pdf",
+			Size:       int64(len(content)),
+			StorageKey: "chat/report",
+		}
+
+		deletedAt = time.Now()
+	)
+
+	pending := sent
+	pending.MessageID = nil
+
+	tests := []struct {
+		name       string
+		attachment model.Attachment
+		want       []byte
+		err        error
+		mocker     func(chats *mockrepository.MockChat)
+	}{
+		{
+			name:       "member downloads sent attachment",
+			attachment: sent,
+			want:       content,
+			mocker: func(chats *mockrepository.MockChat) {
+				chats.On("MemberRole", ctx, sent.ChatID, userID).Return(model.RoleMember, nil)
+				chats.On("Message", ctx, sent.ChatID, messageID).Return(model.Message{ID: messageID}, nil)
+			},
+		},
+		{
+			name:       "outsider can not download attachment",
+			attachment: sent,
+			err:        sl.Err("service.Download", apperr.PermissionDenied("user is not a member of the chat")),
+			mocker: func(chats *mockrepository.MockChat) {
+				chats.On("MemberRole", ctx, sent.ChatID, userID).Return(model.Role(""), nil)
+			},
+		},
+		{
+			name:       "attachment of deleted message is hidden",
+			attachment: sent,
+			err:        sl.Err("service.Download", apperr.NotFound("attachment not found")),
+			mocker: func(chats *mockrepository.MockChat) {
+				chats.On("MemberRole", ctx, sent.ChatID, userID).Return(model.RoleMember, nil)
+				chats.On("Message", ctx, sent.ChatID, messageID).Return(model.Message{ID: messageID, DeletedAt: &deletedAt}, nil)
+			},
+		},
+		{
+			name:       "pending attachment is visible only to uploader",
+			attachment: pending,
+			err:        sl.Err("service.Download", apperr.NotFound("attachment not found")),
+			mocker: func(chats *mockrepository.MockChat) {
+				chats.On("MemberRole", ctx, sent.ChatID, userID).Return(model.RoleMember, nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			chats := mockrepository.NewMockChat(t)
+			attachments := mockrepository.NewMockAttachment(t)
+
+			attachments.On("Attachment", ctx, tt.attachment.ID).Return(tt.attachment, nil)
+
+			tt.mocker(chats)
+
+			blobs, err := blobclient.NewLocalStore(t.TempDir())
+			require.NoError(t, err)
+
+			_, err = blobs.Put(ctx, sent.StorageKey, bytes.NewReader(content))
+			require.NoError(t, err)
+
+			service := attachmentservice.NewService(chats, attachments, blobs, config.Attachments{})
+
+			attachment, body, err := service.Download(ctx, converter.DownloadAttachmentInput{
+				AttachmentID: tt.attachment.ID,
+				UserID:       userID,
+			})
+
+			require.Equal(t, tt.err, err)
+
+			if tt.err != nil {
+				return
+			}
+
+			defer body.Close()
+
+			got, err := io.ReadAll(body)
+			require.NoError(t, err)
+
+			require.Equal(t, tt.attachment, attachment)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,154 @@
+package attachmenttests
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	blobclient "github.com/defany/chat-server/app/internal/client/blob"
+	mockclient "github.com/defany/chat-server/app/internal/client/mocks"
+	"github.com/defany/chat-server/app/internal/config"
+	"github.com/defany/chat-server/app/internal/model"
+	mockrepository "github.com/defany/chat-server/app/internal/repository/mocks"
+	attachmentservice "github.com/defany/chat-server/app/internal/service/attachment"
+	"github.com/defany/slogger/pkg/logger/sl"
+	"github.com/stretchr/testify/require"
+)
+
+// defaultBatchSize размер пачки, когда purge_batch_size не задан
+const defaultBatchSize = 100
+
+func TestService_PurgeUnattached(t *testing.T) {
+	const batchSize = 2
+
+	err := errors.New("failed to purge attachments")
+
+	tests := []struct {
+		name    string
+		batches []int
+		err     error
+		want    int64
+		wantErr error
+	}{
+		{
+			name:    "purges batches until the last incomplete one",
+			batches: []int{batchSize, batchSize, 1},
+			want:    2*batchSize + 1,
+		},
+		{
+			name:    "nothing to purge",
+			batches: []int{0},
+			want:    0,
+		},
+		{
+			name:    "repository error stops purging",
+			batches: []int{batchSize},
+			err:     err,
+			want:    batchSize,
+			wantErr: sl.Err("service.PurgeUnattached", err),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			blobs, err := blobclient.NewLocalStore(t.TempDir())
+			require.NoError(t, err)
+
+			attachments := mockrepository.NewMockAttachment(t)
+
+			var keys []string
+
+			for i, size := range tt.batches {
+				batch := make([]model.Attachment, 0, size)
+
+				for j := range size {
+					key := fmt.Sprintf("1/%d-%d", i, j)
+
+					_, err := blobs.Put(ctx, key, bytes.NewReader([]byte("content")))
+					require.NoError(t, err)
+
+					keys = append(keys, key)
+					batch = append(batch, model.Attachment{StorageKey: key})
+				}
+
+				attachments.On("PurgeUnattached", ctx, time.Hour, uint64(batchSize)).Return(batch, nil).Once()
+			}
+
+			if tt.err != nil {
+				attachments.On("PurgeUnattached", ctx, time.Hour, uint64(batchSize)).Return(nil, tt.err).Once()
+			}
+
+			service := attachmentservice.NewService(nil, attachments, blobs, config.Attachments{
+				UnattachedTTL:  time.Hour,
+				PurgeBatchSize: batchSize,
+			})
+
+			purged, err := service.PurgeUnattached(ctx)
+
+			require.Equal(t, tt.wantErr, err)
+			require.Equal(t, tt.want, purged)
+
+			for _, key := range keys {
+				_, err := blobs.Get(ctx, key)
+				require.Error(t, err, "blob %s must be deleted", key)
+			}
+		})
+	}
+}
+
+func TestService_PurgeOrphans(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("files of purged chat are removed from storage", func(t *testing.T) {
+		blobs, err := blobclient.NewLocalStore(t.TempDir())
+		require.NoError(t, err)
+
+		keys := []string{"1/first", "1/second"}
+
+		for _, key := range keys {
+			_, err := blobs.Put(ctx, key, bytes.NewReader([]byte("content")))
+			require.NoError(t, err)
+		}
+
+		// Так очередь выглядит после PurgeDeletedChats: строк вложений уже нет, остались только ключи
+		attachments := mockrepository.NewMockAttachment(t)
+		attachments.On("Orphans", ctx, uint64(defaultBatchSize)).Return(keys, nil)
+		attachments.On("RemoveOrphans", ctx, keys).Return(nil)
+
+		service := attachmentservice.NewService(nil, attachments, blobs, config.Attachments{})
+
+		purged, err := service.PurgeOrphans(ctx)
+
+		require.NoError(t, err)
+		require.Equal(t, int64(len(keys)), purged)
+
+		for _, key := range keys {
+			_, err := blobs.Get(ctx, key)
+			require.Error(t, err, "blob %s must be deleted", key)
+		}
+	})
+
+	t.Run("key stays queued when storage fails", func(t *testing.T) {
+		storageErr := errors.New("storage is unavailable")
+
+		blobs := mockclient.NewMockBlobStore(t)
+		blobs.On("Delete", ctx, "1/first").Return(nil)
+		blobs.On("Delete", ctx, "1/second").Return(storageErr)
+
+		attachments := mockrepository.NewMockAttachment(t)
+		attachments.On("Orphans", ctx, uint64(defaultBatchSize)).Return([]string{"1/first", "1/second"}, nil)
+		attachments.On("RemoveOrphans", ctx, []string{"1/first"}).Return(nil)
+
+		service := attachmentservice.NewService(nil, attachments, blobs, config.Attachments{})
+
+		purged, err := service.PurgeOrphans(ctx)
+
+		require.Equal(t, sl.Err("service.PurgeOrphans", errors.Join(storageErr)), err)
+		require.Equal(t, int64(1), purged)
+	})
+}
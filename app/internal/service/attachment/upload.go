@@ -0,0 +1,124 @@
+package attachmentservice
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/defany/chat-server/app/internal/apperr"
+	"github.com/defany/chat-server/app/internal/converter"
+	"github.com/defany/chat-server/app/internal/model"
+	"github.com/defany/slogger/pkg/logger/sl"
+)
+
+var errTooLarge = errors.New("attachment is too large")
+
+// sniffLen столько байт смотрит http.DetectContentType, больше читать заранее незачем
+const sniffLen = 512
+
+// Upload складывает файл в хранилище и записывает метаданные. К сообщению вложение привязывается позже, при отправке
+func (s *service) Upload(ctx context.Context, input converter.UploadAttachmentInput, body io.Reader) (model.Attachment, error) {
+	op := sl.FnName()
+
+	err := s.access.RequirePoster(ctx, input.ChatID, input.UserID)
+	if err != nil {
+		return model.Attachment{}, sl.Err(op, err)
+	}
+
+	// Присланному клиентом mime_type не верим: тип определяем по первым байтам файла
+	head := make([]byte, sniffLen)
+
+	n, err := io.ReadFull(body, head)
+	if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
+		return model.Attachment{}, sl.Err(op, err)
+	}
+
+	head = head[:n]
+
+	mimeType := detectMimeType(head)
+
+	if !s.mimeAllowed(mimeType) {
+		return model.Attachment{}, sl.Err(op, apperr.InvalidArgument("mime type is not allowed", apperr.FieldViolation{
+			Field:       "mime_type",
+			Description: fmt.Sprintf("%s files can not be uploaded", mimeType),
+		}))
+	}
+
+	key, err := storageKey(input.ChatID)
+	if err != nil {
+		return model.Attachment{}, sl.Err(op, err)
+	}
+
+	hash := sha256.New()
+
+	size, err := s.blobs.Put(ctx, key, io.TeeReader(&limitedReader{r: io.MultiReader(bytes.NewReader(head), body), left: s.cfg.MaxSize}, hash))
+	if errors.Is(err, errTooLarge) {
+		return model.Attachment{}, sl.Err(op, apperr.InvalidArgument("attachment is too large", apperr.FieldViolation{
+			Field:       "chunk",
+			Description: fmt.Sprintf("attachment must not be larger than %d bytes", s.cfg.MaxSize),
+		}))
+	}
+	if err != nil {
+		return model.Attachment{}, sl.Err(op, err)
+	}
+
+	attachment, err := s.attachments.Create(ctx, model.Attachment{
+		ChatID:     input.ChatID,
+		UserID:     input.UserID,
+		Filename:   input.Filename,
+		MimeType:   mimeType,
+		Size:       size,
+		SHA256:     hex.EncodeToString(hash.Sum(nil)),
+		StorageKey: key,
+	})
+	if err != nil {
+		// Без строки в базе файл никто не найдет, поэтому не оставляем его в хранилище
+		_ = s.blobs.Delete(context.WithoutCancel(ctx), key)
+
+		return model.Attachment{}, sl.Err(op, err)
+	}
+
+	return attachment, nil
+}
+
+// detectMimeType отрезает параметры вроде charset, чтобы сравнивать с настройками только сам тип
+func detectMimeType(head []byte) string {
+	mimeType, _, _ := strings.Cut(http.DetectContentType(head), ";")
+
+	return strings.TrimSpace(mimeType)
+}
+
+// storageKey случайный, чтобы по ключу нельзя было угадать чужие файлы, а префикс чата упрощает ручную уборку
+func storageKey(chatID int64) (string, error) {
+	b := make([]byte, 16)
+
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%d/%s", chatID, hex.EncodeToString(b)), nil
+}
+
+// limitedReader в отличие от io.LimitReader не обрезает файл молча, а прерывает загрузку ошибкой
+type limitedReader struct {
+	r    io.Reader
+	left int64
+}
+
+func (l *limitedReader) Read(p []byte) (int, error) {
+	n, err := l.r.Read(p)
+
+	l.left -= int64(n)
+	if l.left < 0 {
+		return n, errTooLarge
+	}
+
+	return n, err
+}
@@ -0,0 +1,45 @@
+package chatservice
+
+import (
+	"context"
+
+	"github.com/defany/chat-server/app/internal/apperr"
+	"github.com/defany/chat-server/app/internal/model"
+)
+
+// authoredMessage блокирует сообщение и проверяет, что его еще можно менять от имени пользователя
+func (s *service) authoredMessage(ctx context.Context, chatID int64, messageID uint64, userID uint64) (model.Message, error) {
+	if err := s.access.RequireRole(ctx, chatID, userID); err != nil {
+		return model.Message{}, err
+	}
+
+	msg, err := s.repo.MessageForUpdate(ctx, chatID, messageID)
+	if err != nil {
+		return model.Message{}, err
+	}
+
+	if msg.DeletedAt != nil {
+		return model.Message{}, apperr.NotFound("message not found")
+	}
+
+	if msg.System {
+		return model.Message{}, apperr.PermissionDenied("system messages can not be changed")
+	}
+
+	if msg.UserID != userID {
+		return model.Message{}, apperr.PermissionDenied("only author can change message")
+	}
+
+	if s.cfg.EditWindow > 0 {
+		ok, err := s.repo.InEditWindow(ctx, msg.ID, s.cfg.EditWindow)
+		if err != nil {
+			return model.Message{}, err
+		}
+
+		if !ok {
+			return model.Message{}, apperr.FailedPrecondition("message can not be changed after edit window")
+		}
+	}
+
+	return msg, nil
+}
@@ -0,0 +1,88 @@
+package chatservice
+
+import (
+	"context"
+
+	"github.com/defany/chat-server/app/internal/apperr"
+	"github.com/defany/chat-server/app/internal/converter"
+	"github.com/defany/chat-server/app/internal/model"
+	"github.com/defany/slogger/pkg/logger/sl"
+)
+
+// CreateChannel создает канал, в котором пока только владелец. Читатели приходят сами через Subscribe
+func (s *service) CreateChannel(ctx context.Context, input converter.CreateChannelInput) (converter.CreateChatOutput, error) {
+	op := sl.FnName()
+
+	var output converter.CreateChatOutput
+
+	err := s.tx.ReadCommitted(ctx, func(ctx context.Context) error {
+		chatID, err := s.repo.Create(ctx, model.Chat{
+			Kind:        model.ChatKindChannel,
+			Title:       input.Title,
+			Description: input.Description,
+		})
+		if err != nil {
+			return err
+		}
+
+		output.ID = chatID
+
+		err = s.repo.AddMembers(ctx, chatID, chatMembers(input.UserID, nil))
+		if err != nil {
+			return err
+		}
+
+		err = s.log.Log(ctx, model.Log{
+			Action: model.LogCreateChat,
+			UserID: input.UserID,
+		})
+		if err != nil {
+			return err
+		}
+
+		return nil
+	})
+	if err != nil {
+		return converter.CreateChatOutput{}, sl.Err(op, err)
+	}
+
+	return output, nil
+}
+
+// Subscribe добавляет пользователя в канал обычным участником. Служебное сообщение не пишем, подписчиков слишком много
+func (s *service) Subscribe(ctx context.Context, input converter.SubscribeInput) error {
+	op := sl.FnName()
+
+	err := s.tx.ReadCommitted(ctx, func(ctx context.Context) error {
+		chat, err := s.repo.Chat(ctx, input.ChatID)
+		if err != nil {
+			return err
+		}
+
+		if chat.Kind != model.ChatKindChannel {
+			return apperr.FailedPrecondition("only channels can be subscribed to")
+		}
+
+		err = s.repo.AddMembers(ctx, uint64(input.ChatID), []model.ChatMember{
+			{UserID: input.UserID, Role: model.RoleMember},
+		})
+		if err != nil {
+			return err
+		}
+
+		err = s.log.Log(ctx, model.Log{
+			Action: model.LogSubscribe,
+			UserID: input.UserID,
+		})
+		if err != nil {
+			return err
+		}
+
+		return nil
+	})
+	if err != nil {
+		return sl.Err(op, err)
+	}
+
+	return nil
+}
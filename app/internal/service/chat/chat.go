@@ -1,21 +1,42 @@
 package chatservice
 
 import (
+	"github.com/defany/chat-server/app/internal/client"
+	"github.com/defany/chat-server/app/internal/config"
 	"github.com/defany/chat-server/app/internal/repository"
 	servicedef "github.com/defany/chat-server/app/internal/service"
+	"github.com/defany/chat-server/app/internal/service/access"
+	"github.com/defany/chat-server/app/internal/service/hub"
+	"github.com/defany/chat-server/app/internal/service/presence"
 	"github.com/defany/db/pkg/postgres"
 )
 
 type service struct {
-	tx   postgres.TxManager
-	repo repository.Chat
-	log  repository.Log
+	tx            postgres.TxManager
+	repo          repository.Chat
+	reactions     repository.Reaction
+	attachments   repository.Attachment
+	notifications repository.Notification
+	log           repository.Log
+	users         client.UserDirectory
+	hub           *hub.Hub
+	presence      *presence.Tracker
+	access        *access.Checker
+	cfg           config.Chat
 }
 
-func NewService(tx postgres.TxManager, repo repository.Chat, log repository.Log) servicedef.Chat {
+func NewService(tx postgres.TxManager, repo repository.Chat, reactions repository.Reaction, attachments repository.Attachment, notifications repository.Notification, log repository.Log, users client.UserDirectory, hub *hub.Hub, presence *presence.Tracker, cfg config.Chat) servicedef.Chat {
 	return &service{
-		tx:   tx,
-		repo: repo,
-		log:  log,
+		tx:            tx,
+		repo:          repo,
+		reactions:     reactions,
+		attachments:   attachments,
+		notifications: notifications,
+		log:           log,
+		users:         users,
+		hub:           hub,
+		presence:      presence,
+		access:        access.New(repo),
+		cfg:           cfg,
 	}
 }
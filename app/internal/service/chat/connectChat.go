@@ -0,0 +1,41 @@
+package chatservice
+
+import (
+	"context"
+	"errors"
+
+	"github.com/defany/chat-server/app/internal/converter"
+	"github.com/defany/chat-server/app/internal/model"
+	"github.com/defany/chat-server/app/internal/service/hub"
+	"github.com/defany/slogger/pkg/logger/sl"
+)
+
+func (s *service) ConnectChat(ctx context.Context, input converter.ConnectChatInput, send func(msg model.Message) error) error {
+	op := sl.FnName()
+
+	if err := s.access.RequireRole(ctx, input.ChatID, input.UserID); err != nil {
+		return sl.Err(op, err)
+	}
+
+	sub := s.hub.Subscribe(input.ChatID, input.UserID)
+	defer s.hub.Unsubscribe(sub)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case msg, ok := <-sub.Messages():
+			if !ok {
+				if err := sub.Err(); err != nil && !errors.Is(err, hub.ErrChatClosed) && !errors.Is(err, hub.ErrMemberRemoved) {
+					return sl.Err(op, err)
+				}
+
+				return nil
+			}
+
+			if err := send(msg); err != nil {
+				return sl.Err(op, err)
+			}
+		}
+	}
+}
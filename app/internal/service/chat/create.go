@@ -2,7 +2,10 @@ package chatservice
 
 import (
 	"context"
+	"errors"
 
+	"github.com/defany/chat-server/app/internal/apperr"
+	"github.com/defany/chat-server/app/internal/client"
 	"github.com/defany/chat-server/app/internal/converter"
 	"github.com/defany/chat-server/app/internal/model"
 	"github.com/defany/slogger/pkg/logger/sl"
@@ -11,10 +14,50 @@ import (
 func (s *service) CreateChat(ctx context.Context, input converter.CreateChatInput) (converter.CreateChatOutput, error) {
 	op := sl.FnName()
 
+	memberIDs, err := s.users.IDs(ctx, input.Nicknames)
+	if err != nil {
+		if errors.Is(err, client.ErrUserNotFound) {
+			err = apperr.Wrap(apperr.CodeNotFound, err.Error(), err)
+		}
+
+		return converter.CreateChatOutput{}, sl.Err(op, err)
+	}
+
+	members := chatMembers(input.UserID, memberIDs)
+
+	// Чат ровно с одним другим пользователем без названия личный, с названием - ошибка, а не молча потерянный заголовок
+	isDirect := len(members) == 2
+
+	switch {
+	case isDirect && input.Title != "":
+		return converter.CreateChatOutput{}, sl.Err(op, apperr.InvalidArgument("title is not allowed for direct chat", apperr.FieldViolation{
+			Field:       "title",
+			Description: "chat with one other user is direct and has no title, add more members to create a group",
+		}))
+	case !isDirect && input.Title == "":
+		return converter.CreateChatOutput{}, sl.Err(op, apperr.InvalidArgument("group chat must have a title", apperr.FieldViolation{
+			Field:       "title",
+			Description: "title is required for group chat",
+		}))
+	}
+
 	var output converter.CreateChatOutput
 
-	err := s.tx.ReadCommitted(ctx, func(ctx context.Context) error {
+	err = s.tx.ReadCommitted(ctx, func(ctx context.Context) error {
+		// У пары личный чат всегда один, поэтому отдаем уже существующий
+		if isDirect {
+			chatID, _, err := s.getOrCreateDirect(ctx, input.UserID, members[1].UserID)
+			if err != nil {
+				return err
+			}
+
+			output.ID = uint64(chatID)
+
+			return nil
+		}
+
 		chatID, err := s.repo.Create(ctx, model.Chat{
+			Kind:  model.ChatKindGroup,
 			Title: input.Title,
 		})
 		if err != nil {
@@ -23,6 +66,11 @@ func (s *service) CreateChat(ctx context.Context, input converter.CreateChatInpu
 
 		output.ID = chatID
 
+		err = s.repo.AddMembers(ctx, chatID, members)
+		if err != nil {
+			return err
+		}
+
 		err = s.log.Log(ctx, model.Log{
 			Action: model.LogCreateChat,
 			UserID: input.UserID,
@@ -39,3 +87,30 @@ func (s *service) CreateChat(ctx context.Context, input converter.CreateChatInpu
 
 	return output, nil
 }
+
+// chatMembers делает создателя владельцем чата, а остальных - обычными участниками
+func chatMembers(ownerID uint64, memberIDs []uint64) []model.ChatMember {
+	seen := map[uint64]struct{}{
+		ownerID: {},
+	}
+
+	members := make([]model.ChatMember, 0, len(memberIDs)+1)
+	members = append(members, model.ChatMember{
+		UserID: ownerID,
+		Role:   model.RoleOwner,
+	})
+
+	for _, id := range memberIDs {
+		if _, ok := seen[id]; ok {
+			continue
+		}
+
+		seen[id] = struct{}{}
+		members = append(members, model.ChatMember{
+			UserID: id,
+			Role:   model.RoleMember,
+		})
+	}
+
+	return members
+}
@@ -12,7 +12,12 @@ func (s *service) DeleteChat(ctx context.Context, input converter.DeleteChatInpu
 	op := sl.FnName()
 
 	err := s.tx.ReadCommitted(ctx, func(ctx context.Context) error {
-		err := s.repo.Delete(ctx, input.ChatID)
+		err := s.access.RequireRole(ctx, input.ChatID, input.UserID, model.RoleOwner)
+		if err != nil {
+			return err
+		}
+
+		err = s.repo.Delete(ctx, input.ChatID)
 		if err != nil {
 			return err
 		}
@@ -31,5 +36,8 @@ func (s *service) DeleteChat(ctx context.Context, input converter.DeleteChatInpu
 		return sl.Err(op, err)
 	}
 
+	s.hub.CloseChat(input.ChatID)
+	s.presence.CloseChat(input.ChatID)
+
 	return nil
 }
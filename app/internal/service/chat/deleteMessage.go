@@ -0,0 +1,61 @@
+package chatservice
+
+import (
+	"context"
+
+	"github.com/defany/chat-server/app/internal/converter"
+	"github.com/defany/chat-server/app/internal/model"
+	"github.com/defany/slogger/pkg/logger/sl"
+)
+
+func (s *service) DeleteMessage(ctx context.Context, input converter.DeleteMessageInput) error {
+	op := sl.FnName()
+
+	var msg model.Message
+
+	err := s.tx.ReadCommitted(ctx, func(ctx context.Context) error {
+		current, err := s.authoredMessage(ctx, input.ChatID, input.MessageID, input.UserID)
+		if err != nil {
+			return err
+		}
+
+		// Текст пропадает из сообщения, но остается в истории правок
+		err = s.repo.AddMessageRevision(ctx, current.ID, current.Text, current.Entities)
+		if err != nil {
+			return err
+		}
+
+		msg, err = s.repo.DeleteMessage(ctx, current.ID)
+		if err != nil {
+			return err
+		}
+
+		// Вложения удаленного сообщения больше никто не скачает, их файлы уберет purger
+		err = s.attachments.OrphanMessage(ctx, current.ID)
+		if err != nil {
+			return err
+		}
+
+		err = s.attachments.DeleteByMessage(ctx, current.ID)
+		if err != nil {
+			return err
+		}
+
+		err = s.log.Log(ctx, model.Log{
+			Action: model.LogDeleteMessage,
+			UserID: input.UserID,
+		})
+		if err != nil {
+			return err
+		}
+
+		return nil
+	})
+	if err != nil {
+		return sl.Err(op, err)
+	}
+
+	s.hub.Publish(msg)
+
+	return nil
+}
@@ -0,0 +1,81 @@
+package chatservice
+
+import (
+	"context"
+
+	"github.com/defany/chat-server/app/internal/apperr"
+	"github.com/defany/chat-server/app/internal/client"
+	"github.com/defany/chat-server/app/internal/converter"
+	"github.com/defany/chat-server/app/internal/model"
+	"github.com/defany/slogger/pkg/logger/sl"
+)
+
+func (s *service) GetOrCreateDirectChat(ctx context.Context, input converter.GetOrCreateDirectChatInput) (converter.GetOrCreateDirectChatOutput, error) {
+	op := sl.FnName()
+
+	if input.PeerID == input.UserID {
+		return converter.GetOrCreateDirectChatOutput{}, sl.Err(op, apperr.InvalidArgument("can not create direct chat with yourself"))
+	}
+
+	usernames, err := s.users.Usernames(ctx, []uint64{input.PeerID})
+	if err != nil {
+		return converter.GetOrCreateDirectChatOutput{}, sl.Err(op, err)
+	}
+
+	if _, ok := usernames[input.PeerID]; !ok {
+		return converter.GetOrCreateDirectChatOutput{}, sl.Err(op, apperr.Wrap(apperr.CodeNotFound, client.ErrUserNotFound.Error(), client.ErrUserNotFound))
+	}
+
+	var output converter.GetOrCreateDirectChatOutput
+
+	err = s.tx.ReadCommitted(ctx, func(ctx context.Context) error {
+		chatID, created, err := s.getOrCreateDirect(ctx, input.UserID, input.PeerID)
+		if err != nil {
+			return err
+		}
+
+		output.Created = created
+
+		output.Chat, err = s.repo.Chat(ctx, chatID)
+		if err != nil {
+			return err
+		}
+
+		return nil
+	})
+	if err != nil {
+		return converter.GetOrCreateDirectChatOutput{}, sl.Err(op, err)
+	}
+
+	return output, nil
+}
+
+// getOrCreateDirect должен вызываться внутри транзакции. Оба участника личного чата - владельцы, так его никто не покинет и не удалит другого
+func (s *service) getOrCreateDirect(ctx context.Context, userID, peerID uint64) (int64, bool, error) {
+	chatID, created, err := s.repo.GetOrCreateDirect(ctx, userID, peerID)
+	if err != nil {
+		return 0, false, err
+	}
+
+	if !created {
+		return chatID, false, nil
+	}
+
+	err = s.repo.AddMembers(ctx, uint64(chatID), []model.ChatMember{
+		{UserID: userID, Role: model.RoleOwner},
+		{UserID: peerID, Role: model.RoleOwner},
+	})
+	if err != nil {
+		return 0, false, err
+	}
+
+	err = s.log.Log(ctx, model.Log{
+		Action: model.LogCreateChat,
+		UserID: userID,
+	})
+	if err != nil {
+		return 0, false, err
+	}
+
+	return chatID, true, nil
+}
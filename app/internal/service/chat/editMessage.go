@@ -0,0 +1,81 @@
+package chatservice
+
+import (
+	"context"
+	"slices"
+
+	"github.com/defany/chat-server/app/internal/apperr"
+	"github.com/defany/chat-server/app/internal/content"
+	"github.com/defany/chat-server/app/internal/converter"
+	"github.com/defany/chat-server/app/internal/model"
+	"github.com/defany/slogger/pkg/logger/sl"
+)
+
+func (s *service) EditMessage(ctx context.Context, input converter.EditMessageInput) (model.Message, error) {
+	op := sl.FnName()
+
+	if input.Text == "" {
+		return model.Message{}, sl.Err(op, apperr.InvalidArgument("message text can not be empty", apperr.FieldViolation{
+			Field:       "text",
+			Description: "delete the message instead of clearing its text",
+		}))
+	}
+
+	entities, err := content.Parse(input.Text, input.Entities)
+	if err != nil {
+		return model.Message{}, sl.Err(op, err)
+	}
+
+	var msg model.Message
+
+	err = s.tx.ReadCommitted(ctx, func(ctx context.Context) error {
+		current, err := s.authoredMessage(ctx, input.ChatID, input.MessageID, input.UserID)
+		if err != nil {
+			return err
+		}
+
+		err = s.repo.AddMessageRevision(ctx, current.ID, current.Text, current.Entities)
+		if err != nil {
+			return err
+		}
+
+		msg, err = s.repo.EditMessage(ctx, current.ID, input.Text, entities)
+		if err != nil {
+			return err
+		}
+
+		mentioned, err := s.setMentions(ctx, msg, current.Entities)
+		if err != nil {
+			return err
+		}
+
+		// Уже упомянутых до правки повторно не дергаем
+		added := slices.DeleteFunc(mentioned, func(userID uint64) bool {
+			return slices.Contains(content.Mentions(current.Entities), userID)
+		})
+
+		if len(added) != 0 {
+			err = s.notifications.EnqueueMentions(ctx, msg, added)
+			if err != nil {
+				return err
+			}
+		}
+
+		err = s.log.Log(ctx, model.Log{
+			Action: model.LogEditMessage,
+			UserID: input.UserID,
+		})
+		if err != nil {
+			return err
+		}
+
+		return nil
+	})
+	if err != nil {
+		return model.Message{}, sl.Err(op, err)
+	}
+
+	s.hub.Publish(msg)
+
+	return msg, nil
+}
@@ -0,0 +1,24 @@
+package chatservice
+
+import (
+	"context"
+
+	"github.com/defany/chat-server/app/internal/converter"
+	"github.com/defany/chat-server/app/internal/model"
+	"github.com/defany/slogger/pkg/logger/sl"
+)
+
+func (s *service) GetChat(ctx context.Context, input converter.GetChatInput) (model.Chat, error) {
+	op := sl.FnName()
+
+	if err := s.access.RequireRole(ctx, input.ChatID, input.UserID); err != nil {
+		return model.Chat{}, sl.Err(op, err)
+	}
+
+	chat, err := s.repo.Chat(ctx, input.ChatID)
+	if err != nil {
+		return model.Chat{}, sl.Err(op, err)
+	}
+
+	return chat, nil
+}
@@ -0,0 +1,64 @@
+package chatservice
+
+import (
+	"context"
+
+	"github.com/defany/chat-server/app/internal/converter"
+	"github.com/defany/chat-server/app/internal/model"
+	"github.com/defany/chat-server/app/pkg/cursor"
+	"github.com/defany/slogger/pkg/logger/sl"
+)
+
+const (
+	defaultChatsLimit = 20
+	maxChatsLimit     = 100
+)
+
+func (s *service) ListChats(ctx context.Context, input converter.ListChatsInput) (converter.ListChatsOutput, error) {
+	op := sl.FnName()
+
+	filter := model.ChatsFilter{
+		UserID: input.UserID,
+		Limit:  pageLimit(input.Limit, defaultChatsLimit, maxChatsLimit),
+	}
+
+	if input.Cursor != "" {
+		after, err := cursor.Decode[model.ChatCursor](input.Cursor)
+		if err != nil {
+			return converter.ListChatsOutput{}, sl.Err(op, invalidCursor(err))
+		}
+
+		filter.Cursor = &after
+	}
+
+	// Берем на один чат больше, чтобы понять, есть ли следующая страница
+	filter.Limit++
+
+	chats, err := s.repo.ListChats(ctx, filter)
+	if err != nil {
+		return converter.ListChatsOutput{}, sl.Err(op, err)
+	}
+
+	if uint64(len(chats)) < filter.Limit {
+		return converter.ListChatsOutput{
+			Chats: chats,
+		}, nil
+	}
+
+	chats = chats[:len(chats)-1]
+
+	last := chats[len(chats)-1]
+
+	nextCursor, err := cursor.Encode(model.ChatCursor{
+		LastMessageAt: last.LastMessageAt,
+		ID:            last.ID,
+	})
+	if err != nil {
+		return converter.ListChatsOutput{}, sl.Err(op, err)
+	}
+
+	return converter.ListChatsOutput{
+		Chats:      chats,
+		NextCursor: nextCursor,
+	}, nil
+}
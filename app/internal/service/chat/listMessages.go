@@ -0,0 +1,157 @@
+package chatservice
+
+import (
+	"context"
+
+	"github.com/defany/chat-server/app/internal/apperr"
+	"github.com/defany/chat-server/app/internal/converter"
+	"github.com/defany/chat-server/app/internal/model"
+	"github.com/defany/chat-server/app/pkg/cursor"
+	"github.com/defany/slogger/pkg/logger/sl"
+)
+
+const (
+	defaultMessagesLimit = 50
+	maxMessagesLimit     = 100
+)
+
+func (s *service) ListMessages(ctx context.Context, input converter.ListMessagesInput) (converter.ListMessagesOutput, error) {
+	op := sl.FnName()
+
+	if err := s.access.RequireRole(ctx, input.ChatID, input.UserID); err != nil {
+		return converter.ListMessagesOutput{}, sl.Err(op, err)
+	}
+
+	filter := model.MessagesFilter{
+		ChatID:    input.ChatID,
+		Direction: input.Direction,
+	}
+
+	messages, nextCursor, err := s.listPage(ctx, filter, input.Cursor, input.Limit)
+	if err != nil {
+		return converter.ListMessagesOutput{}, sl.Err(op, err)
+	}
+
+	return converter.ListMessagesOutput{
+		Messages:   messages,
+		NextCursor: nextCursor,
+	}, nil
+}
+
+// listPage достает одну страницу сообщений по фильтру и возвращает курсор на следующую
+func (s *service) listPage(ctx context.Context, filter model.MessagesFilter, pageCursor string, limit int32) ([]model.Message, string, error) {
+	filter.Limit = pageLimit(limit, defaultMessagesLimit, maxMessagesLimit)
+
+	if pageCursor != "" {
+		after, err := cursor.Decode[model.MessageCursor](pageCursor)
+		if err != nil {
+			return nil, "", invalidCursor(err)
+		}
+
+		filter.Cursor = &after
+	}
+
+	// Берем на одно сообщение больше, чтобы понять, есть ли следующая страница
+	filter.Limit++
+
+	messages, err := s.repo.ListMessages(ctx, filter)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if uint64(len(messages)) < filter.Limit {
+		return messages, "", s.withDetails(ctx, messages)
+	}
+
+	messages = messages[:len(messages)-1]
+
+	if err := s.withDetails(ctx, messages); err != nil {
+		return nil, "", err
+	}
+
+	last := messages[len(messages)-1]
+
+	nextCursor, err := cursor.Encode(model.MessageCursor{
+		Timestamp: last.Timestamp,
+		ID:        last.ID,
+	})
+	if err != nil {
+		return nil, "", err
+	}
+
+	return messages, nextCursor, nil
+}
+
+// withDetails дописывает к странице все, что хранится отдельно от самих сообщений
+func (s *service) withDetails(ctx context.Context, messages []model.Message) error {
+	if err := s.withReactions(ctx, messages); err != nil {
+		return err
+	}
+
+	return s.withAttachments(ctx, messages)
+}
+
+// withReactions дописывает к сообщениям счетчики реакций одним запросом на всю страницу
+func (s *service) withReactions(ctx context.Context, messages []model.Message) error {
+	if len(messages) == 0 {
+		return nil
+	}
+
+	ids := make([]uint64, 0, len(messages))
+	for _, msg := range messages {
+		ids = append(ids, msg.ID)
+	}
+
+	counts, err := s.reactions.Counts(ctx, ids)
+	if err != nil {
+		return err
+	}
+
+	for i := range messages {
+		messages[i].Reactions = counts[messages[i].ID]
+	}
+
+	return nil
+}
+
+// withAttachments дописывает вложения одним запросом на всю страницу. У удаленных сообщений вложения не показываем
+func (s *service) withAttachments(ctx context.Context, messages []model.Message) error {
+	ids := make([]uint64, 0, len(messages))
+	for _, msg := range messages {
+		if msg.DeletedAt == nil {
+			ids = append(ids, msg.ID)
+		}
+	}
+
+	if len(ids) == 0 {
+		return nil
+	}
+
+	attachments, err := s.attachments.ByMessages(ctx, ids)
+	if err != nil {
+		return err
+	}
+
+	for i := range messages {
+		messages[i].Attachments = attachments[messages[i].ID]
+	}
+
+	return nil
+}
+
+func invalidCursor(err error) error {
+	e := apperr.Wrap(apperr.CodeInvalidArgument, "invalid cursor", err)
+	e.Violations = []apperr.FieldViolation{
+		{Field: "cursor", Description: "cursor must be taken from the previous page"},
+	}
+
+	return e
+}
+
+func pageLimit(limit int32, defaultLimit uint64, maxLimit uint64) uint64 {
+	if limit <= 0 {
+		return defaultLimit
+	}
+
+	return min(uint64(limit), maxLimit)
+}
@@ -0,0 +1,46 @@
+package chatservice
+
+import (
+	"context"
+
+	"github.com/defany/chat-server/app/internal/converter"
+	"github.com/defany/chat-server/app/internal/model"
+	"github.com/defany/slogger/pkg/logger/sl"
+)
+
+func (s *service) ListThread(ctx context.Context, input converter.ListThreadInput) (converter.ListThreadOutput, error) {
+	op := sl.FnName()
+
+	if err := s.access.RequireRole(ctx, input.ChatID, input.UserID); err != nil {
+		return converter.ListThreadOutput{}, sl.Err(op, err)
+	}
+
+	root, err := s.repo.Message(ctx, input.ChatID, input.MessageID)
+	if err != nil {
+		return converter.ListThreadOutput{}, sl.Err(op, err)
+	}
+
+	rootPage := []model.Message{root}
+	if err := s.withDetails(ctx, rootPage); err != nil {
+		return converter.ListThreadOutput{}, sl.Err(op, err)
+	}
+
+	root = rootPage[0]
+
+	filter := model.MessagesFilter{
+		ChatID:    input.ChatID,
+		ReplyTo:   &root.ID,
+		Direction: input.Direction,
+	}
+
+	replies, nextCursor, err := s.listPage(ctx, filter, input.Cursor, input.Limit)
+	if err != nil {
+		return converter.ListThreadOutput{}, sl.Err(op, err)
+	}
+
+	return converter.ListThreadOutput{
+		Root:       root,
+		Replies:    replies,
+		NextCursor: nextCursor,
+	}, nil
+}
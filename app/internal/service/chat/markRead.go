@@ -0,0 +1,27 @@
+package chatservice
+
+import (
+	"context"
+
+	"github.com/defany/chat-server/app/internal/converter"
+	"github.com/defany/slogger/pkg/logger/sl"
+)
+
+func (s *service) MarkRead(ctx context.Context, input converter.MarkReadInput) error {
+	op := sl.FnName()
+
+	if err := s.access.RequireRole(ctx, input.ChatID, input.UserID); err != nil {
+		return sl.Err(op, err)
+	}
+
+	// Проверяем, что сообщение из этого чата, иначе можно "прочитать" чат чужим id из будущего
+	if _, err := s.repo.Message(ctx, input.ChatID, input.MessageID); err != nil {
+		return sl.Err(op, err)
+	}
+
+	if err := s.repo.MarkRead(ctx, input.ChatID, input.UserID, input.MessageID); err != nil {
+		return sl.Err(op, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,319 @@
+package chatservice
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/defany/chat-server/app/internal/apperr"
+	"github.com/defany/chat-server/app/internal/client"
+	"github.com/defany/chat-server/app/internal/converter"
+	"github.com/defany/chat-server/app/internal/model"
+	"github.com/defany/slogger/pkg/logger/sl"
+)
+
+func (s *service) AddMembers(ctx context.Context, input converter.AddMembersInput) error {
+	op := sl.FnName()
+
+	memberIDs, err := s.users.IDs(ctx, input.Nicknames)
+	if err != nil {
+		if errors.Is(err, client.ErrUserNotFound) {
+			err = apperr.Wrap(apperr.CodeNotFound, err.Error(), err)
+		}
+
+		return sl.Err(op, err)
+	}
+
+	// Первым идет тот, кто добавляет, он уже в чате и в список новых участников не попадает
+	members := chatMembers(input.UserID, memberIDs)[1:]
+	if len(members) == 0 {
+		return sl.Err(op, apperr.InvalidArgument("no new members to add"))
+	}
+
+	ids := make([]uint64, 0, len(members)+1)
+	ids = append(ids, input.UserID)
+
+	for _, member := range members {
+		ids = append(ids, member.UserID)
+	}
+
+	names, err := s.displayNames(ctx, ids)
+	if err != nil {
+		return sl.Err(op, err)
+	}
+
+	var msg model.Message
+
+	err = s.tx.ReadCommitted(ctx, func(ctx context.Context) error {
+		err := s.access.RequireRole(ctx, input.ChatID, input.UserID, model.RoleOwner, model.RoleAdmin)
+		if err != nil {
+			return err
+		}
+
+		chat, err := s.repo.Chat(ctx, input.ChatID)
+		if err != nil {
+			return err
+		}
+
+		if chat.Kind == model.ChatKindDirect {
+			return apperr.FailedPrecondition("members can not be added to direct chat, create a group instead")
+		}
+
+		err = s.repo.AddMembers(ctx, uint64(input.ChatID), members)
+		if err != nil {
+			return err
+		}
+
+		msg, err = s.repo.SendSystemMessage(ctx, input.ChatID, input.UserID, fmt.Sprintf("%s added %s", names[0], strings.Join(names[1:], ", ")))
+		if err != nil {
+			return err
+		}
+
+		err = s.log.Log(ctx, model.Log{
+			Action: model.LogAddMembers,
+			UserID: input.UserID,
+		})
+		if err != nil {
+			return err
+		}
+
+		return nil
+	})
+	if err != nil {
+		return sl.Err(op, err)
+	}
+
+	s.hub.Publish(msg)
+
+	return nil
+}
+
+func (s *service) RemoveMember(ctx context.Context, input converter.RemoveMemberInput) error {
+	op := sl.FnName()
+
+	if input.MemberID == input.UserID {
+		return sl.Err(op, apperr.InvalidArgument("use LeaveChat to leave the chat"))
+	}
+
+	names, err := s.displayNames(ctx, []uint64{input.UserID, input.MemberID})
+	if err != nil {
+		return sl.Err(op, err)
+	}
+
+	var msg model.Message
+
+	err = s.tx.ReadCommitted(ctx, func(ctx context.Context) error {
+		role, err := s.access.MemberRole(ctx, input.ChatID, input.UserID, model.RoleOwner, model.RoleAdmin)
+		if err != nil {
+			return err
+		}
+
+		memberRole, err := s.repo.MemberRole(ctx, input.ChatID, input.MemberID)
+		if err != nil {
+			return err
+		}
+
+		switch {
+		case memberRole == "":
+			return apperr.NotFound("chat member not found")
+		case memberRole == model.RoleOwner:
+			return apperr.PermissionDenied("chat owner can not be removed")
+		case role == model.RoleAdmin && memberRole != model.RoleMember:
+			return apperr.PermissionDenied("admins can remove only regular members")
+		}
+
+		err = s.repo.RemoveMember(ctx, input.ChatID, input.MemberID)
+		if err != nil {
+			return err
+		}
+
+		msg, err = s.repo.SendSystemMessage(ctx, input.ChatID, input.UserID, fmt.Sprintf("%s removed %s", names[0], names[1]))
+		if err != nil {
+			return err
+		}
+
+		err = s.log.Log(ctx, model.Log{
+			Action: model.LogRemoveMember,
+			UserID: input.UserID,
+		})
+		if err != nil {
+			return err
+		}
+
+		return nil
+	})
+	if err != nil {
+		return sl.Err(op, err)
+	}
+
+	s.publishRemoval(msg, input.MemberID)
+
+	return nil
+}
+
+// SetMemberAdmin назначать и снимать админов может только владелец, сам владелец свою роль не меняет
+func (s *service) SetMemberAdmin(ctx context.Context, input converter.SetMemberAdminInput) error {
+	op := sl.FnName()
+
+	names, err := s.displayNames(ctx, []uint64{input.UserID, input.MemberID})
+	if err != nil {
+		return sl.Err(op, err)
+	}
+
+	role, text := model.RoleMember, fmt.Sprintf("%s removed %s from admins", names[0], names[1])
+	if input.Admin {
+		role, text = model.RoleAdmin, fmt.Sprintf("%s made %s an admin", names[0], names[1])
+	}
+
+	var msg model.Message
+
+	err = s.tx.ReadCommitted(ctx, func(ctx context.Context) error {
+		err := s.access.RequireRole(ctx, input.ChatID, input.UserID, model.RoleOwner)
+		if err != nil {
+			return err
+		}
+
+		memberRole, err := s.repo.MemberRole(ctx, input.ChatID, input.MemberID)
+		if err != nil {
+			return err
+		}
+
+		switch memberRole {
+		case "":
+			return apperr.NotFound("chat member not found")
+		case model.RoleOwner:
+			return apperr.FailedPrecondition("chat owner role can not be changed")
+		case role:
+			return nil
+		}
+
+		err = s.repo.SetMemberRole(ctx, input.ChatID, input.MemberID, role)
+		if err != nil {
+			return err
+		}
+
+		msg, err = s.repo.SendSystemMessage(ctx, input.ChatID, input.UserID, text)
+		if err != nil {
+			return err
+		}
+
+		err = s.log.Log(ctx, model.Log{
+			Action: model.LogSetMemberRole,
+			UserID: input.UserID,
+		})
+		if err != nil {
+			return err
+		}
+
+		return nil
+	})
+	if err != nil {
+		return sl.Err(op, err)
+	}
+
+	// Роль не поменялась, сообщать не о чем
+	if msg.ID != 0 {
+		s.hub.Publish(msg)
+	}
+
+	return nil
+}
+
+func (s *service) LeaveChat(ctx context.Context, input converter.LeaveChatInput) error {
+	op := sl.FnName()
+
+	names, err := s.displayNames(ctx, []uint64{input.UserID})
+	if err != nil {
+		return sl.Err(op, err)
+	}
+
+	var (
+		msg  model.Message
+		chat model.Chat
+	)
+
+	err = s.tx.ReadCommitted(ctx, func(ctx context.Context) error {
+		role, err := s.access.MemberRole(ctx, input.ChatID, input.UserID)
+		if err != nil {
+			return err
+		}
+
+		if role == model.RoleOwner {
+			return apperr.FailedPrecondition("owner can not leave the chat, delete it instead")
+		}
+
+		chat, err = s.repo.Chat(ctx, input.ChatID)
+		if err != nil {
+			return err
+		}
+
+		err = s.repo.RemoveMember(ctx, input.ChatID, input.UserID)
+		if err != nil {
+			return err
+		}
+
+		// Из канала отписываются молча, иначе тысячи подписчиков завалят его служебными сообщениями
+		if chat.Kind != model.ChatKindChannel {
+			msg, err = s.repo.SendSystemMessage(ctx, input.ChatID, input.UserID, fmt.Sprintf("%s left the chat", names[0]))
+			if err != nil {
+				return err
+			}
+		}
+
+		err = s.log.Log(ctx, model.Log{
+			Action: model.LogLeaveChat,
+			UserID: input.UserID,
+		})
+		if err != nil {
+			return err
+		}
+
+		return nil
+	})
+	if err != nil {
+		return sl.Err(op, err)
+	}
+
+	if chat.Kind == model.ChatKindChannel {
+		s.dropSubscriptions(input.ChatID, input.UserID)
+
+		return nil
+	}
+
+	s.publishRemoval(msg, input.UserID)
+
+	return nil
+}
+
+// publishRemoval сначала рассылает служебное сообщение, чтобы его успел получить и сам ушедший, и только потом закрывает его стримы
+func (s *service) publishRemoval(msg model.Message, userID uint64) {
+	s.hub.Publish(msg)
+	s.dropSubscriptions(msg.ChatID, userID)
+}
+
+func (s *service) dropSubscriptions(chatID int64, userID uint64) {
+	s.hub.RemoveMember(chatID, userID)
+	s.presence.RemoveMember(chatID, userID)
+}
+
+// displayNames отдает имена для служебных сообщений в том же порядке, неизвестных пользователей показываем по id
+func (s *service) displayNames(ctx context.Context, ids []uint64) ([]string, error) {
+	usernames, err := s.users.Usernames(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0, len(ids))
+
+	for _, id := range ids {
+		name, ok := usernames[id]
+		if !ok {
+			name = fmt.Sprintf("user %d", id)
+		}
+
+		names = append(names, name)
+	}
+
+	return names, nil
+}
@@ -0,0 +1,91 @@
+package chatservice
+
+import (
+	"context"
+	"slices"
+
+	"github.com/defany/chat-server/app/internal/apperr"
+	"github.com/defany/chat-server/app/internal/content"
+	"github.com/defany/chat-server/app/internal/converter"
+	"github.com/defany/chat-server/app/internal/model"
+	"github.com/defany/chat-server/app/pkg/cursor"
+	"github.com/defany/slogger/pkg/logger/sl"
+)
+
+const (
+	defaultMentionsLimit = 20
+	maxMentionsLimit     = 100
+)
+
+func (s *service) ListMentions(ctx context.Context, input converter.ListMentionsInput) (converter.ListMentionsOutput, error) {
+	op := sl.FnName()
+
+	limit := pageLimit(input.Limit, defaultMentionsLimit, maxMentionsLimit)
+
+	filter := model.MentionsFilter{
+		UserID: input.UserID,
+		Limit:  limit + 1,
+	}
+
+	if input.Cursor != "" {
+		after, err := cursor.Decode[model.MentionCursor](input.Cursor)
+		if err != nil {
+			return converter.ListMentionsOutput{}, sl.Err(op, invalidCursor(err))
+		}
+
+		filter.Cursor = &after
+	}
+
+	messages, err := s.repo.ListMentions(ctx, filter)
+	if err != nil {
+		return converter.ListMentionsOutput{}, sl.Err(op, err)
+	}
+
+	var nextCursor string
+
+	if uint64(len(messages)) > limit {
+		messages = messages[:limit]
+
+		nextCursor, err = cursor.Encode(model.MentionCursor{
+			MessageID: messages[len(messages)-1].ID,
+		})
+		if err != nil {
+			return converter.ListMentionsOutput{}, sl.Err(op, err)
+		}
+	}
+
+	if err := s.withDetails(ctx, messages); err != nil {
+		return converter.ListMentionsOutput{}, sl.Err(op, err)
+	}
+
+	return converter.ListMentionsOutput{
+		Messages:   messages,
+		NextCursor: nextCursor,
+	}, nil
+}
+
+// setMentions переписывает упоминания по разметке сообщения и возвращает упомянутых. Себя не упоминаем, а посторонних упомянуть нельзя.
+// previous разметка до правки: если упоминаний не было ни до, ни после, в базу не ходим
+func (s *service) setMentions(ctx context.Context, msg model.Message, previous []model.MessageEntity) ([]uint64, error) {
+	userIDs := slices.DeleteFunc(content.Mentions(msg.Entities), func(userID uint64) bool {
+		return userID == msg.UserID
+	})
+
+	if len(userIDs) == 0 && len(content.Mentions(previous)) == 0 {
+		return nil, nil
+	}
+
+	mentioned, err := s.repo.SetMentions(ctx, msg.ID, msg.ChatID, userIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(mentioned) != len(userIDs) {
+		return nil, apperr.InvalidArgument("mentioned user is not a chat member", apperr.FieldViolation{
+			Field:       "content.entities",
+			Description: "only chat members can be mentioned",
+		})
+	}
+
+	return userIDs, nil
+}
@@ -0,0 +1,23 @@
+package chatservice
+
+import (
+	"context"
+
+	"github.com/defany/chat-server/app/internal/converter"
+	"github.com/defany/slogger/pkg/logger/sl"
+)
+
+// MuteChat влияет только на уведомления, сообщения в поток чата приходят как обычно
+func (s *service) MuteChat(ctx context.Context, input converter.MuteChatInput) error {
+	op := sl.FnName()
+
+	if err := s.access.RequireRole(ctx, input.ChatID, input.UserID); err != nil {
+		return sl.Err(op, err)
+	}
+
+	if err := s.repo.SetMuted(ctx, input.ChatID, input.UserID, input.Muted); err != nil {
+		return sl.Err(op, err)
+	}
+
+	return nil
+}
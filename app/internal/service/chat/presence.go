@@ -0,0 +1,94 @@
+package chatservice
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	"github.com/defany/chat-server/app/internal/apperr"
+	"github.com/defany/chat-server/app/internal/converter"
+	"github.com/defany/chat-server/app/internal/model"
+	"github.com/defany/chat-server/app/internal/service/presence"
+	"github.com/defany/slogger/pkg/logger/sl"
+)
+
+func (s *service) Presence(ctx context.Context, userID uint64, recv func() (converter.PresenceInput, error), send func(event model.PresenceEvent) error) error {
+	op := sl.FnName()
+
+	watcher := s.presence.Watch(userID)
+	defer s.presence.Unwatch(watcher)
+
+	inputs := make(chan converter.PresenceInput)
+	recvErr := make(chan error, 1)
+
+	go func() {
+		for {
+			input, err := recv()
+			if err != nil {
+				recvErr <- err
+
+				return
+			}
+
+			select {
+			case inputs <- input:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case err := <-recvErr:
+			if errors.Is(err, io.EOF) || ctx.Err() != nil {
+				return nil
+			}
+
+			return sl.Err(op, err)
+		case input := <-inputs:
+			if err := s.handlePresence(ctx, watcher, userID, input); err != nil {
+				return sl.Err(op, err)
+			}
+		case event, ok := <-watcher.Events():
+			if !ok {
+				if err := watcher.Err(); err != nil {
+					return sl.Err(op, err)
+				}
+
+				return nil
+			}
+
+			if err := send(event); err != nil {
+				return sl.Err(op, err)
+			}
+		}
+	}
+}
+
+func (s *service) handlePresence(ctx context.Context, watcher *presence.Watcher, userID uint64, input converter.PresenceInput) error {
+	switch input.Action {
+	case model.PresenceActionJoin:
+		// Та же проверка, что и при отправке сообщения, чтобы посторонние не могли подглядывать
+		if err := s.access.RequireRole(ctx, input.ChatID, userID); err != nil {
+			return err
+		}
+
+		s.presence.Join(watcher, input.ChatID)
+	case model.PresenceActionLeave:
+		s.presence.Leave(watcher, input.ChatID)
+	case model.PresenceActionTypingStart, model.PresenceActionTypingStop:
+		err := s.presence.Typing(watcher, input.ChatID, input.Action == model.PresenceActionTypingStart)
+		if errors.Is(err, presence.ErrNotJoined) {
+			return apperr.FailedPrecondition("join chat presence before sending typing events")
+		}
+
+		return err
+	default:
+		return apperr.InvalidArgument("unknown presence action")
+	}
+
+	return nil
+}
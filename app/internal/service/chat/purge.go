@@ -0,0 +1,56 @@
+package chatservice
+
+import (
+	"context"
+
+	"github.com/defany/slogger/pkg/logger/sl"
+)
+
+const defaultPurgeBatchSize = 100
+
+// PurgeDeletedChats окончательно удаляет чаты, у которых истек срок восстановления, и возвращает их количество.
+// Файлы вложений уходят в очередь на удаление, их убирает purger вложений
+func (s *service) PurgeDeletedChats(ctx context.Context) (int64, error) {
+	op := sl.FnName()
+
+	batchSize := s.cfg.PurgeBatchSize
+	if batchSize == 0 {
+		batchSize = defaultPurgeBatchSize
+	}
+
+	var total int64
+
+	for {
+		var expired []int64
+
+		err := s.tx.ReadCommitted(ctx, func(ctx context.Context) error {
+			var err error
+
+			expired, err = s.repo.ExpiredDeleted(ctx, s.cfg.DeletedRetention, batchSize)
+			if err != nil {
+				return err
+			}
+
+			if len(expired) == 0 {
+				return nil
+			}
+
+			// Каскад снесет строки вложений, поэтому ключи файлов запоминаем до удаления чатов
+			err = s.attachments.OrphanChats(ctx, expired)
+			if err != nil {
+				return err
+			}
+
+			return s.repo.PurgeDeleted(ctx, expired)
+		})
+		if err != nil {
+			return total, sl.Err(op, err)
+		}
+
+		total += int64(len(expired))
+
+		if uint64(len(expired)) < batchSize {
+			return total, nil
+		}
+	}
+}
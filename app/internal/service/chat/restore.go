@@ -0,0 +1,50 @@
+package chatservice
+
+import (
+	"context"
+
+	"github.com/defany/chat-server/app/internal/apperr"
+	"github.com/defany/chat-server/app/internal/converter"
+	"github.com/defany/chat-server/app/internal/model"
+	"github.com/defany/slogger/pkg/logger/sl"
+)
+
+func (s *service) RestoreChat(ctx context.Context, input converter.RestoreChatInput) error {
+	op := sl.FnName()
+
+	err := s.tx.ReadCommitted(ctx, func(ctx context.Context) error {
+		role, err := s.repo.DeletedMemberRole(ctx, input.ChatID, input.UserID)
+		if err != nil {
+			return err
+		}
+
+		// Для чужих удаленных чатов не говорим, что они вообще существуют
+		if role == "" {
+			return apperr.NotFound("deleted chat not found")
+		}
+
+		if role != model.RoleOwner {
+			return apperr.PermissionDenied("only chat owner can restore chat")
+		}
+
+		err = s.repo.Restore(ctx, input.ChatID, s.cfg.DeletedRetention)
+		if err != nil {
+			return err
+		}
+
+		err = s.log.Log(ctx, model.Log{
+			Action: model.LogRestoreChat,
+			UserID: input.UserID,
+		})
+		if err != nil {
+			return err
+		}
+
+		return nil
+	})
+	if err != nil {
+		return sl.Err(op, err)
+	}
+
+	return nil
+}
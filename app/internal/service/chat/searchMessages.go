@@ -0,0 +1,74 @@
+package chatservice
+
+import (
+	"context"
+
+	"github.com/defany/chat-server/app/internal/converter"
+	"github.com/defany/chat-server/app/internal/model"
+	"github.com/defany/chat-server/app/pkg/cursor"
+	"github.com/defany/slogger/pkg/logger/sl"
+)
+
+const (
+	defaultSearchLimit = 20
+	maxSearchLimit     = 100
+)
+
+func (s *service) SearchMessages(ctx context.Context, input converter.SearchMessagesInput) (converter.SearchMessagesOutput, error) {
+	op := sl.FnName()
+
+	// Поиск по всем чатам и так ограничен чатами пользователя, а про чужой чат лучше честно ответить отказом, чем пустой выдачей
+	if input.ChatID != 0 {
+		err := s.access.RequireRole(ctx, input.ChatID, input.UserID)
+		if err != nil {
+			return converter.SearchMessagesOutput{}, sl.Err(op, err)
+		}
+	}
+
+	filter := model.SearchFilter{
+		UserID: input.UserID,
+		ChatID: input.ChatID,
+		Query:  input.Query,
+		Limit:  pageLimit(input.Limit, defaultSearchLimit, maxSearchLimit),
+	}
+
+	if input.Cursor != "" {
+		after, err := cursor.Decode[model.SearchCursor](input.Cursor)
+		if err != nil {
+			return converter.SearchMessagesOutput{}, sl.Err(op, invalidCursor(err))
+		}
+
+		filter.Cursor = &after
+	}
+
+	// Берем на одно сообщение больше, чтобы понять, есть ли следующая страница
+	filter.Limit++
+
+	hits, err := s.repo.SearchMessages(ctx, filter)
+	if err != nil {
+		return converter.SearchMessagesOutput{}, sl.Err(op, err)
+	}
+
+	if uint64(len(hits)) < filter.Limit {
+		return converter.SearchMessagesOutput{
+			Hits: hits,
+		}, nil
+	}
+
+	hits = hits[:len(hits)-1]
+
+	last := hits[len(hits)-1]
+
+	nextCursor, err := cursor.Encode(model.SearchCursor{
+		Rank: last.Rank,
+		ID:   last.Message.ID,
+	})
+	if err != nil {
+		return converter.SearchMessagesOutput{}, sl.Err(op, err)
+	}
+
+	return converter.SearchMessagesOutput{
+		Hits:       hits,
+		NextCursor: nextCursor,
+	}, nil
+}
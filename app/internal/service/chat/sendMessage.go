@@ -2,17 +2,93 @@ package chatservice
 
 import (
 	"context"
+	"errors"
 
+	"github.com/defany/chat-server/app/internal/apperr"
+	"github.com/defany/chat-server/app/internal/content"
 	"github.com/defany/chat-server/app/internal/converter"
 	"github.com/defany/chat-server/app/internal/model"
 	"github.com/defany/slogger/pkg/logger/sl"
 )
 
-func (s *service) SendMessage(ctx context.Context, input converter.SendMessageInput) error {
+func (s *service) SendMessage(ctx context.Context, input converter.SendMessageInput) (model.Message, error) {
 	op := sl.FnName()
 
-	err := s.tx.ReadCommitted(ctx, func(ctx context.Context) error {
-		err := s.repo.SendMessage(ctx, input)
+	// Текст обязателен только у сообщения без вложений
+	if input.Text == "" && len(input.AttachmentIDs) == 0 {
+		return model.Message{}, sl.Err(op, apperr.InvalidArgument("message must have text or attachments", apperr.FieldViolation{
+			Field:       "text",
+			Description: "text is required when there are no attachments",
+		}))
+	}
+
+	entities, err := content.Parse(input.Text, input.Entities)
+	if err != nil {
+		return model.Message{}, sl.Err(op, err)
+	}
+
+	input.Entities = entities
+
+	var (
+		msg      model.Message
+		replayed bool
+	)
+
+	err = s.tx.ReadCommitted(ctx, func(ctx context.Context) error {
+		err := s.access.RequirePoster(ctx, input.ChatID, input.From)
+		if err != nil {
+			return err
+		}
+
+		msg, err = s.repo.SendMessage(ctx, input)
+		// Клиент повторил запрос с тем же ключом - отдаем уже сохраненное сообщение
+		if errors.Is(err, apperr.ErrAlreadyExists) && input.ClientMessageID != "" {
+			replayed = true
+
+			msg, err = s.repo.MessageByClientID(ctx, input.ChatID, input.From, input.ClientMessageID)
+			if err != nil {
+				return err
+			}
+
+			if len(input.AttachmentIDs) == 0 {
+				return nil
+			}
+
+			attachments, err := s.attachments.ByMessages(ctx, []uint64{msg.ID})
+			if err != nil {
+				return err
+			}
+
+			msg.Attachments = attachments[msg.ID]
+
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		if len(input.AttachmentIDs) != 0 {
+			msg.Attachments, err = s.attachments.Attach(ctx, msg.ID, input.ChatID, input.From, input.AttachmentIDs)
+			if err != nil {
+				return err
+			}
+
+			// Чужие, уже отправленные или загруженные в другой чат вложения не привязались, откатываем все сообщение
+			if len(msg.Attachments) != len(input.AttachmentIDs) {
+				return apperr.InvalidArgument("attachment not found or already attached", apperr.FieldViolation{
+					Field:       "attachment_ids",
+					Description: "attachments must be uploaded by the sender to this chat and not sent yet",
+				})
+			}
+		}
+
+		mentioned, err := s.setMentions(ctx, msg, nil)
+		if err != nil {
+			return err
+		}
+
+		// Уведомления пишутся в той же транзакции, что и сообщение: откатилось одно - откатилось и другое
+		err = s.notifications.Enqueue(ctx, msg, mentioned)
 		if err != nil {
 			return err
 		}
@@ -28,8 +104,13 @@ func (s *service) SendMessage(ctx context.Context, input converter.SendMessageIn
 		return nil
 	})
 	if err != nil {
-		return sl.Err(op, err)
+		return model.Message{}, sl.Err(op, err)
+	}
+
+	// Повтор уже был разослан подписчикам при первой отправке
+	if !replayed {
+		s.hub.Publish(msg)
 	}
 
-	return nil
+	return msg, nil
 }
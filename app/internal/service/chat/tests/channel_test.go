@@ -0,0 +1,211 @@
+package usertests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/brianvoe/gofakeit"
+	"github.com/defany/chat-server/app/internal/apperr"
+	"github.com/defany/chat-server/app/internal/config"
+	"github.com/defany/chat-server/app/internal/converter"
+	"github.com/defany/chat-server/app/internal/model"
+	mockrepository "github.com/defany/chat-server/app/internal/repository/mocks"
+	servicedef "github.com/defany/chat-server/app/internal/service"
+	chatservice "github.com/defany/chat-server/app/internal/service/chat"
+	"github.com/defany/chat-server/app/internal/service/hub"
+	"github.com/defany/db/pkg/postgres"
+	mockpostgres "github.com/defany/db/pkg/postgres/mocks"
+	"github.com/defany/slogger/pkg/logger/sl"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/require"
+)
+
+type channelMocker struct {
+	chat          *mockrepository.MockChat
+	notifications *mockrepository.MockNotification
+	log           *mockrepository.MockLog
+}
+
+func newChannelService(t *testing.T, commit bool, mocker func(txCtx context.Context, m channelMocker)) (context.Context, servicedef.Chat) {
+	t.Helper()
+
+	ctx := context.Background()
+
+	tx := mockpostgres.NewMockTx(t)
+
+	txCtx := postgres.InjectTX(ctx, tx)
+
+	if commit {
+		tx.On("Commit", txCtx).Return(nil)
+	} else {
+		tx.On("Rollback", txCtx).Return(nil)
+	}
+
+	db := mockpostgres.NewMockPostgres(t)
+	db.On("BeginTx", ctx, pgx.TxOptions{IsoLevel: pgx.ReadCommitted}).Return(tx, nil)
+
+	m := channelMocker{
+		chat:          mockrepository.NewMockChat(t),
+		notifications: mockrepository.NewMockNotification(t),
+		log:           mockrepository.NewMockLog(t),
+	}
+
+	mocker(txCtx, m)
+
+	return ctx, chatservice.NewService(postgres.NewTxManager(db), m.chat, nil, nil, m.notifications, m.log, nil, hub.New(), nil, config.Chat{})
+}
+
+func TestService_CreateChannel(t *testing.T) {
+	var (
+		input = converter.CreateChannelInput{
+			Title:       gofakeit.JobTitle(),
+			Description: gofakeit.Sentence(5),
+			UserID:      gofakeit.Uint64(),
+		}
+
+		chatID = gofakeit.Uint64()
+	)
+
+	ctx, service := newChannelService(t, true, func(txCtx context.Context, m channelMocker) {
+		m.chat.On("Create", txCtx, model.Chat{
+			Kind:        model.ChatKindChannel,
+			Title:       input.Title,
+			Description: input.Description,
+		}).Return(chatID, nil)
+		m.chat.On("AddMembers", txCtx, chatID, []model.ChatMember{
+			{UserID: input.UserID, Role: model.RoleOwner},
+		}).Return(nil)
+		m.log.On("Log", txCtx, model.Log{Action: model.LogCreateChat, UserID: input.UserID}).Return(nil)
+	})
+
+	output, err := service.CreateChannel(ctx, input)
+
+	require.NoError(t, err)
+	require.Equal(t, converter.CreateChatOutput{ID: chatID}, output)
+}
+
+func TestService_Subscribe(t *testing.T) {
+	var (
+		input = converter.SubscribeInput{
+			ChatID: gofakeit.Int64(),
+			UserID: gofakeit.Uint64(),
+		}
+
+		channel = model.Chat{
+			ID:   input.ChatID,
+			Kind: model.ChatKindChannel,
+		}
+
+		subscriber = []model.ChatMember{
+			{UserID: input.UserID, Role: model.RoleMember},
+		}
+
+		alreadyMemberErr = apperr.AlreadyExists("user is already a chat member")
+	)
+
+	tests := []struct {
+		name   string
+		commit bool
+		err    error
+		mocker func(txCtx context.Context, m channelMocker)
+	}{
+		{
+			name:   "user subscribes to channel",
+			commit: true,
+			mocker: func(txCtx context.Context, m channelMocker) {
+				m.chat.On("Chat", txCtx, input.ChatID).Return(channel, nil)
+				m.chat.On("AddMembers", txCtx, uint64(input.ChatID), subscriber).Return(nil)
+				m.log.On("Log", txCtx, model.Log{Action: model.LogSubscribe, UserID: input.UserID}).Return(nil)
+			},
+		},
+		{
+			name: "group can not be subscribed to",
+			err:  sl.Err("service.Subscribe", apperr.FailedPrecondition("only channels can be subscribed to")),
+			mocker: func(txCtx context.Context, m channelMocker) {
+				group := channel
+				group.Kind = model.ChatKindGroup
+
+				m.chat.On("Chat", txCtx, input.ChatID).Return(group, nil)
+			},
+		},
+		{
+			name: "user is already subscribed",
+			err:  sl.Err("service.Subscribe", alreadyMemberErr),
+			mocker: func(txCtx context.Context, m channelMocker) {
+				m.chat.On("Chat", txCtx, input.ChatID).Return(channel, nil)
+				m.chat.On("AddMembers", txCtx, uint64(input.ChatID), subscriber).Return(alreadyMemberErr)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, service := newChannelService(t, tt.commit, tt.mocker)
+
+			err := service.Subscribe(ctx, input)
+
+			require.Equal(t, tt.err, err)
+		})
+	}
+}
+
+func TestService_SendMessageToChannel(t *testing.T) {
+	var (
+		input = converter.SendMessageInput{
+			ChatID: gofakeit.Int64(),
+			From:   gofakeit.Uint64(),
+			Text:   gofakeit.Sentence(5),
+		}
+
+		channel = model.Chat{
+			ID:   input.ChatID,
+			Kind: model.ChatKindChannel,
+		}
+
+		stored = model.Message{
+			ID:     gofakeit.Uint64(),
+			ChatID: input.ChatID,
+			UserID: input.From,
+			Text:   input.Text,
+		}
+	)
+
+	tests := []struct {
+		name   string
+		commit bool
+		want   model.Message
+		err    error
+		mocker func(txCtx context.Context, m channelMocker)
+	}{
+		{
+			name:   "admin posts to channel",
+			commit: true,
+			want:   stored,
+			mocker: func(txCtx context.Context, m channelMocker) {
+				m.chat.On("MemberRole", txCtx, input.ChatID, input.From).Return(model.RoleAdmin, nil)
+				m.chat.On("SendMessage", txCtx, input).Return(stored, nil)
+				m.notifications.On("Enqueue", txCtx, stored, []uint64(nil)).Return(nil)
+				m.log.On("Log", txCtx, model.Log{Action: model.LogSendMessage, UserID: input.From}).Return(nil)
+			},
+		},
+		{
+			name: "subscriber can not post to channel",
+			err:  sl.Err("service.SendMessage", apperr.PermissionDenied("only channel admins can post")),
+			mocker: func(txCtx context.Context, m channelMocker) {
+				m.chat.On("MemberRole", txCtx, input.ChatID, input.From).Return(model.RoleMember, nil)
+				m.chat.On("Chat", txCtx, input.ChatID).Return(channel, nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, service := newChannelService(t, tt.commit, tt.mocker)
+
+			msg, err := service.SendMessage(ctx, input)
+
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.want, msg)
+		})
+	}
+}
@@ -0,0 +1,135 @@
+package usertests
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit"
+	"github.com/defany/chat-server/app/internal/apperr"
+	"github.com/defany/chat-server/app/internal/config"
+	"github.com/defany/chat-server/app/internal/converter"
+	"github.com/defany/chat-server/app/internal/model"
+	mockrepository "github.com/defany/chat-server/app/internal/repository/mocks"
+	chatservice "github.com/defany/chat-server/app/internal/service/chat"
+	"github.com/defany/chat-server/app/internal/service/hub"
+	"github.com/defany/slogger/pkg/logger/sl"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService_ConnectChat(t *testing.T) {
+	type args struct {
+		input converter.ConnectChatInput
+		send  func(msg model.Message) error
+	}
+
+	var (
+		chatID = gofakeit.Int64()
+
+		msg = model.Message{
+			ChatID:    chatID,
+			UserID:    gofakeit.Uint64(),
+			Text:      gofakeit.JobTitle(),
+			Timestamp: gofakeit.Date(),
+		}
+
+		input = converter.ConnectChatInput{
+			ChatID: chatID,
+			UserID: gofakeit.Uint64(),
+		}
+
+		sendErr = errors.New("stream closed")
+	)
+
+	tests := []struct {
+		name  string
+		args  args
+		role  model.Role
+		err   error
+		event func(h *hub.Hub)
+	}{
+		{
+			name: "stream ends when chat is deleted",
+			args: args{
+				input: input,
+				send: func(msg model.Message) error {
+					return nil
+				},
+			},
+			role: model.RoleMember,
+			err:  nil,
+			event: func(h *hub.Hub) {
+				h.CloseChat(chatID)
+			},
+		},
+		{
+			name: "published message is sent to subscriber",
+			args: args{
+				input: input,
+				send: func(got model.Message) error {
+					if !reflect.DeepEqual(got, msg) {
+						return errors.New("unexpected message")
+					}
+
+					return sendErr
+				},
+			},
+			role: model.RoleMember,
+			err:  sl.Err("service.ConnectChat", sendErr),
+			event: func(h *hub.Hub) {
+				h.Publish(msg)
+			},
+		},
+		{
+			name: "failed to connect because user is not a chat member",
+			args: args{
+				input: input,
+				send: func(msg model.Message) error {
+					return nil
+				},
+			},
+			role:  "",
+			err:   sl.Err("service.ConnectChat", apperr.PermissionDenied("user is not a member of the chat")),
+			event: func(h *hub.Hub) {},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := hub.New()
+
+			chatRepo := mockrepository.NewMockChat(t)
+			chatRepo.On("MemberRole", mock.Anything, tt.args.input.ChatID, tt.args.input.UserID).Return(tt.role, nil)
+
+			service := chatservice.NewService(nil, chatRepo, nil, nil, nil, nil, nil, h, nil, config.Chat{})
+
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+
+			done := make(chan error, 1)
+
+			go func() {
+				done <- service.ConnectChat(ctx, tt.args.input, tt.args.send)
+			}()
+
+			// Подписка происходит асинхронно, поэтому повторяем событие, пока стрим не завершится
+			ticker := time.NewTicker(10 * time.Millisecond)
+			defer ticker.Stop()
+
+			for {
+				select {
+				case err := <-done:
+					require.Equal(t, tt.err, err)
+					require.NoError(t, ctx.Err())
+
+					return
+				case <-ticker.C:
+					tt.event(h)
+				}
+			}
+		})
+	}
+}
@@ -6,11 +6,16 @@ import (
 	"testing"
 
 	"github.com/brianvoe/gofakeit"
+	"github.com/defany/chat-server/app/internal/apperr"
+	"github.com/defany/chat-server/app/internal/client"
+	mockclient "github.com/defany/chat-server/app/internal/client/mocks"
+	"github.com/defany/chat-server/app/internal/config"
 	"github.com/defany/chat-server/app/internal/converter"
 	"github.com/defany/chat-server/app/internal/model"
 	"github.com/defany/chat-server/app/internal/repository"
 	mockrepository "github.com/defany/chat-server/app/internal/repository/mocks"
 	chatservice "github.com/defany/chat-server/app/internal/service/chat"
+	"github.com/defany/chat-server/app/internal/service/hub"
 	chatv1 "github.com/defany/chat-server/app/pkg/gen/chat/v1"
 	"github.com/defany/db/pkg/postgres"
 	mockpostgres "github.com/defany/db/pkg/postgres/mocks"
@@ -31,6 +36,7 @@ func TestService_SuccessChatCreate(t *testing.T) {
 		txManager postgres.TxManager
 		chat      repository.Chat
 		log       repository.Log
+		users     client.UserDirectory
 	}
 
 	var (
@@ -40,6 +46,7 @@ func TestService_SuccessChatCreate(t *testing.T) {
 
 		title     = gofakeit.JobTitle()
 		nicknames = []string{gofakeit.JobTitle(), gofakeit.JobTitle()}
+		memberIDs = []uint64{gofakeit.Uint64(), gofakeit.Uint64()}
 
 		chatCreateInput = converter.CreateChatInput{
 			Title:     title,
@@ -90,17 +97,28 @@ func TestService_SuccessChatCreate(t *testing.T) {
 				txManager := postgres.NewTxManager(db)
 				chatRepo := mockrepository.NewMockChat(t)
 				logRepo := mockrepository.NewMockLog(t)
+				users := mockclient.NewMockUserDirectory(t)
+
+				users.On("IDs", tt.ctx, tt.chatCreateInput.Nicknames).Return(memberIDs, nil)
 
 				chatRepo.On("Create", txCtx, model.Chat{
+					Kind:  model.ChatKindGroup,
 					Title: tt.chatCreateInput.Title,
 				}).Return(uint64(chatID), nil)
 
+				chatRepo.On("AddMembers", txCtx, uint64(chatID), []model.ChatMember{
+					{UserID: userID, Role: model.RoleOwner},
+					{UserID: memberIDs[0], Role: model.RoleMember},
+					{UserID: memberIDs[1], Role: model.RoleMember},
+				}).Return(nil)
+
 				logRepo.On("Log", txCtx, tt.logCreateInput).Return(nil)
 
 				return mocker{
 					txManager: txManager,
 					chat:      chatRepo,
 					log:       logRepo,
+					users:     users,
 				}
 			},
 		},
@@ -112,7 +130,7 @@ func TestService_SuccessChatCreate(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			mocker := tt.mocker(tt.args)
 
-			service := chatservice.NewService(mocker.txManager, mocker.chat, mocker.log)
+			service := chatservice.NewService(mocker.txManager, mocker.chat, nil, nil, nil, mocker.log, mocker.users, hub.New(), nil, config.Chat{})
 
 			output, err := service.CreateChat(tt.args.ctx, tt.args.chatCreateInput)
 
@@ -133,6 +151,7 @@ func TestService_FailChatCreateProcessTx(t *testing.T) {
 		txManager postgres.TxManager
 		chat      repository.Chat
 		log       repository.Log
+		users     client.UserDirectory
 	}
 
 	var (
@@ -152,7 +171,7 @@ func TestService_FailChatCreateProcessTx(t *testing.T) {
 			name: "failed to start tx because ReadCommitted returned an error",
 			args: args{
 				ctx:             context.Background(),
-				chatCreateInput: converter.CreateChatInput{},
+				chatCreateInput: converter.CreateChatInput{Title: gofakeit.JobTitle()},
 				logCreateInput:  model.Log{},
 			},
 			want: converter.CreateChatOutput{},
@@ -161,10 +180,14 @@ func TestService_FailChatCreateProcessTx(t *testing.T) {
 				txManager := mockpostgres.NewMockTxManager(t)
 				txManager.On("ReadCommitted", tt.ctx, mock.AnythingOfType("postgres.Handler")).Return(err)
 
+				users := mockclient.NewMockUserDirectory(t)
+				users.On("IDs", tt.ctx, tt.chatCreateInput.Nicknames).Return([]uint64{}, nil)
+
 				return mocker{
 					txManager: txManager,
 					chat:      nil,
 					log:       nil,
+					users:     users,
 				}
 			},
 		},
@@ -176,7 +199,7 @@ func TestService_FailChatCreateProcessTx(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			mocker := tt.mocker(tt.args)
 
-			service := chatservice.NewService(mocker.txManager, mocker.chat, mocker.log)
+			service := chatservice.NewService(mocker.txManager, mocker.chat, nil, nil, nil, mocker.log, mocker.users, hub.New(), nil, config.Chat{})
 
 			output, err := service.CreateChat(tt.args.ctx, tt.args.chatCreateInput)
 
@@ -197,6 +220,7 @@ func TestService_FailChatCreate(t *testing.T) {
 		txManager postgres.TxManager
 		chat      repository.Chat
 		log       repository.Log
+		users     client.UserDirectory
 	}
 
 	var (
@@ -204,6 +228,7 @@ func TestService_FailChatCreate(t *testing.T) {
 
 		title     = gofakeit.JobTitle()
 		nicknames = []string{gofakeit.JobTitle(), gofakeit.JobTitle()}
+		memberIDs = []uint64{gofakeit.Uint64(), gofakeit.Uint64()}
 
 		chatCreateInput = converter.CreateChatInput{
 			Title:     title,
@@ -249,8 +274,12 @@ func TestService_FailChatCreate(t *testing.T) {
 				txManager := postgres.NewTxManager(db)
 				chatRepo := mockrepository.NewMockChat(t)
 				logRepo := mockrepository.NewMockLog(t)
+				users := mockclient.NewMockUserDirectory(t)
+
+				users.On("IDs", tt.ctx, tt.chatCreateInput.Nicknames).Return(memberIDs, nil)
 
 				chatRepo.On("Create", txCtx, model.Chat{
+					Kind:  model.ChatKindGroup,
 					Title: tt.chatCreateInput.Title,
 				}).Return(userID, err)
 
@@ -258,6 +287,7 @@ func TestService_FailChatCreate(t *testing.T) {
 					txManager: txManager,
 					chat:      chatRepo,
 					log:       logRepo,
+					users:     users,
 				}
 			},
 		},
@@ -269,7 +299,7 @@ func TestService_FailChatCreate(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			mocker := tt.mocker(tt.args)
 
-			service := chatservice.NewService(mocker.txManager, mocker.chat, mocker.log)
+			service := chatservice.NewService(mocker.txManager, mocker.chat, nil, nil, nil, mocker.log, mocker.users, hub.New(), nil, config.Chat{})
 
 			output, err := service.CreateChat(tt.args.ctx, tt.args.chatCreateInput)
 
@@ -290,13 +320,16 @@ func TestService_FailChatCreateLog(t *testing.T) {
 		txManager postgres.TxManager
 		chat      repository.Chat
 		log       repository.Log
+		users     client.UserDirectory
 	}
 
 	var (
+		chatID = gofakeit.Uint64()
 		userID = gofakeit.Uint64()
 
 		title     = gofakeit.JobTitle()
 		nicknames = []string{gofakeit.JobTitle(), gofakeit.JobTitle()}
+		memberIDs = []uint64{gofakeit.Uint64(), gofakeit.Uint64()}
 
 		chatCreateInput = converter.CreateChatInput{
 			Title:     title,
@@ -347,10 +380,20 @@ func TestService_FailChatCreateLog(t *testing.T) {
 				txManager := postgres.NewTxManager(db)
 				chatRepo := mockrepository.NewMockChat(t)
 				logRepo := mockrepository.NewMockLog(t)
+				users := mockclient.NewMockUserDirectory(t)
+
+				users.On("IDs", tt.ctx, tt.chatCreateInput.Nicknames).Return(memberIDs, nil)
 
 				chatRepo.On("Create", txCtx, model.Chat{
+					Kind:  model.ChatKindGroup,
 					Title: tt.chatCreateInput.Title,
-				}).Return(userID, nil)
+				}).Return(chatID, nil)
+
+				chatRepo.On("AddMembers", txCtx, chatID, []model.ChatMember{
+					{UserID: userID, Role: model.RoleOwner},
+					{UserID: memberIDs[0], Role: model.RoleMember},
+					{UserID: memberIDs[1], Role: model.RoleMember},
+				}).Return(nil)
 
 				logRepo.On("Log", txCtx, tt.logCreateInput).Return(err)
 
@@ -358,6 +401,82 @@ func TestService_FailChatCreateLog(t *testing.T) {
 					txManager: txManager,
 					chat:      chatRepo,
 					log:       logRepo,
+					users:     users,
+				}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Parallel()
+
+		t.Run(tt.name, func(t *testing.T) {
+			mocker := tt.mocker(tt.args)
+
+			service := chatservice.NewService(mocker.txManager, mocker.chat, nil, nil, nil, mocker.log, mocker.users, hub.New(), nil, config.Chat{})
+
+			output, err := service.CreateChat(tt.args.ctx, tt.args.chatCreateInput)
+
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.want, output)
+		})
+	}
+}
+
+func TestService_FailChatCreateResolveUsers(t *testing.T) {
+	type args struct {
+		ctx             context.Context
+		chatCreateInput converter.CreateChatInput
+	}
+
+	type mocker struct {
+		txManager postgres.TxManager
+		chat      repository.Chat
+		log       repository.Log
+		users     client.UserDirectory
+	}
+
+	var (
+		userID = gofakeit.Uint64()
+
+		title     = gofakeit.JobTitle()
+		nicknames = []string{gofakeit.JobTitle(), gofakeit.JobTitle()}
+
+		chatCreateInput = converter.CreateChatInput{
+			Title:     title,
+			Nicknames: nicknames,
+			UserID:    userID,
+		}
+
+		slErr = sl.Err("service.CreateChat", apperr.Wrap(apperr.CodeNotFound, client.ErrUserNotFound.Error(), client.ErrUserNotFound))
+	)
+
+	tests := []struct {
+		name   string
+		args   args
+		want   converter.CreateChatOutput
+		err    error
+		mocker func(tt args) mocker
+	}{
+		{
+			name: "failed to create chat because one of usernames is unknown",
+			args: args{
+				ctx:             context.Background(),
+				chatCreateInput: chatCreateInput,
+			},
+			want: converter.CreateChatOutput{},
+			err:  slErr,
+			mocker: func(tt args) mocker {
+				txManager := mockpostgres.NewMockTxManager(t)
+
+				users := mockclient.NewMockUserDirectory(t)
+				users.On("IDs", tt.ctx, tt.chatCreateInput.Nicknames).Return(nil, client.ErrUserNotFound)
+
+				return mocker{
+					txManager: txManager,
+					chat:      nil,
+					log:       nil,
+					users:     users,
 				}
 			},
 		},
@@ -369,7 +488,7 @@ func TestService_FailChatCreateLog(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			mocker := tt.mocker(tt.args)
 
-			service := chatservice.NewService(mocker.txManager, mocker.chat, mocker.log)
+			service := chatservice.NewService(mocker.txManager, mocker.chat, nil, nil, nil, mocker.log, mocker.users, hub.New(), nil, config.Chat{})
 
 			output, err := service.CreateChat(tt.args.ctx, tt.args.chatCreateInput)
 
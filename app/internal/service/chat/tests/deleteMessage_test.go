@@ -0,0 +1,134 @@
+package usertests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit"
+	"github.com/defany/chat-server/app/internal/apperr"
+	"github.com/defany/chat-server/app/internal/config"
+	"github.com/defany/chat-server/app/internal/converter"
+	"github.com/defany/chat-server/app/internal/model"
+	mockrepository "github.com/defany/chat-server/app/internal/repository/mocks"
+	chatservice "github.com/defany/chat-server/app/internal/service/chat"
+	"github.com/defany/chat-server/app/internal/service/hub"
+	"github.com/defany/db/pkg/postgres"
+	mockpostgres "github.com/defany/db/pkg/postgres/mocks"
+	"github.com/defany/slogger/pkg/logger/sl"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService_DeleteMessage(t *testing.T) {
+	type mocker struct {
+		chat        *mockrepository.MockChat
+		attachments *mockrepository.MockAttachment
+		log         *mockrepository.MockLog
+	}
+
+	var (
+		input = converter.DeleteMessageInput{
+			ChatID:    gofakeit.Int64(),
+			MessageID: gofakeit.Uint64(),
+			UserID:    gofakeit.Uint64(),
+		}
+
+		current = model.Message{
+			ID:        input.MessageID,
+			ChatID:    input.ChatID,
+			UserID:    input.UserID,
+			Text:      gofakeit.JobTitle(),
+			Timestamp: time.Now(),
+		}
+
+		deletedAt = time.Now()
+
+		deleted = model.Message{
+			ID:        input.MessageID,
+			ChatID:    input.ChatID,
+			UserID:    input.UserID,
+			Timestamp: current.Timestamp,
+			DeletedAt: &deletedAt,
+		}
+	)
+
+	tests := []struct {
+		name   string
+		commit bool
+		err    error
+		mocker func(txCtx context.Context, m mocker)
+	}{
+		{
+			name:   "author deletes message and its text goes to history",
+			commit: true,
+			mocker: func(txCtx context.Context, m mocker) {
+				m.chat.On("MemberRole", txCtx, input.ChatID, input.UserID).Return(model.RoleMember, nil)
+				m.chat.On("MessageForUpdate", txCtx, input.ChatID, input.MessageID).Return(current, nil)
+				m.chat.On("AddMessageRevision", txCtx, current.ID, current.Text, current.Entities).Return(nil)
+				m.chat.On("DeleteMessage", txCtx, current.ID).Return(deleted, nil)
+				m.attachments.On("OrphanMessage", txCtx, current.ID).Return(nil)
+				m.attachments.On("DeleteByMessage", txCtx, current.ID).Return(nil)
+				m.log.On("Log", txCtx, model.Log{Action: model.LogDeleteMessage, UserID: input.UserID}).Return(nil)
+			},
+		},
+		{
+			name: "outsider can not delete message",
+			err:  sl.Err("service.DeleteMessage", apperr.PermissionDenied("user is not a member of the chat")),
+			mocker: func(txCtx context.Context, m mocker) {
+				m.chat.On("MemberRole", txCtx, input.ChatID, input.UserID).Return(model.Role(""), nil)
+			},
+		},
+		{
+			name: "message not found",
+			err:  sl.Err("service.DeleteMessage", apperr.NotFound("message not found")),
+			mocker: func(txCtx context.Context, m mocker) {
+				m.chat.On("MemberRole", txCtx, input.ChatID, input.UserID).Return(model.RoleMember, nil)
+				m.chat.On("MessageForUpdate", txCtx, input.ChatID, input.MessageID).Return(model.Message{}, apperr.NotFound("message not found"))
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			tx := mockpostgres.NewMockTx(t)
+
+			txCtx := postgres.InjectTX(ctx, tx)
+
+			if tt.commit {
+				tx.On("Commit", txCtx).Return(nil)
+			} else {
+				tx.On("Rollback", txCtx).Return(nil)
+			}
+
+			db := mockpostgres.NewMockPostgres(t)
+			db.On("BeginTx", ctx, pgx.TxOptions{IsoLevel: pgx.ReadCommitted}).Return(tx, nil)
+
+			m := mocker{
+				chat:        mockrepository.NewMockChat(t),
+				attachments: mockrepository.NewMockAttachment(t),
+				log:         mockrepository.NewMockLog(t),
+			}
+
+			tt.mocker(txCtx, m)
+
+			h := hub.New()
+
+			sub := h.Subscribe(input.ChatID, gofakeit.Uint64())
+			defer h.Unsubscribe(sub)
+
+			service := chatservice.NewService(postgres.NewTxManager(db), m.chat, nil, m.attachments, nil, m.log, nil, h, nil, config.Chat{})
+
+			err := service.DeleteMessage(ctx, input)
+
+			require.Equal(t, tt.err, err)
+
+			// Подписчики получают удаленное сообщение, чтобы убрать его у себя
+			if tt.err == nil {
+				require.Equal(t, deleted, <-sub.Messages())
+			}
+		})
+	}
+}
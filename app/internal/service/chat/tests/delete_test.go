@@ -4,13 +4,18 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/brianvoe/gofakeit"
+	"github.com/defany/chat-server/app/internal/apperr"
+	"github.com/defany/chat-server/app/internal/config"
 	"github.com/defany/chat-server/app/internal/converter"
 	"github.com/defany/chat-server/app/internal/model"
 	"github.com/defany/chat-server/app/internal/repository"
 	mockrepository "github.com/defany/chat-server/app/internal/repository/mocks"
 	chatservice "github.com/defany/chat-server/app/internal/service/chat"
+	"github.com/defany/chat-server/app/internal/service/hub"
+	"github.com/defany/chat-server/app/internal/service/presence"
 	"github.com/defany/db/pkg/postgres"
 	mockpostgres "github.com/defany/db/pkg/postgres/mocks"
 	"github.com/defany/slogger/pkg/logger/sl"
@@ -79,6 +84,8 @@ func TestService_SuccessChatDelete(t *testing.T) {
 				chatRepo := mockrepository.NewMockChat(t)
 				logRepo := mockrepository.NewMockLog(t)
 
+				chatRepo.On("MemberRole", txCtx, tt.deleteChatInput.ChatID, tt.deleteChatInput.UserID).Return(model.RoleOwner, nil)
+
 				chatRepo.On("Delete", txCtx, tt.deleteChatInput.ChatID).Return(nil)
 
 				logRepo.On("Log", txCtx, tt.logCreateInput).Return(nil)
@@ -98,11 +105,19 @@ func TestService_SuccessChatDelete(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			mocker := tt.mocker(tt.args)
 
-			service := chatservice.NewService(mocker.txManager, mocker.chat, mocker.log)
+			tracker := presence.New(time.Minute)
+
+			watcher := tracker.Watch(userID)
+			tracker.Join(watcher, chatID)
+
+			service := chatservice.NewService(mocker.txManager, mocker.chat, nil, nil, nil, mocker.log, nil, hub.New(), tracker, config.Chat{})
 
 			err := service.DeleteChat(tt.args.ctx, tt.args.deleteChatInput)
 
 			require.Equal(t, tt.want, err)
+
+			// Подключения участников отписаны от удаленного чата, печатать в нем уже нельзя
+			require.ErrorIs(t, tracker.Typing(watcher, chatID, true), presence.ErrNotJoined)
 		})
 	}
 }
@@ -159,7 +174,7 @@ func TestService_FailChatDeleteProcessTx(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			mocker := tt.mocker(tt.args)
 
-			service := chatservice.NewService(mocker.txManager, mocker.chat, mocker.log)
+			service := chatservice.NewService(mocker.txManager, mocker.chat, nil, nil, nil, mocker.log, nil, hub.New(), nil, config.Chat{})
 
 			err := service.DeleteChat(tt.args.ctx, tt.args.deleteChatInput)
 
@@ -227,6 +242,8 @@ func TestService_FailChatDelete(t *testing.T) {
 				chatRepo := mockrepository.NewMockChat(t)
 				logRepo := mockrepository.NewMockLog(t)
 
+				chatRepo.On("MemberRole", txCtx, tt.chatDeleteInput.ChatID, tt.chatDeleteInput.UserID).Return(model.RoleOwner, nil)
+
 				chatRepo.On("Delete", txCtx, tt.chatDeleteInput.ChatID).Return(err)
 
 				return mocker{
@@ -244,7 +261,7 @@ func TestService_FailChatDelete(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			mocker := tt.mocker(tt.args)
 
-			service := chatservice.NewService(mocker.txManager, mocker.chat, mocker.log)
+			service := chatservice.NewService(mocker.txManager, mocker.chat, nil, nil, nil, mocker.log, nil, hub.New(), nil, config.Chat{})
 
 			err := service.DeleteChat(tt.args.ctx, tt.args.chatDeleteInput)
 
@@ -317,6 +334,8 @@ func TestService_FailChatDeleteLog(t *testing.T) {
 				chatRepo := mockrepository.NewMockChat(t)
 				logRepo := mockrepository.NewMockLog(t)
 
+				chatRepo.On("MemberRole", txCtx, tt.deleteChatInput.ChatID, tt.deleteChatInput.UserID).Return(model.RoleOwner, nil)
+
 				chatRepo.On("Delete", txCtx, tt.deleteChatInput.ChatID).Return(nil)
 
 				logRepo.On("Log", txCtx, tt.logCreateInput).Return(err)
@@ -336,7 +355,76 @@ func TestService_FailChatDeleteLog(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			mocker := tt.mocker(tt.args)
 
-			service := chatservice.NewService(mocker.txManager, mocker.chat, mocker.log)
+			service := chatservice.NewService(mocker.txManager, mocker.chat, nil, nil, nil, mocker.log, nil, hub.New(), nil, config.Chat{})
+
+			err := service.DeleteChat(tt.args.ctx, tt.args.deleteChatInput)
+
+			require.Equal(t, tt.want, err)
+		})
+	}
+}
+
+func TestService_FailChatDeleteNotOwner(t *testing.T) {
+	type args struct {
+		ctx             context.Context
+		deleteChatInput converter.DeleteChatInput
+	}
+
+	var (
+		deleteChatInput = converter.DeleteChatInput{
+			ChatID: gofakeit.Int64(),
+			UserID: gofakeit.Uint64(),
+		}
+
+		notAllowedErr = sl.Err("service.DeleteChat", apperr.PermissionDenied("action is not allowed for chat member"))
+		notMemberErr  = sl.Err("service.DeleteChat", apperr.PermissionDenied("user is not a member of the chat"))
+	)
+
+	tests := []struct {
+		name string
+		args args
+		role model.Role
+		want error
+	}{
+		{
+			name: "member can not delete chat",
+			args: args{
+				ctx:             context.Background(),
+				deleteChatInput: deleteChatInput,
+			},
+			role: model.RoleMember,
+			want: notAllowedErr,
+		},
+		{
+			name: "outsider can not delete chat",
+			args: args{
+				ctx:             context.Background(),
+				deleteChatInput: deleteChatInput,
+			},
+			role: "",
+			want: notMemberErr,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			txOpts := pgx.TxOptions{
+				IsoLevel: pgx.ReadCommitted,
+			}
+
+			tx := mockpostgres.NewMockTx(t)
+
+			txCtx := postgres.InjectTX(tt.args.ctx, tx)
+
+			tx.On("Rollback", txCtx).Return(nil)
+
+			db := mockpostgres.NewMockPostgres(t)
+			db.On("BeginTx", tt.args.ctx, txOpts).Return(tx, nil)
+
+			chatRepo := mockrepository.NewMockChat(t)
+			chatRepo.On("MemberRole", txCtx, tt.args.deleteChatInput.ChatID, tt.args.deleteChatInput.UserID).Return(tt.role, nil)
+
+			service := chatservice.NewService(postgres.NewTxManager(db), chatRepo, nil, nil, nil, mockrepository.NewMockLog(t), nil, hub.New(), nil, config.Chat{})
 
 			err := service.DeleteChat(tt.args.ctx, tt.args.deleteChatInput)
 
@@ -0,0 +1,225 @@
+package usertests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/brianvoe/gofakeit"
+	"github.com/defany/chat-server/app/internal/apperr"
+	"github.com/defany/chat-server/app/internal/client"
+	mockclient "github.com/defany/chat-server/app/internal/client/mocks"
+	"github.com/defany/chat-server/app/internal/config"
+	"github.com/defany/chat-server/app/internal/converter"
+	"github.com/defany/chat-server/app/internal/model"
+	mockrepository "github.com/defany/chat-server/app/internal/repository/mocks"
+	chatservice "github.com/defany/chat-server/app/internal/service/chat"
+	"github.com/defany/chat-server/app/internal/service/hub"
+	"github.com/defany/db/pkg/postgres"
+	mockpostgres "github.com/defany/db/pkg/postgres/mocks"
+	"github.com/defany/slogger/pkg/logger/sl"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/require"
+)
+
+type directMocker struct {
+	chat  *mockrepository.MockChat
+	log   *mockrepository.MockLog
+	users *mockclient.MockUserDirectory
+}
+
+func TestService_GetOrCreateDirectChat(t *testing.T) {
+	var (
+		input = converter.GetOrCreateDirectChatInput{
+			UserID: gofakeit.Uint64(),
+			PeerID: gofakeit.Uint64(),
+		}
+
+		chat = model.Chat{
+			ID:      gofakeit.Int64(),
+			Kind:    model.ChatKindDirect,
+			Version: 1,
+		}
+
+		owners = []model.ChatMember{
+			{UserID: input.UserID, Role: model.RoleOwner},
+			{UserID: input.PeerID, Role: model.RoleOwner},
+		}
+
+		usernames = map[uint64]string{
+			input.PeerID: "bob",
+		}
+	)
+
+	tests := []struct {
+		name   string
+		tx     bool
+		commit bool
+		want   converter.GetOrCreateDirectChatOutput
+		err    error
+		mocker func(txCtx context.Context, m directMocker)
+	}{
+		{
+			name:   "first call creates direct chat with both users as owners",
+			tx:     true,
+			commit: true,
+			want:   converter.GetOrCreateDirectChatOutput{Chat: chat, Created: true},
+			mocker: func(txCtx context.Context, m directMocker) {
+				m.users.On("Usernames", context.Background(), []uint64{input.PeerID}).Return(usernames, nil)
+				m.chat.On("GetOrCreateDirect", txCtx, input.UserID, input.PeerID).Return(chat.ID, true, nil)
+				m.chat.On("AddMembers", txCtx, uint64(chat.ID), owners).Return(nil)
+				m.log.On("Log", txCtx, model.Log{Action: model.LogCreateChat, UserID: input.UserID}).Return(nil)
+				m.chat.On("Chat", txCtx, chat.ID).Return(chat, nil)
+			},
+		},
+		{
+			name:   "existing direct chat is returned",
+			tx:     true,
+			commit: true,
+			want:   converter.GetOrCreateDirectChatOutput{Chat: chat},
+			mocker: func(txCtx context.Context, m directMocker) {
+				m.users.On("Usernames", context.Background(), []uint64{input.PeerID}).Return(usernames, nil)
+				m.chat.On("GetOrCreateDirect", txCtx, input.UserID, input.PeerID).Return(chat.ID, false, nil)
+				m.chat.On("Chat", txCtx, chat.ID).Return(chat, nil)
+			},
+		},
+		{
+			name: "unknown user",
+			err:  sl.Err("service.GetOrCreateDirectChat", apperr.Wrap(apperr.CodeNotFound, client.ErrUserNotFound.Error(), client.ErrUserNotFound)),
+			mocker: func(txCtx context.Context, m directMocker) {
+				m.users.On("Usernames", context.Background(), []uint64{input.PeerID}).Return(map[uint64]string{}, nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			tx := mockpostgres.NewMockTx(t)
+
+			txCtx := postgres.InjectTX(ctx, tx)
+
+			db := mockpostgres.NewMockPostgres(t)
+
+			if tt.tx {
+				if tt.commit {
+					tx.On("Commit", txCtx).Return(nil)
+				} else {
+					tx.On("Rollback", txCtx).Return(nil)
+				}
+
+				db.On("BeginTx", ctx, pgx.TxOptions{IsoLevel: pgx.ReadCommitted}).Return(tx, nil)
+			}
+
+			m := directMocker{
+				chat:  mockrepository.NewMockChat(t),
+				log:   mockrepository.NewMockLog(t),
+				users: mockclient.NewMockUserDirectory(t),
+			}
+
+			tt.mocker(txCtx, m)
+
+			service := chatservice.NewService(postgres.NewTxManager(db), m.chat, nil, nil, nil, m.log, m.users, hub.New(), nil, config.Chat{})
+
+			output, err := service.GetOrCreateDirectChat(ctx, input)
+
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.want, output)
+		})
+	}
+}
+
+func TestService_GetOrCreateDirectChatWithYourself(t *testing.T) {
+	service := chatservice.NewService(nil, nil, nil, nil, nil, nil, nil, hub.New(), nil, config.Chat{})
+
+	userID := gofakeit.Uint64()
+
+	_, err := service.GetOrCreateDirectChat(context.Background(), converter.GetOrCreateDirectChatInput{
+		UserID: userID,
+		PeerID: userID,
+	})
+
+	require.Equal(t, sl.Err("service.GetOrCreateDirectChat", apperr.InvalidArgument("can not create direct chat with yourself")), err)
+}
+
+func TestService_CreateChatWithOneUserReturnsDirectChat(t *testing.T) {
+	var (
+		input = converter.CreateChatInput{
+			Nicknames: []string{"bob"},
+			UserID:    gofakeit.Uint64(),
+		}
+
+		peerID = gofakeit.Uint64()
+		chatID = gofakeit.Int64()
+	)
+
+	ctx := context.Background()
+
+	tx := mockpostgres.NewMockTx(t)
+
+	txCtx := postgres.InjectTX(ctx, tx)
+
+	tx.On("Commit", txCtx).Return(nil)
+
+	db := mockpostgres.NewMockPostgres(t)
+	db.On("BeginTx", ctx, pgx.TxOptions{IsoLevel: pgx.ReadCommitted}).Return(tx, nil)
+
+	users := mockclient.NewMockUserDirectory(t)
+	users.On("IDs", ctx, input.Nicknames).Return([]uint64{peerID}, nil)
+
+	// Чат уже есть, поэтому ни Create, ни AddMembers не вызываются
+	repo := mockrepository.NewMockChat(t)
+	repo.On("GetOrCreateDirect", txCtx, input.UserID, peerID).Return(chatID, false, nil)
+
+	service := chatservice.NewService(postgres.NewTxManager(db), repo, nil, nil, nil, nil, users, hub.New(), nil, config.Chat{})
+
+	output, err := service.CreateChat(ctx, input)
+
+	require.NoError(t, err)
+	require.Equal(t, converter.CreateChatOutput{ID: uint64(chatID)}, output)
+}
+
+func TestService_CreateChatWithOneUserAndTitleFails(t *testing.T) {
+	input := converter.CreateChatInput{
+		Title:     gofakeit.JobTitle(),
+		Nicknames: []string{"bob"},
+		UserID:    gofakeit.Uint64(),
+	}
+
+	ctx := context.Background()
+
+	users := mockclient.NewMockUserDirectory(t)
+	users.On("IDs", ctx, input.Nicknames).Return([]uint64{gofakeit.Uint64()}, nil)
+
+	// Транзакция не открывается, в репозиторий ничего не пишется
+	service := chatservice.NewService(mockpostgres.NewMockTxManager(t), mockrepository.NewMockChat(t), nil, nil, nil, nil, users, hub.New(), nil, config.Chat{})
+
+	output, err := service.CreateChat(ctx, input)
+
+	require.Equal(t, sl.Err("service.CreateChat", apperr.InvalidArgument("title is not allowed for direct chat", apperr.FieldViolation{
+		Field:       "title",
+		Description: "chat with one other user is direct and has no title, add more members to create a group",
+	})), err)
+	require.Equal(t, converter.CreateChatOutput{}, output)
+}
+
+func TestService_CreateGroupChatWithoutTitleFails(t *testing.T) {
+	input := converter.CreateChatInput{
+		Nicknames: []string{"bob", "alice"},
+		UserID:    gofakeit.Uint64(),
+	}
+
+	ctx := context.Background()
+
+	users := mockclient.NewMockUserDirectory(t)
+	users.On("IDs", ctx, input.Nicknames).Return([]uint64{gofakeit.Uint64(), gofakeit.Uint64()}, nil)
+
+	service := chatservice.NewService(mockpostgres.NewMockTxManager(t), mockrepository.NewMockChat(t), nil, nil, nil, nil, users, hub.New(), nil, config.Chat{})
+
+	_, err := service.CreateChat(ctx, input)
+
+	require.Equal(t, sl.Err("service.CreateChat", apperr.InvalidArgument("group chat must have a title", apperr.FieldViolation{
+		Field:       "title",
+		Description: "title is required for group chat",
+	})), err)
+}
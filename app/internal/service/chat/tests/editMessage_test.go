@@ -0,0 +1,172 @@
+package usertests
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit"
+	"github.com/defany/chat-server/app/internal/apperr"
+	"github.com/defany/chat-server/app/internal/config"
+	"github.com/defany/chat-server/app/internal/converter"
+	"github.com/defany/chat-server/app/internal/model"
+	mockrepository "github.com/defany/chat-server/app/internal/repository/mocks"
+	chatservice "github.com/defany/chat-server/app/internal/service/chat"
+	"github.com/defany/chat-server/app/internal/service/hub"
+	"github.com/defany/db/pkg/postgres"
+	mockpostgres "github.com/defany/db/pkg/postgres/mocks"
+	"github.com/defany/slogger/pkg/logger/sl"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService_EditMessage(t *testing.T) {
+	type mocker struct {
+		chat *mockrepository.MockChat
+		log  *mockrepository.MockLog
+	}
+
+	var (
+		input = converter.EditMessageInput{
+			ChatID:    gofakeit.Int64(),
+			MessageID: gofakeit.Uint64(),
+			UserID:    gofakeit.Uint64(),
+			Text:      gofakeit.JobTitle(),
+		}
+
+		current = model.Message{
+			ID:        input.MessageID,
+			ChatID:    input.ChatID,
+			UserID:    input.UserID,
+			Text:      gofakeit.JobTitle(),
+			Timestamp: time.Now().Add(-time.Minute),
+		}
+
+		editedAt = time.Now()
+
+		edited = model.Message{
+			ID:        input.MessageID,
+			ChatID:    input.ChatID,
+			UserID:    input.UserID,
+			Text:      input.Text,
+			Timestamp: current.Timestamp,
+			EditedAt:  &editedAt,
+		}
+
+		deletedAt = time.Now()
+
+		err = errors.New("failed to edit message")
+	)
+
+	tests := []struct {
+		name   string
+		commit bool
+		want   model.Message
+		err    error
+		mocker func(txCtx context.Context, m mocker)
+	}{
+		{
+			name:   "author edits message and previous text goes to history",
+			commit: true,
+			want:   edited,
+			mocker: func(txCtx context.Context, m mocker) {
+				m.chat.On("MemberRole", txCtx, input.ChatID, input.UserID).Return(model.RoleMember, nil)
+				m.chat.On("MessageForUpdate", txCtx, input.ChatID, input.MessageID).Return(current, nil)
+				m.chat.On("InEditWindow", txCtx, current.ID, time.Hour).Return(true, nil)
+				m.chat.On("AddMessageRevision", txCtx, current.ID, current.Text, current.Entities).Return(nil)
+				m.chat.On("EditMessage", txCtx, current.ID, input.Text, []model.MessageEntity(nil)).Return(edited, nil)
+				m.log.On("Log", txCtx, model.Log{Action: model.LogEditMessage, UserID: input.UserID}).Return(nil)
+			},
+		},
+		{
+			name: "only author can edit message",
+			err:  sl.Err("service.EditMessage", apperr.PermissionDenied("only author can change message")),
+			mocker: func(txCtx context.Context, m mocker) {
+				foreign := current
+				foreign.UserID = input.UserID + 1
+
+				m.chat.On("MemberRole", txCtx, input.ChatID, input.UserID).Return(model.RoleAdmin, nil)
+				m.chat.On("MessageForUpdate", txCtx, input.ChatID, input.MessageID).Return(foreign, nil)
+			},
+		},
+		{
+			name: "system message can not be edited",
+			err:  sl.Err("service.EditMessage", apperr.PermissionDenied("system messages can not be changed")),
+			mocker: func(txCtx context.Context, m mocker) {
+				system := current
+				system.System = true
+
+				m.chat.On("MemberRole", txCtx, input.ChatID, input.UserID).Return(model.RoleOwner, nil)
+				m.chat.On("MessageForUpdate", txCtx, input.ChatID, input.MessageID).Return(system, nil)
+			},
+		},
+		{
+			name: "edit window is over",
+			err:  sl.Err("service.EditMessage", apperr.FailedPrecondition("message can not be changed after edit window")),
+			mocker: func(txCtx context.Context, m mocker) {
+				// Возраст сообщения считает база, поэтому его timestamp тут ни при чем
+				m.chat.On("MemberRole", txCtx, input.ChatID, input.UserID).Return(model.RoleMember, nil)
+				m.chat.On("MessageForUpdate", txCtx, input.ChatID, input.MessageID).Return(current, nil)
+				m.chat.On("InEditWindow", txCtx, current.ID, time.Hour).Return(false, nil)
+			},
+		},
+		{
+			name: "deleted message can not be edited",
+			err:  sl.Err("service.EditMessage", apperr.NotFound("message not found")),
+			mocker: func(txCtx context.Context, m mocker) {
+				deleted := current
+				deleted.DeletedAt = &deletedAt
+
+				m.chat.On("MemberRole", txCtx, input.ChatID, input.UserID).Return(model.RoleMember, nil)
+				m.chat.On("MessageForUpdate", txCtx, input.ChatID, input.MessageID).Return(deleted, nil)
+			},
+		},
+		{
+			name: "chat repository returned an error",
+			err:  sl.Err("service.EditMessage", err),
+			mocker: func(txCtx context.Context, m mocker) {
+				m.chat.On("MemberRole", txCtx, input.ChatID, input.UserID).Return(model.RoleMember, nil)
+				m.chat.On("MessageForUpdate", txCtx, input.ChatID, input.MessageID).Return(current, nil)
+				m.chat.On("InEditWindow", txCtx, current.ID, time.Hour).Return(true, nil)
+				m.chat.On("AddMessageRevision", txCtx, current.ID, current.Text, current.Entities).Return(nil)
+				m.chat.On("EditMessage", txCtx, current.ID, input.Text, []model.MessageEntity(nil)).Return(model.Message{}, err)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			tx := mockpostgres.NewMockTx(t)
+
+			txCtx := postgres.InjectTX(ctx, tx)
+
+			if tt.commit {
+				tx.On("Commit", txCtx).Return(nil)
+			} else {
+				tx.On("Rollback", txCtx).Return(nil)
+			}
+
+			db := mockpostgres.NewMockPostgres(t)
+			db.On("BeginTx", ctx, pgx.TxOptions{IsoLevel: pgx.ReadCommitted}).Return(tx, nil)
+
+			m := mocker{
+				chat: mockrepository.NewMockChat(t),
+				log:  mockrepository.NewMockLog(t),
+			}
+
+			tt.mocker(txCtx, m)
+
+			service := chatservice.NewService(postgres.NewTxManager(db), m.chat, nil, nil, nil, m.log, nil, hub.New(), nil, config.Chat{
+				EditWindow: time.Hour,
+			})
+
+			msg, err := service.EditMessage(ctx, input)
+
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.want, msg)
+		})
+	}
+}
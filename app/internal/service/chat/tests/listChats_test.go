@@ -0,0 +1,128 @@
+package usertests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit"
+	"github.com/defany/chat-server/app/internal/apperr"
+	"github.com/defany/chat-server/app/internal/config"
+	"github.com/defany/chat-server/app/internal/converter"
+	"github.com/defany/chat-server/app/internal/model"
+	mockrepository "github.com/defany/chat-server/app/internal/repository/mocks"
+	chatservice "github.com/defany/chat-server/app/internal/service/chat"
+	"github.com/defany/chat-server/app/internal/service/hub"
+	"github.com/defany/chat-server/app/pkg/cursor"
+	"github.com/defany/slogger/pkg/logger/sl"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService_ListChats(t *testing.T) {
+	var (
+		ctx = context.Background()
+
+		userID = gofakeit.Uint64()
+
+		now = time.Now().UTC().Truncate(time.Microsecond)
+
+		chats = []model.ChatSummary{
+			{
+				ID:            gofakeit.Int64(),
+				Title:         gofakeit.Company(),
+				MemberCount:   3,
+				UnreadCount:   2,
+				LastMessageAt: now,
+				LastMessage: &model.Message{
+					ID:        gofakeit.Uint64(),
+					UserID:    gofakeit.Uint64(),
+					Text:      gofakeit.JobTitle(),
+					Timestamp: now,
+				},
+			},
+			{
+				ID:            gofakeit.Int64(),
+				Title:         gofakeit.Company(),
+				MemberCount:   2,
+				LastMessageAt: now.Add(-time.Hour),
+			},
+		}
+
+		nextCursor, _ = cursor.Encode(model.ChatCursor{
+			LastMessageAt: chats[0].LastMessageAt,
+			ID:            chats[0].ID,
+		})
+
+		after = model.ChatCursor{
+			LastMessageAt: now.Add(-time.Minute),
+			ID:            gofakeit.Int64(),
+		}
+
+		afterCursor, _ = cursor.Encode(after)
+	)
+
+	invalidCursorErr := apperr.Wrap(apperr.CodeInvalidArgument, "invalid cursor", cursor.ErrInvalidCursor)
+	invalidCursorErr.Violations = []apperr.FieldViolation{
+		{Field: "cursor", Description: "cursor must be taken from the previous page"},
+	}
+
+	tests := []struct {
+		name   string
+		input  converter.ListChatsInput
+		want   converter.ListChatsOutput
+		err    error
+		mocker func(repo *mockrepository.MockChat)
+	}{
+		{
+			name: "first page with next cursor",
+			input: converter.ListChatsInput{
+				UserID: userID,
+				Limit:  1,
+			},
+			want: converter.ListChatsOutput{
+				Chats:      chats[:1],
+				NextCursor: nextCursor,
+			},
+			mocker: func(repo *mockrepository.MockChat) {
+				repo.On("ListChats", ctx, model.ChatsFilter{UserID: userID, Limit: 2}).Return(chats, nil)
+			},
+		},
+		{
+			name: "last page with default limit",
+			input: converter.ListChatsInput{
+				UserID: userID,
+				Cursor: afterCursor,
+			},
+			want: converter.ListChatsOutput{
+				Chats: chats[1:],
+			},
+			mocker: func(repo *mockrepository.MockChat) {
+				repo.On("ListChats", ctx, model.ChatsFilter{UserID: userID, Cursor: &after, Limit: 21}).Return(chats[1:], nil)
+			},
+		},
+		{
+			name: "invalid cursor",
+			input: converter.ListChatsInput{
+				UserID: userID,
+				Cursor: "not a cursor",
+			},
+			err:    sl.Err("service.ListChats", invalidCursorErr),
+			mocker: func(repo *mockrepository.MockChat) {},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := mockrepository.NewMockChat(t)
+
+			tt.mocker(repo)
+
+			service := chatservice.NewService(nil, repo, nil, nil, nil, nil, nil, hub.New(), nil, config.Chat{})
+
+			output, err := service.ListChats(ctx, tt.input)
+
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.want, output)
+		})
+	}
+}
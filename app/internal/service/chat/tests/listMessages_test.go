@@ -0,0 +1,219 @@
+package usertests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/brianvoe/gofakeit"
+	"github.com/defany/chat-server/app/internal/apperr"
+	"github.com/defany/chat-server/app/internal/config"
+	"github.com/defany/chat-server/app/internal/converter"
+	"github.com/defany/chat-server/app/internal/model"
+	"github.com/defany/chat-server/app/internal/repository"
+	mockrepository "github.com/defany/chat-server/app/internal/repository/mocks"
+	chatservice "github.com/defany/chat-server/app/internal/service/chat"
+	"github.com/defany/chat-server/app/internal/service/hub"
+	"github.com/defany/chat-server/app/pkg/cursor"
+	"github.com/defany/slogger/pkg/logger/sl"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService_ListMessages(t *testing.T) {
+	type args struct {
+		ctx   context.Context
+		input converter.ListMessagesInput
+	}
+
+	type mocker struct {
+		chat        repository.Chat
+		reactions   repository.Reaction
+		attachments repository.Attachment
+	}
+
+	var (
+		chatID = gofakeit.Int64()
+		userID = gofakeit.Uint64()
+
+		messages = []model.Message{
+			{ID: 3, ChatID: chatID, UserID: gofakeit.Uint64(), Text: gofakeit.JobTitle(), Timestamp: gofakeit.Date()},
+			{ID: 2, ChatID: chatID, UserID: gofakeit.Uint64(), Text: gofakeit.JobTitle(), Timestamp: gofakeit.Date()},
+			{ID: 1, ChatID: chatID, UserID: gofakeit.Uint64(), Text: gofakeit.JobTitle(), Timestamp: gofakeit.Date()},
+		}
+
+		pageCursor = model.MessageCursor{
+			Timestamp: messages[1].Timestamp.UTC(),
+			ID:        messages[1].ID,
+		}
+	)
+
+	nextCursor, err := cursor.Encode(pageCursor)
+	require.NoError(t, err)
+
+	reactions := map[uint64][]model.ReactionCount{
+		messages[0].ID: {{Emoji: "👍", Count: 2}, {Emoji: "🔥", Count: 1}},
+	}
+
+	messageID := messages[1].ID
+
+	attachments := map[uint64][]model.Attachment{
+		messages[1].ID: {{ID: gofakeit.Uint64(), ChatID: chatID, MessageID: &messageID, Filename: "report.pdf", MimeType: "application/pdf", Size: 1024}},
+	}
+
+	// ListMessages дописывает реакции и вложения прямо в слайс, который вернул репозиторий, поэтому ждем копию
+	withReactions := append([]model.Message(nil), messages[:2]...)
+	withReactions[0].Reactions = reactions[messages[0].ID]
+	withReactions[1].Attachments = attachments[messages[1].ID]
+
+	invalidCursorErr := apperr.Wrap(apperr.CodeInvalidArgument, "invalid cursor", cursor.ErrInvalidCursor)
+	invalidCursorErr.Violations = []apperr.FieldViolation{
+		{Field: "cursor", Description: "cursor must be taken from the previous page"},
+	}
+
+	tests := []struct {
+		name   string
+		args   args
+		want   converter.ListMessagesOutput
+		err    error
+		mocker func(tt args) mocker
+	}{
+		{
+			name: "first page with next cursor",
+			args: args{
+				ctx: context.Background(),
+				input: converter.ListMessagesInput{
+					ChatID: chatID,
+					UserID: userID,
+					Limit:  2,
+				},
+			},
+			want: converter.ListMessagesOutput{
+				Messages:   withReactions,
+				NextCursor: nextCursor,
+			},
+			err: nil,
+			mocker: func(tt args) mocker {
+				chatRepo := mockrepository.NewMockChat(t)
+
+				chatRepo.On("MemberRole", tt.ctx, tt.input.ChatID, tt.input.UserID).Return(model.RoleMember, nil)
+
+				chatRepo.On("ListMessages", tt.ctx, model.MessagesFilter{
+					ChatID:    chatID,
+					Direction: model.DirectionBackward,
+					Limit:     3,
+				}).Return(messages, nil)
+
+				reactionRepo := mockrepository.NewMockReaction(t)
+
+				reactionRepo.On("Counts", tt.ctx, []uint64{messages[0].ID, messages[1].ID}).Return(reactions, nil)
+
+				attachmentRepo := mockrepository.NewMockAttachment(t)
+
+				attachmentRepo.On("ByMessages", tt.ctx, []uint64{messages[0].ID, messages[1].ID}).Return(attachments, nil)
+
+				return mocker{
+					chat:        chatRepo,
+					reactions:   reactionRepo,
+					attachments: attachmentRepo,
+				}
+			},
+		},
+		{
+			name: "last page by cursor without next cursor",
+			args: args{
+				ctx: context.Background(),
+				input: converter.ListMessagesInput{
+					ChatID: chatID,
+					UserID: userID,
+					Cursor: nextCursor,
+					Limit:  1000,
+				},
+			},
+			want: converter.ListMessagesOutput{
+				Messages: messages[2:],
+			},
+			err: nil,
+			mocker: func(tt args) mocker {
+				chatRepo := mockrepository.NewMockChat(t)
+
+				chatRepo.On("MemberRole", tt.ctx, tt.input.ChatID, tt.input.UserID).Return(model.RoleMember, nil)
+
+				chatRepo.On("ListMessages", tt.ctx, model.MessagesFilter{
+					ChatID:    chatID,
+					Cursor:    &pageCursor,
+					Direction: model.DirectionBackward,
+					Limit:     101,
+				}).Return(messages[2:], nil)
+
+				reactionRepo := mockrepository.NewMockReaction(t)
+
+				reactionRepo.On("Counts", tt.ctx, []uint64{messages[2].ID}).Return(map[uint64][]model.ReactionCount{}, nil)
+
+				attachmentRepo := mockrepository.NewMockAttachment(t)
+
+				attachmentRepo.On("ByMessages", tt.ctx, []uint64{messages[2].ID}).Return(map[uint64][]model.Attachment{}, nil)
+
+				return mocker{
+					chat:        chatRepo,
+					reactions:   reactionRepo,
+					attachments: attachmentRepo,
+				}
+			},
+		},
+		{
+			name: "failed to list messages because user is not a chat member",
+			args: args{
+				ctx: context.Background(),
+				input: converter.ListMessagesInput{
+					ChatID: chatID,
+					UserID: userID,
+				},
+			},
+			want: converter.ListMessagesOutput{},
+			err:  sl.Err("service.ListMessages", apperr.PermissionDenied("user is not a member of the chat")),
+			mocker: func(tt args) mocker {
+				chatRepo := mockrepository.NewMockChat(t)
+
+				chatRepo.On("MemberRole", tt.ctx, tt.input.ChatID, tt.input.UserID).Return(model.Role(""), nil)
+
+				return mocker{
+					chat: chatRepo,
+				}
+			},
+		},
+		{
+			name: "failed to list messages because cursor is malformed",
+			args: args{
+				ctx: context.Background(),
+				input: converter.ListMessagesInput{
+					ChatID: chatID,
+					UserID: userID,
+					Cursor: "not a cursor",
+				},
+			},
+			want: converter.ListMessagesOutput{},
+			err:  sl.Err("service.ListMessages", invalidCursorErr),
+			mocker: func(tt args) mocker {
+				chatRepo := mockrepository.NewMockChat(t)
+
+				chatRepo.On("MemberRole", tt.ctx, tt.input.ChatID, tt.input.UserID).Return(model.RoleMember, nil)
+
+				return mocker{
+					chat: chatRepo,
+				}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mocker := tt.mocker(tt.args)
+
+			service := chatservice.NewService(nil, mocker.chat, mocker.reactions, mocker.attachments, nil, nil, nil, hub.New(), nil, config.Chat{})
+
+			output, err := service.ListMessages(tt.args.ctx, tt.args.input)
+
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.want, output)
+		})
+	}
+}
@@ -0,0 +1,110 @@
+package usertests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/brianvoe/gofakeit"
+	"github.com/defany/chat-server/app/internal/apperr"
+	"github.com/defany/chat-server/app/internal/config"
+	"github.com/defany/chat-server/app/internal/converter"
+	"github.com/defany/chat-server/app/internal/model"
+	mockrepository "github.com/defany/chat-server/app/internal/repository/mocks"
+	chatservice "github.com/defany/chat-server/app/internal/service/chat"
+	"github.com/defany/chat-server/app/internal/service/hub"
+	"github.com/defany/slogger/pkg/logger/sl"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService_ListThread(t *testing.T) {
+	var (
+		chatID = gofakeit.Int64()
+		userID = gofakeit.Uint64()
+
+		rootID = uint64(10)
+
+		root = model.Message{ID: rootID, ChatID: chatID, UserID: gofakeit.Uint64(), Text: gofakeit.JobTitle(), Timestamp: gofakeit.Date()}
+
+		replies = []model.Message{
+			{ID: 11, ChatID: chatID, UserID: userID, Text: gofakeit.JobTitle(), Timestamp: gofakeit.Date(), ReplyToMessageID: &rootID},
+			{ID: 12, ChatID: chatID, UserID: userID, Text: gofakeit.JobTitle(), Timestamp: gofakeit.Date(), ReplyToMessageID: &rootID},
+		}
+
+		input = converter.ListThreadInput{
+			ChatID:    chatID,
+			MessageID: rootID,
+			UserID:    userID,
+			Direction: model.DirectionForward,
+		}
+
+		notFoundErr = apperr.NotFound("message not found")
+
+		reactions = map[uint64][]model.ReactionCount{
+			rootID: {{Emoji: "👀", Count: 3}},
+		}
+	)
+
+	rootWithReactions := root
+	rootWithReactions.Reactions = reactions[rootID]
+
+	tests := []struct {
+		name   string
+		want   converter.ListThreadOutput
+		err    error
+		mocker func(chatRepo *mockrepository.MockChat, reactionRepo *mockrepository.MockReaction, attachmentRepo *mockrepository.MockAttachment)
+	}{
+		{
+			name: "root message with replies",
+			want: converter.ListThreadOutput{
+				Root:    rootWithReactions,
+				Replies: replies,
+			},
+			mocker: func(chatRepo *mockrepository.MockChat, reactionRepo *mockrepository.MockReaction, attachmentRepo *mockrepository.MockAttachment) {
+				chatRepo.On("MemberRole", context.Background(), chatID, userID).Return(model.RoleMember, nil)
+				chatRepo.On("Message", context.Background(), chatID, rootID).Return(root, nil)
+				reactionRepo.On("Counts", context.Background(), []uint64{rootID}).Return(reactions, nil)
+				reactionRepo.On("Counts", context.Background(), []uint64{replies[0].ID, replies[1].ID}).Return(map[uint64][]model.ReactionCount{}, nil)
+				attachmentRepo.On("ByMessages", context.Background(), []uint64{rootID}).Return(map[uint64][]model.Attachment{}, nil)
+				attachmentRepo.On("ByMessages", context.Background(), []uint64{replies[0].ID, replies[1].ID}).Return(map[uint64][]model.Attachment{}, nil)
+				chatRepo.On("ListMessages", context.Background(), model.MessagesFilter{
+					ChatID:    chatID,
+					ReplyTo:   &rootID,
+					Direction: model.DirectionForward,
+					Limit:     51,
+				}).Return(replies, nil)
+			},
+		},
+		{
+			name: "root message is not found in the chat",
+			err:  sl.Err("service.ListThread", notFoundErr),
+			mocker: func(chatRepo *mockrepository.MockChat, reactionRepo *mockrepository.MockReaction, attachmentRepo *mockrepository.MockAttachment) {
+				chatRepo.On("MemberRole", context.Background(), chatID, userID).Return(model.RoleMember, nil)
+				chatRepo.On("Message", context.Background(), chatID, rootID).Return(model.Message{}, notFoundErr)
+			},
+		},
+		{
+			name: "outsider can not read thread",
+			err:  sl.Err("service.ListThread", apperr.PermissionDenied("user is not a member of the chat")),
+			mocker: func(chatRepo *mockrepository.MockChat, reactionRepo *mockrepository.MockReaction, attachmentRepo *mockrepository.MockAttachment) {
+				chatRepo.On("MemberRole", context.Background(), chatID, userID).Return(model.Role(""), nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			chatRepo := mockrepository.NewMockChat(t)
+			reactionRepo := mockrepository.NewMockReaction(t)
+			attachmentRepo := mockrepository.NewMockAttachment(t)
+
+			tt.mocker(chatRepo, reactionRepo, attachmentRepo)
+
+			service := chatservice.NewService(nil, chatRepo, reactionRepo, attachmentRepo, nil, nil, nil, hub.New(), nil, config.Chat{})
+
+			output, err := service.ListThread(context.Background(), input)
+
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.want, output)
+		})
+	}
+}
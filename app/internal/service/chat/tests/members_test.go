@@ -0,0 +1,559 @@
+package usertests
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit"
+	"github.com/defany/chat-server/app/internal/apperr"
+	mockclient "github.com/defany/chat-server/app/internal/client/mocks"
+	"github.com/defany/chat-server/app/internal/config"
+	"github.com/defany/chat-server/app/internal/converter"
+	"github.com/defany/chat-server/app/internal/model"
+	mockrepository "github.com/defany/chat-server/app/internal/repository/mocks"
+	chatservice "github.com/defany/chat-server/app/internal/service/chat"
+	"github.com/defany/chat-server/app/internal/service/hub"
+	"github.com/defany/chat-server/app/internal/service/presence"
+	"github.com/defany/db/pkg/postgres"
+	mockpostgres "github.com/defany/db/pkg/postgres/mocks"
+	"github.com/defany/slogger/pkg/logger/sl"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/require"
+)
+
+type membersMocker struct {
+	chat  *mockrepository.MockChat
+	log   *mockrepository.MockLog
+	users *mockclient.MockUserDirectory
+}
+
+func TestService_AddMembers(t *testing.T) {
+	var (
+		input = converter.AddMembersInput{
+			ChatID:    gofakeit.Int64(),
+			UserID:    gofakeit.Uint64(),
+			Nicknames: []string{"bob", "carol"},
+		}
+
+		bobID   = gofakeit.Uint64()
+		carolID = gofakeit.Uint64()
+
+		members = []model.ChatMember{
+			{UserID: bobID, Role: model.RoleMember},
+			{UserID: carolID, Role: model.RoleMember},
+		}
+
+		names = map[uint64]string{
+			input.UserID: "alice",
+			bobID:        "bob",
+		}
+
+		msg = model.Message{
+			ID:     gofakeit.Uint64(),
+			ChatID: input.ChatID,
+			UserID: input.UserID,
+			Text:   "alice added bob, user " + strconv.FormatUint(carolID, 10),
+			System: true,
+		}
+
+		alreadyMemberErr = apperr.AlreadyExists("user is already a chat member")
+
+		group = model.Chat{
+			ID:   input.ChatID,
+			Kind: model.ChatKindGroup,
+		}
+	)
+
+	tests := []struct {
+		name   string
+		tx     bool
+		commit bool
+		err    error
+		mocker func(txCtx context.Context, m membersMocker)
+	}{
+		{
+			name:   "admin adds members",
+			tx:     true,
+			commit: true,
+			err:    nil,
+			mocker: func(txCtx context.Context, m membersMocker) {
+				m.users.On("IDs", context.Background(), input.Nicknames).Return([]uint64{bobID, carolID, bobID}, nil)
+				m.users.On("Usernames", context.Background(), []uint64{input.UserID, bobID, carolID}).Return(names, nil)
+				m.chat.On("MemberRole", txCtx, input.ChatID, input.UserID).Return(model.RoleAdmin, nil)
+				m.chat.On("Chat", txCtx, input.ChatID).Return(group, nil)
+				m.chat.On("AddMembers", txCtx, uint64(input.ChatID), members).Return(nil)
+				m.chat.On("SendSystemMessage", txCtx, input.ChatID, input.UserID, msg.Text).Return(msg, nil)
+				m.log.On("Log", txCtx, model.Log{Action: model.LogAddMembers, UserID: input.UserID}).Return(nil)
+			},
+		},
+		{
+			name: "regular member can not add members",
+			tx:   true,
+			err:  sl.Err("service.AddMembers", apperr.PermissionDenied("action is not allowed for chat member")),
+			mocker: func(txCtx context.Context, m membersMocker) {
+				m.users.On("IDs", context.Background(), input.Nicknames).Return([]uint64{bobID, carolID}, nil)
+				m.users.On("Usernames", context.Background(), []uint64{input.UserID, bobID, carolID}).Return(names, nil)
+				m.chat.On("MemberRole", txCtx, input.ChatID, input.UserID).Return(model.RoleMember, nil)
+			},
+		},
+		{
+			name: "user is already a chat member",
+			tx:   true,
+			err:  sl.Err("service.AddMembers", alreadyMemberErr),
+			mocker: func(txCtx context.Context, m membersMocker) {
+				m.users.On("IDs", context.Background(), input.Nicknames).Return([]uint64{bobID, carolID}, nil)
+				m.users.On("Usernames", context.Background(), []uint64{input.UserID, bobID, carolID}).Return(names, nil)
+				m.chat.On("MemberRole", txCtx, input.ChatID, input.UserID).Return(model.RoleOwner, nil)
+				m.chat.On("Chat", txCtx, input.ChatID).Return(group, nil)
+				m.chat.On("AddMembers", txCtx, uint64(input.ChatID), members).Return(alreadyMemberErr)
+			},
+		},
+		{
+			name: "members can not be added to direct chat",
+			tx:   true,
+			err:  sl.Err("service.AddMembers", apperr.FailedPrecondition("members can not be added to direct chat, create a group instead")),
+			mocker: func(txCtx context.Context, m membersMocker) {
+				direct := group
+				direct.Kind = model.ChatKindDirect
+
+				m.users.On("IDs", context.Background(), input.Nicknames).Return([]uint64{bobID, carolID}, nil)
+				m.users.On("Usernames", context.Background(), []uint64{input.UserID, bobID, carolID}).Return(names, nil)
+				m.chat.On("MemberRole", txCtx, input.ChatID, input.UserID).Return(model.RoleOwner, nil)
+				m.chat.On("Chat", txCtx, input.ChatID).Return(direct, nil)
+			},
+		},
+		{
+			name: "user adds only themselves",
+			err:  sl.Err("service.AddMembers", apperr.InvalidArgument("no new members to add")),
+			mocker: func(txCtx context.Context, m membersMocker) {
+				m.users.On("IDs", context.Background(), input.Nicknames).Return([]uint64{input.UserID}, nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			tx := mockpostgres.NewMockTx(t)
+
+			txCtx := postgres.InjectTX(ctx, tx)
+
+			db := mockpostgres.NewMockPostgres(t)
+
+			if tt.tx {
+				if tt.commit {
+					tx.On("Commit", txCtx).Return(nil)
+				} else {
+					tx.On("Rollback", txCtx).Return(nil)
+				}
+
+				db.On("BeginTx", ctx, pgx.TxOptions{IsoLevel: pgx.ReadCommitted}).Return(tx, nil)
+			}
+
+			m := membersMocker{
+				chat:  mockrepository.NewMockChat(t),
+				log:   mockrepository.NewMockLog(t),
+				users: mockclient.NewMockUserDirectory(t),
+			}
+
+			tt.mocker(txCtx, m)
+
+			h := hub.New()
+			sub := h.Subscribe(input.ChatID, gofakeit.Uint64())
+
+			service := chatservice.NewService(postgres.NewTxManager(db), m.chat, nil, nil, nil, m.log, m.users, h, presence.New(time.Minute), config.Chat{})
+
+			err := service.AddMembers(ctx, input)
+
+			require.Equal(t, tt.err, err)
+
+			if tt.commit {
+				require.Equal(t, msg, <-sub.Messages())
+			}
+		})
+	}
+}
+
+func TestService_RemoveMember(t *testing.T) {
+	var (
+		input = converter.RemoveMemberInput{
+			ChatID:   gofakeit.Int64(),
+			UserID:   gofakeit.Uint64(),
+			MemberID: gofakeit.Uint64(),
+		}
+
+		names = map[uint64]string{
+			input.UserID:   "alice",
+			input.MemberID: "bob",
+		}
+
+		msg = model.Message{
+			ID:     gofakeit.Uint64(),
+			ChatID: input.ChatID,
+			UserID: input.UserID,
+			Text:   "alice removed bob",
+			System: true,
+		}
+	)
+
+	tests := []struct {
+		name       string
+		role       model.Role
+		memberRole model.Role
+		commit     bool
+		err        error
+	}{
+		{
+			name:       "owner removes admin",
+			role:       model.RoleOwner,
+			memberRole: model.RoleAdmin,
+			commit:     true,
+			err:        nil,
+		},
+		{
+			name:       "admin removes member",
+			role:       model.RoleAdmin,
+			memberRole: model.RoleMember,
+			commit:     true,
+			err:        nil,
+		},
+		{
+			name:       "admin can not remove another admin",
+			role:       model.RoleAdmin,
+			memberRole: model.RoleAdmin,
+			err:        sl.Err("service.RemoveMember", apperr.PermissionDenied("admins can remove only regular members")),
+		},
+		{
+			name:       "owner can not be removed",
+			role:       model.RoleAdmin,
+			memberRole: model.RoleOwner,
+			err:        sl.Err("service.RemoveMember", apperr.PermissionDenied("chat owner can not be removed")),
+		},
+		{
+			name:       "user is not a chat member",
+			role:       model.RoleOwner,
+			memberRole: "",
+			err:        sl.Err("service.RemoveMember", apperr.NotFound("chat member not found")),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			tx := mockpostgres.NewMockTx(t)
+
+			txCtx := postgres.InjectTX(ctx, tx)
+
+			if tt.commit {
+				tx.On("Commit", txCtx).Return(nil)
+			} else {
+				tx.On("Rollback", txCtx).Return(nil)
+			}
+
+			db := mockpostgres.NewMockPostgres(t)
+			db.On("BeginTx", ctx, pgx.TxOptions{IsoLevel: pgx.ReadCommitted}).Return(tx, nil)
+
+			m := membersMocker{
+				chat:  mockrepository.NewMockChat(t),
+				log:   mockrepository.NewMockLog(t),
+				users: mockclient.NewMockUserDirectory(t),
+			}
+
+			m.users.On("Usernames", ctx, []uint64{input.UserID, input.MemberID}).Return(names, nil)
+			m.chat.On("MemberRole", txCtx, input.ChatID, input.UserID).Return(tt.role, nil)
+			m.chat.On("MemberRole", txCtx, input.ChatID, input.MemberID).Return(tt.memberRole, nil)
+
+			if tt.commit {
+				m.chat.On("RemoveMember", txCtx, input.ChatID, input.MemberID).Return(nil)
+				m.chat.On("SendSystemMessage", txCtx, input.ChatID, input.UserID, msg.Text).Return(msg, nil)
+				m.log.On("Log", txCtx, model.Log{Action: model.LogRemoveMember, UserID: input.UserID}).Return(nil)
+			}
+
+			h := hub.New()
+			sub := h.Subscribe(input.ChatID, input.MemberID)
+
+			service := chatservice.NewService(postgres.NewTxManager(db), m.chat, nil, nil, nil, m.log, m.users, h, presence.New(time.Minute), config.Chat{})
+
+			err := service.RemoveMember(ctx, input)
+
+			require.Equal(t, tt.err, err)
+
+			if !tt.commit {
+				return
+			}
+
+			// Удаленный участник получает сообщение о себе, после чего его стрим закрывается
+			require.Equal(t, msg, <-sub.Messages())
+
+			_, ok := <-sub.Messages()
+			require.False(t, ok)
+			require.ErrorIs(t, sub.Err(), hub.ErrMemberRemoved)
+		})
+	}
+}
+
+func TestService_RemoveMemberSelf(t *testing.T) {
+	userID := gofakeit.Uint64()
+
+	service := chatservice.NewService(nil, nil, nil, nil, nil, nil, nil, hub.New(), nil, config.Chat{})
+
+	err := service.RemoveMember(context.Background(), converter.RemoveMemberInput{
+		ChatID:   gofakeit.Int64(),
+		UserID:   userID,
+		MemberID: userID,
+	})
+
+	require.Equal(t, sl.Err("service.RemoveMember", apperr.InvalidArgument("use LeaveChat to leave the chat")), err)
+}
+
+func TestService_SetMemberAdmin(t *testing.T) {
+	var (
+		chatID = gofakeit.Int64()
+
+		ownerID = gofakeit.Uint64()
+
+		memberID = gofakeit.Uint64()
+
+		names = map[uint64]string{
+			ownerID:  "alice",
+			memberID: "bob",
+		}
+	)
+
+	tests := []struct {
+		name       string
+		admin      bool
+		role       model.Role
+		memberRole model.Role
+		// text пустой, если роль менять не нужно
+		text   string
+		commit bool
+		err    error
+	}{
+		{
+			name:       "owner promotes member",
+			admin:      true,
+			role:       model.RoleOwner,
+			memberRole: model.RoleMember,
+			text:       "alice made bob an admin",
+			commit:     true,
+		},
+		{
+			name:       "owner demotes admin",
+			role:       model.RoleOwner,
+			memberRole: model.RoleAdmin,
+			text:       "alice removed bob from admins",
+			commit:     true,
+		},
+		{
+			name:       "member is already admin",
+			admin:      true,
+			role:       model.RoleOwner,
+			memberRole: model.RoleAdmin,
+			commit:     true,
+		},
+		{
+			name:  "admin can not promote members",
+			admin: true,
+			role:  model.RoleAdmin,
+			err:   sl.Err("service.SetMemberAdmin", apperr.PermissionDenied("action is not allowed for chat admin")),
+		},
+		{
+			name:       "owner role can not be changed",
+			role:       model.RoleOwner,
+			memberRole: model.RoleOwner,
+			err:        sl.Err("service.SetMemberAdmin", apperr.FailedPrecondition("chat owner role can not be changed")),
+		},
+		{
+			name:       "user is not a chat member",
+			admin:      true,
+			role:       model.RoleOwner,
+			memberRole: "",
+			err:        sl.Err("service.SetMemberAdmin", apperr.NotFound("chat member not found")),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			tx := mockpostgres.NewMockTx(t)
+
+			txCtx := postgres.InjectTX(ctx, tx)
+
+			if tt.commit {
+				tx.On("Commit", txCtx).Return(nil)
+			} else {
+				tx.On("Rollback", txCtx).Return(nil)
+			}
+
+			db := mockpostgres.NewMockPostgres(t)
+			db.On("BeginTx", ctx, pgx.TxOptions{IsoLevel: pgx.ReadCommitted}).Return(tx, nil)
+
+			m := membersMocker{
+				chat:  mockrepository.NewMockChat(t),
+				log:   mockrepository.NewMockLog(t),
+				users: mockclient.NewMockUserDirectory(t),
+			}
+
+			m.users.On("Usernames", ctx, []uint64{ownerID, memberID}).Return(names, nil)
+			m.chat.On("MemberRole", txCtx, chatID, ownerID).Return(tt.role, nil)
+
+			if tt.role == model.RoleOwner {
+				m.chat.On("MemberRole", txCtx, chatID, memberID).Return(tt.memberRole, nil)
+			}
+
+			msg := model.Message{
+				ID:     gofakeit.Uint64(),
+				ChatID: chatID,
+				UserID: ownerID,
+				Text:   tt.text,
+				System: true,
+			}
+
+			if tt.text != "" {
+				role := model.RoleMember
+				if tt.admin {
+					role = model.RoleAdmin
+				}
+
+				m.chat.On("SetMemberRole", txCtx, chatID, memberID, role).Return(nil)
+				m.chat.On("SendSystemMessage", txCtx, chatID, ownerID, tt.text).Return(msg, nil)
+				m.log.On("Log", txCtx, model.Log{Action: model.LogSetMemberRole, UserID: ownerID}).Return(nil)
+			}
+
+			h := hub.New()
+
+			sub := h.Subscribe(chatID, memberID)
+			defer h.Unsubscribe(sub)
+
+			service := chatservice.NewService(postgres.NewTxManager(db), m.chat, nil, nil, nil, m.log, m.users, h, nil, config.Chat{})
+
+			err := service.SetMemberAdmin(ctx, converter.SetMemberAdminInput{
+				ChatID:   chatID,
+				UserID:   ownerID,
+				MemberID: memberID,
+				Admin:    tt.admin,
+			})
+
+			require.Equal(t, tt.err, err)
+
+			if tt.text != "" {
+				require.Equal(t, msg, <-sub.Messages())
+			}
+		})
+	}
+}
+
+func TestService_LeaveChat(t *testing.T) {
+	var (
+		input = converter.LeaveChatInput{
+			ChatID: gofakeit.Int64(),
+			UserID: gofakeit.Uint64(),
+		}
+
+		msg = model.Message{
+			ID:     gofakeit.Uint64(),
+			ChatID: input.ChatID,
+			UserID: input.UserID,
+			Text:   "bob left the chat",
+			System: true,
+		}
+	)
+
+	tests := []struct {
+		name   string
+		role   model.Role
+		kind   model.ChatKind
+		commit bool
+		err    error
+	}{
+		{
+			name:   "member leaves chat",
+			role:   model.RoleMember,
+			kind:   model.ChatKindGroup,
+			commit: true,
+			err:    nil,
+		},
+		{
+			name:   "subscriber leaves channel without system message",
+			role:   model.RoleMember,
+			kind:   model.ChatKindChannel,
+			commit: true,
+			err:    nil,
+		},
+		{
+			name: "owner can not leave chat",
+			role: model.RoleOwner,
+			err:  sl.Err("service.LeaveChat", apperr.FailedPrecondition("owner can not leave the chat, delete it instead")),
+		},
+		{
+			name: "user is not a chat member",
+			role: "",
+			err:  sl.Err("service.LeaveChat", apperr.PermissionDenied("user is not a member of the chat")),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			tx := mockpostgres.NewMockTx(t)
+
+			txCtx := postgres.InjectTX(ctx, tx)
+
+			if tt.commit {
+				tx.On("Commit", txCtx).Return(nil)
+			} else {
+				tx.On("Rollback", txCtx).Return(nil)
+			}
+
+			db := mockpostgres.NewMockPostgres(t)
+			db.On("BeginTx", ctx, pgx.TxOptions{IsoLevel: pgx.ReadCommitted}).Return(tx, nil)
+
+			m := membersMocker{
+				chat:  mockrepository.NewMockChat(t),
+				log:   mockrepository.NewMockLog(t),
+				users: mockclient.NewMockUserDirectory(t),
+			}
+
+			m.users.On("Usernames", ctx, []uint64{input.UserID}).Return(map[uint64]string{input.UserID: "bob"}, nil)
+			m.chat.On("MemberRole", txCtx, input.ChatID, input.UserID).Return(tt.role, nil)
+
+			if tt.commit {
+				m.chat.On("Chat", txCtx, input.ChatID).Return(model.Chat{ID: input.ChatID, Kind: tt.kind}, nil)
+				m.chat.On("RemoveMember", txCtx, input.ChatID, input.UserID).Return(nil)
+				m.log.On("Log", txCtx, model.Log{Action: model.LogLeaveChat, UserID: input.UserID}).Return(nil)
+			}
+
+			if tt.commit && tt.kind != model.ChatKindChannel {
+				m.chat.On("SendSystemMessage", txCtx, input.ChatID, input.UserID, msg.Text).Return(msg, nil)
+			}
+
+			h := hub.New()
+			sub := h.Subscribe(input.ChatID, input.UserID)
+
+			service := chatservice.NewService(postgres.NewTxManager(db), m.chat, nil, nil, nil, m.log, m.users, h, presence.New(time.Minute), config.Chat{})
+
+			err := service.LeaveChat(ctx, input)
+
+			require.Equal(t, tt.err, err)
+
+			if !tt.commit {
+				return
+			}
+
+			if tt.kind != model.ChatKindChannel {
+				require.Equal(t, msg, <-sub.Messages())
+			}
+
+			_, ok := <-sub.Messages()
+			require.False(t, ok)
+			require.ErrorIs(t, sub.Err(), hub.ErrMemberRemoved)
+		})
+	}
+}
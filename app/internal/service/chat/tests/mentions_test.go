@@ -0,0 +1,328 @@
+package usertests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit"
+	"github.com/defany/chat-server/app/internal/apperr"
+	"github.com/defany/chat-server/app/internal/config"
+	"github.com/defany/chat-server/app/internal/converter"
+	"github.com/defany/chat-server/app/internal/model"
+	mockrepository "github.com/defany/chat-server/app/internal/repository/mocks"
+	chatservice "github.com/defany/chat-server/app/internal/service/chat"
+	"github.com/defany/chat-server/app/internal/service/hub"
+	"github.com/defany/chat-server/app/pkg/cursor"
+	"github.com/defany/db/pkg/postgres"
+	mockpostgres "github.com/defany/db/pkg/postgres/mocks"
+	"github.com/defany/slogger/pkg/logger/sl"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService_ListMentions(t *testing.T) {
+	var (
+		ctx = context.Background()
+
+		userID = gofakeit.Uint64()
+
+		messages = []model.Message{
+			{ID: 30, ChatID: gofakeit.Int64(), UserID: gofakeit.Uint64(), Text: "bob look"},
+			{ID: 20, ChatID: gofakeit.Int64(), UserID: gofakeit.Uint64(), Text: "bob?"},
+		}
+
+		nextCursor, _ = cursor.Encode(model.MentionCursor{MessageID: messages[0].ID})
+	)
+
+	invalidCursorErr := apperr.Wrap(apperr.CodeInvalidArgument, "invalid cursor", cursor.ErrInvalidCursor)
+	invalidCursorErr.Violations = []apperr.FieldViolation{
+		{Field: "cursor", Description: "cursor must be taken from the previous page"},
+	}
+
+	tests := []struct {
+		name   string
+		input  converter.ListMentionsInput
+		want   converter.ListMentionsOutput
+		err    error
+		mocker func(chatRepo *mockrepository.MockChat, reactionRepo *mockrepository.MockReaction, attachmentRepo *mockrepository.MockAttachment)
+	}{
+		{
+			name:  "first page with next cursor",
+			input: converter.ListMentionsInput{UserID: userID, Limit: 1},
+			want: converter.ListMentionsOutput{
+				Messages:   messages[:1],
+				NextCursor: nextCursor,
+			},
+			mocker: func(chatRepo *mockrepository.MockChat, reactionRepo *mockrepository.MockReaction, attachmentRepo *mockrepository.MockAttachment) {
+				chatRepo.On("ListMentions", ctx, model.MentionsFilter{UserID: userID, Limit: 2}).Return(messages, nil)
+				reactionRepo.On("Counts", ctx, []uint64{messages[0].ID}).Return(map[uint64][]model.ReactionCount{}, nil)
+				attachmentRepo.On("ByMessages", ctx, []uint64{messages[0].ID}).Return(map[uint64][]model.Attachment{}, nil)
+			},
+		},
+		{
+			name:  "last page by cursor",
+			input: converter.ListMentionsInput{UserID: userID, Cursor: nextCursor},
+			want: converter.ListMentionsOutput{
+				Messages: messages[1:],
+			},
+			mocker: func(chatRepo *mockrepository.MockChat, reactionRepo *mockrepository.MockReaction, attachmentRepo *mockrepository.MockAttachment) {
+				chatRepo.On("ListMentions", ctx, model.MentionsFilter{
+					UserID: userID,
+					Cursor: &model.MentionCursor{MessageID: messages[0].ID},
+					Limit:  21,
+				}).Return(messages[1:], nil)
+				reactionRepo.On("Counts", ctx, []uint64{messages[1].ID}).Return(map[uint64][]model.ReactionCount{}, nil)
+				attachmentRepo.On("ByMessages", ctx, []uint64{messages[1].ID}).Return(map[uint64][]model.Attachment{}, nil)
+			},
+		},
+		{
+			name:  "invalid cursor",
+			input: converter.ListMentionsInput{UserID: userID, Cursor: "not a cursor"},
+			err:   sl.Err("service.ListMentions", invalidCursorErr),
+			mocker: func(chatRepo *mockrepository.MockChat, reactionRepo *mockrepository.MockReaction, attachmentRepo *mockrepository.MockAttachment) {
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			chatRepo := mockrepository.NewMockChat(t)
+			reactionRepo := mockrepository.NewMockReaction(t)
+			attachmentRepo := mockrepository.NewMockAttachment(t)
+
+			tt.mocker(chatRepo, reactionRepo, attachmentRepo)
+
+			service := chatservice.NewService(nil, chatRepo, reactionRepo, attachmentRepo, nil, nil, nil, hub.New(), nil, config.Chat{})
+
+			output, err := service.ListMentions(ctx, tt.input)
+
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.want, output)
+		})
+	}
+}
+
+func TestService_SendMessageWithMentions(t *testing.T) {
+	var (
+		from   = gofakeit.Uint64()
+		peerID = gofakeit.Uint64()
+
+		// Себя упоминать можно, но в таблицу упоминаний автор не попадает
+		input = converter.SendMessageInput{
+			ChatID: gofakeit.Int64(),
+			From:   from,
+			Text:   "bob and me",
+			Entities: []model.MessageEntity{
+				{Type: model.EntityMention, Offset: 8, Length: 2, UserID: from},
+				{Type: model.EntityMention, Offset: 0, Length: 3, UserID: peerID},
+			},
+		}
+	)
+
+	parsed := converter.SendMessageInput{
+		ChatID: input.ChatID,
+		From:   input.From,
+		Text:   input.Text,
+		Entities: []model.MessageEntity{
+			{Type: model.EntityMention, Offset: 0, Length: 3, UserID: peerID},
+			{Type: model.EntityMention, Offset: 8, Length: 2, UserID: input.From},
+		},
+	}
+
+	stored := model.Message{
+		ID:       gofakeit.Uint64(),
+		ChatID:   input.ChatID,
+		UserID:   input.From,
+		Text:     input.Text,
+		Entities: parsed.Entities,
+	}
+
+	tests := []struct {
+		name      string
+		commit    bool
+		mentioned []uint64
+		want      model.Message
+		err       error
+	}{
+		{
+			name:      "mentioned chat member",
+			commit:    true,
+			mentioned: []uint64{peerID},
+			want:      stored,
+		},
+		{
+			name: "mentioned user is not in the chat",
+			err: sl.Err("service.SendMessage", apperr.InvalidArgument("mentioned user is not a chat member", apperr.FieldViolation{
+				Field:       "content.entities",
+				Description: "only chat members can be mentioned",
+			})),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			tx := mockpostgres.NewMockTx(t)
+
+			txCtx := postgres.InjectTX(ctx, tx)
+
+			if tt.commit {
+				tx.On("Commit", txCtx).Return(nil)
+			} else {
+				tx.On("Rollback", txCtx).Return(nil)
+			}
+
+			db := mockpostgres.NewMockPostgres(t)
+			db.On("BeginTx", ctx, pgx.TxOptions{IsoLevel: pgx.ReadCommitted}).Return(tx, nil)
+
+			chatRepo := mockrepository.NewMockChat(t)
+			notificationRepo := mockrepository.NewMockNotification(t)
+			logRepo := mockrepository.NewMockLog(t)
+
+			chatRepo.On("MemberRole", txCtx, input.ChatID, input.From).Return(model.RoleOwner, nil)
+			chatRepo.On("SendMessage", txCtx, parsed).Return(stored, nil)
+			chatRepo.On("SetMentions", txCtx, stored.ID, input.ChatID, []uint64{peerID}).Return(tt.mentioned, nil)
+
+			if tt.commit {
+				// Автор себя не уведомляет, поэтому в outbox уходит только собеседник
+				notificationRepo.On("Enqueue", txCtx, stored, []uint64{peerID}).Return(nil)
+				logRepo.On("Log", txCtx, model.Log{Action: model.LogSendMessage, UserID: input.From}).Return(nil)
+			}
+
+			service := chatservice.NewService(postgres.NewTxManager(db), chatRepo, nil, nil, notificationRepo, logRepo, nil, hub.New(), nil, config.Chat{})
+
+			msg, err := service.SendMessage(ctx, input)
+
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.want, msg)
+		})
+	}
+}
+
+func TestService_EditMessageDropsMentions(t *testing.T) {
+	var (
+		ctx = context.Background()
+
+		input = converter.EditMessageInput{
+			ChatID:    gofakeit.Int64(),
+			MessageID: gofakeit.Uint64(),
+			UserID:    gofakeit.Uint64(),
+			Text:      "nobody here",
+		}
+
+		current = model.Message{
+			ID:        input.MessageID,
+			ChatID:    input.ChatID,
+			UserID:    input.UserID,
+			Text:      "bob here",
+			Timestamp: time.Now(),
+			Entities: []model.MessageEntity{
+				{Type: model.EntityMention, Offset: 0, Length: 3, UserID: gofakeit.Uint64()},
+			},
+		}
+
+		edited = model.Message{
+			ID:     input.MessageID,
+			ChatID: input.ChatID,
+			UserID: input.UserID,
+			Text:   input.Text,
+		}
+	)
+
+	tx := mockpostgres.NewMockTx(t)
+
+	txCtx := postgres.InjectTX(ctx, tx)
+
+	tx.On("Commit", txCtx).Return(nil)
+
+	db := mockpostgres.NewMockPostgres(t)
+	db.On("BeginTx", ctx, pgx.TxOptions{IsoLevel: pgx.ReadCommitted}).Return(tx, nil)
+
+	chatRepo := mockrepository.NewMockChat(t)
+	logRepo := mockrepository.NewMockLog(t)
+
+	chatRepo.On("MemberRole", txCtx, input.ChatID, input.UserID).Return(model.RoleMember, nil)
+	chatRepo.On("MessageForUpdate", txCtx, input.ChatID, input.MessageID).Return(current, nil)
+	chatRepo.On("AddMessageRevision", txCtx, current.ID, current.Text, current.Entities).Return(nil)
+	chatRepo.On("EditMessage", txCtx, current.ID, input.Text, []model.MessageEntity(nil)).Return(edited, nil)
+	// Упоминание пропало из текста, значит и из таблицы упоминаний его надо убрать
+	chatRepo.On("SetMentions", txCtx, current.ID, input.ChatID, []uint64(nil)).Return(nil, nil)
+	logRepo.On("Log", txCtx, model.Log{Action: model.LogEditMessage, UserID: input.UserID}).Return(nil)
+
+	service := chatservice.NewService(postgres.NewTxManager(db), chatRepo, nil, nil, nil, logRepo, nil, hub.New(), nil, config.Chat{})
+
+	msg, err := service.EditMessage(ctx, input)
+
+	require.NoError(t, err)
+	require.Equal(t, edited, msg)
+}
+
+func TestService_EditMessageNotifiesNewMentions(t *testing.T) {
+	var (
+		ctx = context.Background()
+
+		bobID   = gofakeit.Uint64()
+		carolID = gofakeit.Uint64()
+
+		input = converter.EditMessageInput{
+			ChatID:    gofakeit.Int64(),
+			MessageID: gofakeit.Uint64(),
+			UserID:    gofakeit.Uint64(),
+			Text:      "bob and carol",
+			Entities: []model.MessageEntity{
+				{Type: model.EntityMention, Offset: 0, Length: 3, UserID: bobID},
+				{Type: model.EntityMention, Offset: 8, Length: 5, UserID: carolID},
+			},
+		}
+
+		current = model.Message{
+			ID:        input.MessageID,
+			ChatID:    input.ChatID,
+			UserID:    input.UserID,
+			Text:      "bob here",
+			Timestamp: time.Now(),
+			Entities: []model.MessageEntity{
+				{Type: model.EntityMention, Offset: 0, Length: 3, UserID: bobID},
+			},
+		}
+
+		edited = model.Message{
+			ID:       input.MessageID,
+			ChatID:   input.ChatID,
+			UserID:   input.UserID,
+			Text:     input.Text,
+			Entities: input.Entities,
+		}
+	)
+
+	tx := mockpostgres.NewMockTx(t)
+
+	txCtx := postgres.InjectTX(ctx, tx)
+
+	tx.On("Commit", txCtx).Return(nil)
+
+	db := mockpostgres.NewMockPostgres(t)
+	db.On("BeginTx", ctx, pgx.TxOptions{IsoLevel: pgx.ReadCommitted}).Return(tx, nil)
+
+	chatRepo := mockrepository.NewMockChat(t)
+	notificationRepo := mockrepository.NewMockNotification(t)
+	logRepo := mockrepository.NewMockLog(t)
+
+	chatRepo.On("MemberRole", txCtx, input.ChatID, input.UserID).Return(model.RoleMember, nil)
+	chatRepo.On("MessageForUpdate", txCtx, input.ChatID, input.MessageID).Return(current, nil)
+	chatRepo.On("AddMessageRevision", txCtx, current.ID, current.Text, current.Entities).Return(nil)
+	chatRepo.On("EditMessage", txCtx, current.ID, input.Text, input.Entities).Return(edited, nil)
+	chatRepo.On("SetMentions", txCtx, current.ID, input.ChatID, []uint64{bobID, carolID}).Return([]uint64{bobID, carolID}, nil)
+	// Боба уже уведомили при отправке, уведомление уходит только Кэрол
+	notificationRepo.On("EnqueueMentions", txCtx, edited, []uint64{carolID}).Return(nil)
+	logRepo.On("Log", txCtx, model.Log{Action: model.LogEditMessage, UserID: input.UserID}).Return(nil)
+
+	service := chatservice.NewService(postgres.NewTxManager(db), chatRepo, nil, nil, notificationRepo, logRepo, nil, hub.New(), nil, config.Chat{})
+
+	msg, err := service.EditMessage(ctx, input)
+
+	require.NoError(t, err)
+	require.Equal(t, edited, msg)
+}
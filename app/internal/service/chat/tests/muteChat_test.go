@@ -0,0 +1,75 @@
+package usertests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/brianvoe/gofakeit"
+	"github.com/defany/chat-server/app/internal/apperr"
+	"github.com/defany/chat-server/app/internal/config"
+	"github.com/defany/chat-server/app/internal/converter"
+	"github.com/defany/chat-server/app/internal/model"
+	mockrepository "github.com/defany/chat-server/app/internal/repository/mocks"
+	chatservice "github.com/defany/chat-server/app/internal/service/chat"
+	"github.com/defany/chat-server/app/internal/service/hub"
+	"github.com/defany/slogger/pkg/logger/sl"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService_MuteChat(t *testing.T) {
+	var (
+		ctx = context.Background()
+
+		input = converter.MuteChatInput{
+			ChatID: gofakeit.Int64(),
+			UserID: gofakeit.Uint64(),
+			Muted:  true,
+		}
+	)
+
+	tests := []struct {
+		name   string
+		input  converter.MuteChatInput
+		err    error
+		mocker func(chatRepo *mockrepository.MockChat)
+	}{
+		{
+			name:  "member mutes chat",
+			input: input,
+			mocker: func(chatRepo *mockrepository.MockChat) {
+				chatRepo.On("MemberRole", ctx, input.ChatID, input.UserID).Return(model.RoleMember, nil)
+				chatRepo.On("SetMuted", ctx, input.ChatID, input.UserID, true).Return(nil)
+			},
+		},
+		{
+			name:  "member unmutes chat",
+			input: converter.MuteChatInput{ChatID: input.ChatID, UserID: input.UserID},
+			mocker: func(chatRepo *mockrepository.MockChat) {
+				chatRepo.On("MemberRole", ctx, input.ChatID, input.UserID).Return(model.RoleMember, nil)
+				chatRepo.On("SetMuted", ctx, input.ChatID, input.UserID, false).Return(nil)
+			},
+		},
+		{
+			name:  "outsider can not mute chat",
+			input: input,
+			err:   sl.Err("service.MuteChat", apperr.PermissionDenied("user is not a member of the chat")),
+			mocker: func(chatRepo *mockrepository.MockChat) {
+				chatRepo.On("MemberRole", ctx, input.ChatID, input.UserID).Return(model.Role(""), nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			chatRepo := mockrepository.NewMockChat(t)
+
+			tt.mocker(chatRepo)
+
+			service := chatservice.NewService(nil, chatRepo, nil, nil, nil, nil, nil, hub.New(), nil, config.Chat{})
+
+			err := service.MuteChat(ctx, tt.input)
+
+			require.Equal(t, tt.err, err)
+		})
+	}
+}
@@ -0,0 +1,179 @@
+package usertests
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit"
+	"github.com/defany/chat-server/app/internal/apperr"
+	"github.com/defany/chat-server/app/internal/config"
+	"github.com/defany/chat-server/app/internal/converter"
+	"github.com/defany/chat-server/app/internal/model"
+	mockrepository "github.com/defany/chat-server/app/internal/repository/mocks"
+	chatservice "github.com/defany/chat-server/app/internal/service/chat"
+	"github.com/defany/chat-server/app/internal/service/presence"
+	"github.com/defany/slogger/pkg/logger/sl"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type presenceStream struct {
+	inputs chan converter.PresenceInput
+	events chan model.PresenceEvent
+}
+
+func newPresenceStream() *presenceStream {
+	return &presenceStream{
+		inputs: make(chan converter.PresenceInput, 8),
+		events: make(chan model.PresenceEvent, 64),
+	}
+}
+
+func (s *presenceStream) recv() (converter.PresenceInput, error) {
+	input, ok := <-s.inputs
+	if !ok {
+		return converter.PresenceInput{}, io.EOF
+	}
+
+	return input, nil
+}
+
+func (s *presenceStream) send(event model.PresenceEvent) error {
+	s.events <- event
+
+	return nil
+}
+
+func (s *presenceStream) next(t *testing.T) model.PresenceEvent {
+	t.Helper()
+
+	select {
+	case event := <-s.events:
+		return event
+	case <-time.After(5 * time.Second):
+		t.Fatal("presence event was not received")
+	}
+
+	return model.PresenceEvent{}
+}
+
+func TestService_PresenceFailed(t *testing.T) {
+	var (
+		chatID = gofakeit.Int64()
+		userID = gofakeit.Uint64()
+	)
+
+	tests := []struct {
+		name   string
+		inputs []converter.PresenceInput
+		role   model.Role
+		err    error
+	}{
+		{
+			name: "failed to join because user is not a chat member",
+			inputs: []converter.PresenceInput{
+				{ChatID: chatID, Action: model.PresenceActionJoin},
+			},
+			role: "",
+			err:  sl.Err("service.Presence", apperr.PermissionDenied("user is not a member of the chat")),
+		},
+		{
+			name: "failed to type in chat that was not joined",
+			inputs: []converter.PresenceInput{
+				{ChatID: chatID, Action: model.PresenceActionTypingStart},
+			},
+			err: sl.Err("service.Presence", apperr.FailedPrecondition("join chat presence before sending typing events")),
+		},
+		{
+			name: "stream ends when client closes it",
+			inputs: []converter.PresenceInput{
+				{ChatID: chatID, Action: model.PresenceActionJoin},
+				{ChatID: chatID, Action: model.PresenceActionLeave},
+			},
+			role: model.RoleMember,
+			err:  nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			chatRepo := mockrepository.NewMockChat(t)
+			if tt.inputs[0].Action == model.PresenceActionJoin {
+				chatRepo.On("MemberRole", mock.Anything, chatID, userID).Return(tt.role, nil)
+			}
+
+			service := chatservice.NewService(nil, chatRepo, nil, nil, nil, nil, nil, nil, presence.New(time.Minute), config.Chat{})
+
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+
+			stream := newPresenceStream()
+			for _, input := range tt.inputs {
+				stream.inputs <- input
+			}
+
+			close(stream.inputs)
+
+			err := service.Presence(ctx, userID, stream.recv, stream.send)
+
+			require.Equal(t, tt.err, err)
+			require.NoError(t, ctx.Err())
+		})
+	}
+}
+
+func TestService_PresenceTyping(t *testing.T) {
+	var (
+		chatID = gofakeit.Int64()
+
+		watcherID = gofakeit.Uint64()
+		typistID  = gofakeit.Uint64()
+	)
+
+	chatRepo := mockrepository.NewMockChat(t)
+	chatRepo.On("MemberRole", mock.Anything, chatID, watcherID).Return(model.RoleMember, nil)
+	chatRepo.On("MemberRole", mock.Anything, chatID, typistID).Return(model.RoleMember, nil)
+
+	service := chatservice.NewService(nil, chatRepo, nil, nil, nil, nil, nil, nil, presence.New(50*time.Millisecond), config.Chat{})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	watcher := newPresenceStream()
+	typist := newPresenceStream()
+
+	watcherDone := make(chan error, 1)
+	typistDone := make(chan error, 1)
+
+	go func() {
+		watcherDone <- service.Presence(ctx, watcherID, watcher.recv, watcher.send)
+	}()
+
+	go func() {
+		typistDone <- service.Presence(ctx, typistID, typist.recv, typist.send)
+	}()
+
+	watcher.inputs <- converter.PresenceInput{ChatID: chatID, Action: model.PresenceActionJoin}
+	typist.inputs <- converter.PresenceInput{ChatID: chatID, Action: model.PresenceActionJoin}
+
+	// Неважно, кто зашел первым: наблюдатель получит либо снимок, либо событие о входе
+	require.Equal(t, model.PresenceEvent{ChatID: chatID, UserID: typistID, Online: true}, watcher.next(t))
+
+	typist.inputs <- converter.PresenceInput{ChatID: chatID, Action: model.PresenceActionTypingStart}
+
+	require.Equal(t, model.PresenceEvent{ChatID: chatID, UserID: typistID, Online: true, Typing: true}, watcher.next(t))
+
+	// Клиент не повторил статус, он сбрасывается по ttl
+	require.Equal(t, model.PresenceEvent{ChatID: chatID, UserID: typistID, Online: true}, watcher.next(t))
+
+	close(typist.inputs)
+
+	require.NoError(t, <-typistDone)
+	require.Equal(t, model.PresenceEvent{ChatID: chatID, UserID: typistID}, watcher.next(t))
+
+	close(watcher.inputs)
+
+	require.NoError(t, <-watcherDone)
+}
@@ -0,0 +1,96 @@
+package usertests
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/defany/chat-server/app/internal/config"
+	mockrepository "github.com/defany/chat-server/app/internal/repository/mocks"
+	chatservice "github.com/defany/chat-server/app/internal/service/chat"
+	"github.com/defany/chat-server/app/internal/service/hub"
+	"github.com/defany/db/pkg/postgres"
+	mockpostgres "github.com/defany/db/pkg/postgres/mocks"
+	"github.com/defany/slogger/pkg/logger/sl"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService_PurgeDeletedChats(t *testing.T) {
+	const batchSize = 2
+
+	err := errors.New("failed to purge chats")
+
+	tests := []struct {
+		name    string
+		batches [][]int64
+		err     error
+		want    int64
+		wantErr error
+	}{
+		{
+			name:    "purges batches until the last incomplete one",
+			batches: [][]int64{{1, 2}, {3, 4}, {5}},
+			want:    5,
+		},
+		{
+			name:    "nothing to purge",
+			batches: [][]int64{nil},
+			want:    0,
+		},
+		{
+			name:    "repository error stops purging",
+			batches: [][]int64{{1, 2}},
+			err:     err,
+			want:    batchSize,
+			wantErr: sl.Err("service.PurgeDeletedChats", err),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			tx := mockpostgres.NewMockTx(t)
+
+			txCtx := postgres.InjectTX(ctx, tx)
+
+			db := mockpostgres.NewMockPostgres(t)
+			db.On("BeginTx", ctx, pgx.TxOptions{IsoLevel: pgx.ReadCommitted}).Return(tx, nil)
+
+			chatRepo := mockrepository.NewMockChat(t)
+			attachmentRepo := mockrepository.NewMockAttachment(t)
+
+			for _, expired := range tt.batches {
+				chatRepo.On("ExpiredDeleted", txCtx, time.Hour, uint64(batchSize)).Return(expired, nil).Once()
+
+				if len(expired) == 0 {
+					continue
+				}
+
+				// Файлы вложений должны попасть в очередь на удаление до того, как каскад снесет их строки
+				chatRepo.On("PurgeDeleted", txCtx, expired).Return(nil).Once().NotBefore(
+					attachmentRepo.On("OrphanChats", txCtx, expired).Return(nil).Once(),
+				)
+			}
+
+			tx.On("Commit", txCtx).Return(nil).Times(len(tt.batches))
+
+			if tt.err != nil {
+				chatRepo.On("ExpiredDeleted", txCtx, time.Hour, uint64(batchSize)).Return([]int64(nil), tt.err).Once()
+				tx.On("Rollback", txCtx).Return(nil).Once()
+			}
+
+			service := chatservice.NewService(postgres.NewTxManager(db), chatRepo, nil, attachmentRepo, nil, nil, nil, hub.New(), nil, config.Chat{
+				DeletedRetention: time.Hour,
+				PurgeBatchSize:   batchSize,
+			})
+
+			purged, err := service.PurgeDeletedChats(ctx)
+
+			require.Equal(t, tt.wantErr, err)
+			require.Equal(t, tt.want, purged)
+		})
+	}
+}
@@ -0,0 +1,122 @@
+package usertests
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/brianvoe/gofakeit"
+	"github.com/defany/chat-server/app/internal/apperr"
+	"github.com/defany/chat-server/app/internal/config"
+	"github.com/defany/chat-server/app/internal/converter"
+	"github.com/defany/chat-server/app/internal/model"
+	mockrepository "github.com/defany/chat-server/app/internal/repository/mocks"
+	chatservice "github.com/defany/chat-server/app/internal/service/chat"
+	"github.com/defany/chat-server/app/internal/service/hub"
+	"github.com/defany/slogger/pkg/logger/sl"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService_MarkRead(t *testing.T) {
+	var (
+		ctx = context.Background()
+
+		input = converter.MarkReadInput{
+			ChatID:    gofakeit.Int64(),
+			MessageID: gofakeit.Uint64(),
+			UserID:    gofakeit.Uint64(),
+		}
+
+		notFoundErr = apperr.NotFound("message not found")
+	)
+
+	tests := []struct {
+		name   string
+		err    error
+		mocker func(chatRepo *mockrepository.MockChat)
+	}{
+		{
+			name: "member moves read marker",
+			mocker: func(chatRepo *mockrepository.MockChat) {
+				chatRepo.On("MemberRole", ctx, input.ChatID, input.UserID).Return(model.RoleMember, nil)
+				chatRepo.On("Message", ctx, input.ChatID, input.MessageID).Return(model.Message{ID: input.MessageID}, nil)
+				chatRepo.On("MarkRead", ctx, input.ChatID, input.UserID, input.MessageID).Return(nil)
+			},
+		},
+		{
+			name: "message from another chat can not be marked as read",
+			err:  sl.Err("service.MarkRead", notFoundErr),
+			mocker: func(chatRepo *mockrepository.MockChat) {
+				chatRepo.On("MemberRole", ctx, input.ChatID, input.UserID).Return(model.RoleMember, nil)
+				chatRepo.On("Message", ctx, input.ChatID, input.MessageID).Return(model.Message{}, notFoundErr)
+			},
+		},
+		{
+			name: "outsider can not mark chat as read",
+			err:  sl.Err("service.MarkRead", apperr.PermissionDenied("user is not a member of the chat")),
+			mocker: func(chatRepo *mockrepository.MockChat) {
+				chatRepo.On("MemberRole", ctx, input.ChatID, input.UserID).Return(model.Role(""), nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			chatRepo := mockrepository.NewMockChat(t)
+
+			tt.mocker(chatRepo)
+
+			service := chatservice.NewService(nil, chatRepo, nil, nil, nil, nil, nil, hub.New(), nil, config.Chat{})
+
+			err := service.MarkRead(ctx, input)
+
+			require.Equal(t, tt.err, err)
+		})
+	}
+}
+
+func TestService_GetUnreadCounts(t *testing.T) {
+	var (
+		ctx = context.Background()
+
+		userID = gofakeit.Uint64()
+
+		counts = []model.UnreadCount{
+			{ChatID: 1, Count: 3, LastReadMessageID: 10},
+			{ChatID: 2, Count: 0, LastReadMessageID: 0},
+		}
+
+		err = errors.New("failed to count unread messages")
+	)
+
+	tests := []struct {
+		name    string
+		counts  []model.UnreadCount
+		repoErr error
+		err     error
+	}{
+		{
+			name:   "counts for all chats of the user",
+			counts: counts,
+		},
+		{
+			name:    "chat repository returned an error",
+			repoErr: err,
+			err:     sl.Err("service.GetUnreadCounts", err),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			chatRepo := mockrepository.NewMockChat(t)
+			chatRepo.On("UnreadCounts", ctx, userID).Return(tt.counts, tt.repoErr)
+
+			service := chatservice.NewService(nil, chatRepo, nil, nil, nil, nil, nil, hub.New(), nil, config.Chat{})
+
+			got, err := service.GetUnreadCounts(ctx, userID)
+
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.counts, got)
+		})
+	}
+}
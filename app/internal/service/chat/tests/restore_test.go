@@ -0,0 +1,111 @@
+package usertests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit"
+	"github.com/defany/chat-server/app/internal/apperr"
+	"github.com/defany/chat-server/app/internal/config"
+	"github.com/defany/chat-server/app/internal/converter"
+	"github.com/defany/chat-server/app/internal/model"
+	mockrepository "github.com/defany/chat-server/app/internal/repository/mocks"
+	chatservice "github.com/defany/chat-server/app/internal/service/chat"
+	"github.com/defany/chat-server/app/internal/service/hub"
+	"github.com/defany/db/pkg/postgres"
+	mockpostgres "github.com/defany/db/pkg/postgres/mocks"
+	"github.com/defany/slogger/pkg/logger/sl"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService_RestoreChat(t *testing.T) {
+	type mocker struct {
+		chat *mockrepository.MockChat
+		log  *mockrepository.MockLog
+	}
+
+	var (
+		input = converter.RestoreChatInput{
+			ChatID: gofakeit.Int64(),
+			UserID: gofakeit.Uint64(),
+		}
+
+		retentionErr = apperr.FailedPrecondition("chat retention period is over")
+	)
+
+	tests := []struct {
+		name   string
+		commit bool
+		err    error
+		mocker func(txCtx context.Context, m mocker)
+	}{
+		{
+			name:   "owner restores deleted chat",
+			commit: true,
+			err:    nil,
+			mocker: func(txCtx context.Context, m mocker) {
+				m.chat.On("DeletedMemberRole", txCtx, input.ChatID, input.UserID).Return(model.RoleOwner, nil)
+				m.chat.On("Restore", txCtx, input.ChatID, 24*time.Hour).Return(nil)
+				m.log.On("Log", txCtx, model.Log{Action: model.LogRestoreChat, UserID: input.UserID}).Return(nil)
+			},
+		},
+		{
+			name: "chat is not deleted or user never was a member",
+			err:  sl.Err("service.RestoreChat", apperr.NotFound("deleted chat not found")),
+			mocker: func(txCtx context.Context, m mocker) {
+				m.chat.On("DeletedMemberRole", txCtx, input.ChatID, input.UserID).Return(model.Role(""), nil)
+			},
+		},
+		{
+			name: "admin can not restore chat",
+			err:  sl.Err("service.RestoreChat", apperr.PermissionDenied("only chat owner can restore chat")),
+			mocker: func(txCtx context.Context, m mocker) {
+				m.chat.On("DeletedMemberRole", txCtx, input.ChatID, input.UserID).Return(model.RoleAdmin, nil)
+			},
+		},
+		{
+			name: "retention period is over",
+			err:  sl.Err("service.RestoreChat", retentionErr),
+			mocker: func(txCtx context.Context, m mocker) {
+				m.chat.On("DeletedMemberRole", txCtx, input.ChatID, input.UserID).Return(model.RoleOwner, nil)
+				m.chat.On("Restore", txCtx, input.ChatID, 24*time.Hour).Return(retentionErr)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			tx := mockpostgres.NewMockTx(t)
+
+			txCtx := postgres.InjectTX(ctx, tx)
+
+			if tt.commit {
+				tx.On("Commit", txCtx).Return(nil)
+			} else {
+				tx.On("Rollback", txCtx).Return(nil)
+			}
+
+			db := mockpostgres.NewMockPostgres(t)
+			db.On("BeginTx", ctx, pgx.TxOptions{IsoLevel: pgx.ReadCommitted}).Return(tx, nil)
+
+			m := mocker{
+				chat: mockrepository.NewMockChat(t),
+				log:  mockrepository.NewMockLog(t),
+			}
+
+			tt.mocker(txCtx, m)
+
+			service := chatservice.NewService(postgres.NewTxManager(db), m.chat, nil, nil, nil, m.log, nil, hub.New(), nil, config.Chat{
+				DeletedRetention: 24 * time.Hour,
+			})
+
+			err := service.RestoreChat(ctx, input)
+
+			require.Equal(t, tt.err, err)
+		})
+	}
+}
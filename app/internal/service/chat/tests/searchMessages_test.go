@@ -0,0 +1,144 @@
+package usertests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/brianvoe/gofakeit"
+	"github.com/defany/chat-server/app/internal/apperr"
+	"github.com/defany/chat-server/app/internal/config"
+	"github.com/defany/chat-server/app/internal/converter"
+	"github.com/defany/chat-server/app/internal/model"
+	mockrepository "github.com/defany/chat-server/app/internal/repository/mocks"
+	chatservice "github.com/defany/chat-server/app/internal/service/chat"
+	"github.com/defany/chat-server/app/internal/service/hub"
+	"github.com/defany/chat-server/app/pkg/cursor"
+	"github.com/defany/slogger/pkg/logger/sl"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService_SearchMessages(t *testing.T) {
+	var (
+		ctx = context.Background()
+
+		userID = gofakeit.Uint64()
+		chatID = gofakeit.Int64()
+
+		query = "release notes"
+
+		hits = []model.SearchHit{
+			{
+				Message: model.Message{
+					ID:     gofakeit.Uint64(),
+					ChatID: chatID,
+					Text:   "release notes are ready",
+				},
+				Snippet: "<b>release</b> <b>notes</b> are ready",
+				Rank:    0.09,
+			},
+			{
+				Message: model.Message{
+					ID:     gofakeit.Uint64(),
+					ChatID: chatID + 1,
+					Text:   "where are the notes for release",
+				},
+				Snippet: "where are the <b>notes</b> for <b>release</b>",
+				Rank:    0.06,
+			},
+		}
+
+		nextCursor, _ = cursor.Encode(model.SearchCursor{
+			Rank: hits[0].Rank,
+			ID:   hits[0].Message.ID,
+		})
+
+		after = model.SearchCursor{
+			Rank: 0.07,
+			ID:   gofakeit.Uint64(),
+		}
+
+		afterCursor, _ = cursor.Encode(after)
+	)
+
+	invalidCursorErr := apperr.Wrap(apperr.CodeInvalidArgument, "invalid cursor", cursor.ErrInvalidCursor)
+	invalidCursorErr.Violations = []apperr.FieldViolation{
+		{Field: "cursor", Description: "cursor must be taken from the previous page"},
+	}
+
+	tests := []struct {
+		name   string
+		input  converter.SearchMessagesInput
+		want   converter.SearchMessagesOutput
+		err    error
+		mocker func(repo *mockrepository.MockChat)
+	}{
+		{
+			name: "search in all chats with next cursor",
+			input: converter.SearchMessagesInput{
+				UserID: userID,
+				Query:  query,
+				Limit:  1,
+			},
+			want: converter.SearchMessagesOutput{
+				Hits:       hits[:1],
+				NextCursor: nextCursor,
+			},
+			mocker: func(repo *mockrepository.MockChat) {
+				repo.On("SearchMessages", ctx, model.SearchFilter{UserID: userID, Query: query, Limit: 2}).Return(hits, nil)
+			},
+		},
+		{
+			name: "last page in one chat",
+			input: converter.SearchMessagesInput{
+				UserID: userID,
+				ChatID: chatID,
+				Query:  query,
+				Cursor: afterCursor,
+			},
+			want: converter.SearchMessagesOutput{
+				Hits: hits[1:],
+			},
+			mocker: func(repo *mockrepository.MockChat) {
+				repo.On("MemberRole", ctx, chatID, userID).Return(model.RoleMember, nil)
+				repo.On("SearchMessages", ctx, model.SearchFilter{UserID: userID, ChatID: chatID, Query: query, Cursor: &after, Limit: 21}).Return(hits[1:], nil)
+			},
+		},
+		{
+			name: "search in a chat of someone else",
+			input: converter.SearchMessagesInput{
+				UserID: userID,
+				ChatID: chatID,
+				Query:  query,
+			},
+			err: sl.Err("service.SearchMessages", apperr.PermissionDenied("user is not a member of the chat")),
+			mocker: func(repo *mockrepository.MockChat) {
+				repo.On("MemberRole", ctx, chatID, userID).Return(model.Role(""), nil)
+			},
+		},
+		{
+			name: "invalid cursor",
+			input: converter.SearchMessagesInput{
+				UserID: userID,
+				Query:  query,
+				Cursor: "not a cursor",
+			},
+			err:    sl.Err("service.SearchMessages", invalidCursorErr),
+			mocker: func(repo *mockrepository.MockChat) {},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := mockrepository.NewMockChat(t)
+
+			tt.mocker(repo)
+
+			service := chatservice.NewService(nil, repo, nil, nil, nil, nil, nil, hub.New(), nil, config.Chat{})
+
+			output, err := service.SearchMessages(ctx, tt.input)
+
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.want, output)
+		})
+	}
+}
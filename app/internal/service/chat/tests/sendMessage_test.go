@@ -6,11 +6,15 @@ import (
 	"testing"
 
 	"github.com/brianvoe/gofakeit"
+	"github.com/defany/chat-server/app/internal/apperr"
+	"github.com/defany/chat-server/app/internal/auth"
+	"github.com/defany/chat-server/app/internal/config"
 	"github.com/defany/chat-server/app/internal/converter"
 	"github.com/defany/chat-server/app/internal/model"
 	"github.com/defany/chat-server/app/internal/repository"
 	mockrepository "github.com/defany/chat-server/app/internal/repository/mocks"
 	chatservice "github.com/defany/chat-server/app/internal/service/chat"
+	"github.com/defany/chat-server/app/internal/service/hub"
 	chatv1 "github.com/defany/chat-server/app/pkg/gen/chat/v1"
 	"github.com/defany/db/pkg/postgres"
 	mockpostgres "github.com/defany/db/pkg/postgres/mocks"
@@ -29,9 +33,10 @@ func TestService_SuccessSendMessage(t *testing.T) {
 	}
 
 	type mocker struct {
-		txManager postgres.TxManager
-		chat      repository.Chat
-		log       repository.Log
+		txManager     postgres.TxManager
+		chat          repository.Chat
+		notifications repository.Notification
+		log           repository.Log
 	}
 
 	var (
@@ -44,17 +49,25 @@ func TestService_SuccessSendMessage(t *testing.T) {
 
 		req = &chatv1.SendMessageRequest{
 			ChatId:    chatID,
-			From:      int64(userID),
 			Text:      text,
 			Timestamp: timestamppb.New(timestamp),
 		}
 
-		sendMessageInput = converter.ToSendMessageInput(req)
+		sendMessageInput = converter.ToSendMessageInput(auth.InjectUserID(context.Background(), userID), req)
 
 		logCreateInput = model.Log{
 			Action: model.LogSendMessage,
 			UserID: userID,
 		}
+
+		storedMessage = model.Message{
+			ID:           gofakeit.Uint64(),
+			ChatID:       chatID,
+			UserID:       userID,
+			Text:         text,
+			Timestamp:    gofakeit.Date(),
+			ClientSentAt: sendMessageInput.ClientSentAt,
+		}
 	)
 
 	tests := []struct {
@@ -87,16 +100,24 @@ func TestService_SuccessSendMessage(t *testing.T) {
 
 				txManager := postgres.NewTxManager(db)
 				chatRepo := mockrepository.NewMockChat(t)
+				notificationRepo := mockrepository.NewMockNotification(t)
 				logRepo := mockrepository.NewMockLog(t)
 
-				chatRepo.On("SendMessage", txCtx, tt.sendMessageInput).Return(nil)
+				chatRepo.On("MemberRole", txCtx, tt.sendMessageInput.ChatID, tt.sendMessageInput.From).Return(model.RoleMember, nil)
+
+				chatRepo.On("Chat", txCtx, tt.sendMessageInput.ChatID).Return(model.Chat{ID: tt.sendMessageInput.ChatID, Kind: model.ChatKindGroup}, nil)
+
+				chatRepo.On("SendMessage", txCtx, tt.sendMessageInput).Return(storedMessage, nil)
+
+				notificationRepo.On("Enqueue", txCtx, storedMessage, []uint64(nil)).Return(nil)
 
 				logRepo.On("Log", txCtx, tt.logCreateInput).Return(nil)
 
 				return mocker{
-					txManager: txManager,
-					chat:      chatRepo,
-					log:       logRepo,
+					txManager:     txManager,
+					chat:          chatRepo,
+					notifications: notificationRepo,
+					log:           logRepo,
 				}
 			},
 		},
@@ -108,11 +129,12 @@ func TestService_SuccessSendMessage(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			mocker := tt.mocker(tt.args)
 
-			service := chatservice.NewService(mocker.txManager, mocker.chat, mocker.log)
+			service := chatservice.NewService(mocker.txManager, mocker.chat, nil, nil, mocker.notifications, mocker.log, nil, hub.New(), nil, config.Chat{})
 
-			err := service.SendMessage(tt.args.ctx, tt.args.sendMessageInput)
+			msg, err := service.SendMessage(tt.args.ctx, tt.args.sendMessageInput)
 
 			require.Equal(t, tt.want, err)
+			require.Equal(t, storedMessage, msg)
 		})
 	}
 }
@@ -146,7 +168,7 @@ func TestService_FailSendMessageProcessTx(t *testing.T) {
 			name: "failed to start tx because txManager.ReadCommitted returned an error",
 			args: args{
 				ctx:              context.Background(),
-				sendMessageInput: converter.SendMessageInput{},
+				sendMessageInput: converter.SendMessageInput{Text: gofakeit.Sentence(3)},
 				logCreateInput:   model.Log{},
 			},
 			want: slErr,
@@ -169,9 +191,9 @@ func TestService_FailSendMessageProcessTx(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			mocker := tt.mocker(tt.args)
 
-			service := chatservice.NewService(mocker.txManager, mocker.chat, mocker.log)
+			service := chatservice.NewService(mocker.txManager, mocker.chat, nil, nil, nil, mocker.log, nil, hub.New(), nil, config.Chat{})
 
-			err := service.SendMessage(tt.args.ctx, tt.args.sendMessageInput)
+			_, err := service.SendMessage(tt.args.ctx, tt.args.sendMessageInput)
 
 			require.Equal(t, tt.want, err)
 		})
@@ -201,12 +223,11 @@ func TestService_FailSendMessage(t *testing.T) {
 
 		req = &chatv1.SendMessageRequest{
 			ChatId:    chatID,
-			From:      int64(userID),
 			Text:      text,
 			Timestamp: timestamppb.New(timestamp),
 		}
 
-		sendMessageInput = converter.ToSendMessageInput(req)
+		sendMessageInput = converter.ToSendMessageInput(auth.InjectUserID(context.Background(), userID), req)
 
 		err = errors.New("failed to send message in chat")
 
@@ -245,7 +266,11 @@ func TestService_FailSendMessage(t *testing.T) {
 				chatRepo := mockrepository.NewMockChat(t)
 				logRepo := mockrepository.NewMockLog(t)
 
-				chatRepo.On("SendMessage", txCtx, tt.sendMessageInput).Return(err)
+				chatRepo.On("MemberRole", txCtx, tt.sendMessageInput.ChatID, tt.sendMessageInput.From).Return(model.RoleMember, nil)
+
+				chatRepo.On("Chat", txCtx, tt.sendMessageInput.ChatID).Return(model.Chat{ID: tt.sendMessageInput.ChatID, Kind: model.ChatKindGroup}, nil)
+
+				chatRepo.On("SendMessage", txCtx, tt.sendMessageInput).Return(model.Message{}, err)
 
 				return mocker{
 					txManager: txManager,
@@ -262,9 +287,9 @@ func TestService_FailSendMessage(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			mocker := tt.mocker(tt.args)
 
-			service := chatservice.NewService(mocker.txManager, mocker.chat, mocker.log)
+			service := chatservice.NewService(mocker.txManager, mocker.chat, nil, nil, nil, mocker.log, nil, hub.New(), nil, config.Chat{})
 
-			err := service.SendMessage(tt.args.ctx, tt.args.sendMessageInput)
+			_, err := service.SendMessage(tt.args.ctx, tt.args.sendMessageInput)
 
 			require.Equal(t, tt.want, err)
 		})
@@ -279,9 +304,10 @@ func TestService_FailSendMessageLog(t *testing.T) {
 	}
 
 	type mocker struct {
-		txManager postgres.TxManager
-		chat      repository.Chat
-		log       repository.Log
+		txManager     postgres.TxManager
+		chat          repository.Chat
+		notifications repository.Notification
+		log           repository.Log
 	}
 
 	var (
@@ -294,12 +320,11 @@ func TestService_FailSendMessageLog(t *testing.T) {
 
 		req = &chatv1.SendMessageRequest{
 			ChatId:    chatID,
-			From:      int64(userID),
 			Text:      text,
 			Timestamp: timestamppb.New(timestamp),
 		}
 
-		sendMessageInput = converter.ToSendMessageInput(req)
+		sendMessageInput = converter.ToSendMessageInput(auth.InjectUserID(context.Background(), userID), req)
 
 		logCreateInput = model.Log{
 			Action: model.LogSendMessage,
@@ -341,16 +366,24 @@ func TestService_FailSendMessageLog(t *testing.T) {
 
 				txManager := postgres.NewTxManager(db)
 				chatRepo := mockrepository.NewMockChat(t)
+				notificationRepo := mockrepository.NewMockNotification(t)
 				logRepo := mockrepository.NewMockLog(t)
 
-				chatRepo.On("SendMessage", txCtx, tt.sendMessageInput).Return(nil)
+				chatRepo.On("MemberRole", txCtx, tt.sendMessageInput.ChatID, tt.sendMessageInput.From).Return(model.RoleMember, nil)
+
+				chatRepo.On("Chat", txCtx, tt.sendMessageInput.ChatID).Return(model.Chat{ID: tt.sendMessageInput.ChatID, Kind: model.ChatKindGroup}, nil)
+
+				chatRepo.On("SendMessage", txCtx, tt.sendMessageInput).Return(model.Message{ChatID: tt.sendMessageInput.ChatID}, nil)
+
+				notificationRepo.On("Enqueue", txCtx, model.Message{ChatID: tt.sendMessageInput.ChatID}, []uint64(nil)).Return(nil)
 
 				logRepo.On("Log", txCtx, tt.logCreateInput).Return(err)
 
 				return mocker{
-					txManager: txManager,
-					chat:      chatRepo,
-					log:       logRepo,
+					txManager:     txManager,
+					chat:          chatRepo,
+					notifications: notificationRepo,
+					log:           logRepo,
 				}
 			},
 		},
@@ -362,11 +395,175 @@ func TestService_FailSendMessageLog(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			mocker := tt.mocker(tt.args)
 
-			service := chatservice.NewService(mocker.txManager, mocker.chat, mocker.log)
+			service := chatservice.NewService(mocker.txManager, mocker.chat, nil, nil, mocker.notifications, mocker.log, nil, hub.New(), nil, config.Chat{})
 
-			err := service.SendMessage(tt.args.ctx, tt.args.sendMessageInput)
+			_, err := service.SendMessage(tt.args.ctx, tt.args.sendMessageInput)
 
 			require.Equal(t, tt.want, err)
 		})
 	}
 }
+
+func TestService_SendMessageIdempotent(t *testing.T) {
+	var (
+		userID = gofakeit.Uint64()
+
+		req = &chatv1.SendMessageRequest{
+			ChatId:          gofakeit.Int64(),
+			Text:            gofakeit.JobTitle(),
+			ClientMessageId: gofakeit.UUID(),
+		}
+
+		input = converter.ToSendMessageInput(auth.InjectUserID(context.Background(), userID), req)
+
+		clientMessageID = req.ClientMessageId
+
+		storedMessage = model.Message{
+			ID:              gofakeit.Uint64(),
+			ChatID:          input.ChatID,
+			UserID:          userID,
+			Text:            input.Text,
+			Timestamp:       gofakeit.Date(),
+			ClientMessageID: &clientMessageID,
+		}
+	)
+
+	ctx := context.Background()
+
+	tx := mockpostgres.NewMockTx(t)
+
+	txCtx := postgres.InjectTX(ctx, tx)
+
+	tx.On("Commit", txCtx).Return(nil)
+
+	db := mockpostgres.NewMockPostgres(t)
+	db.On("BeginTx", ctx, pgx.TxOptions{IsoLevel: pgx.ReadCommitted}).Return(tx, nil)
+
+	chatRepo := mockrepository.NewMockChat(t)
+
+	chatRepo.On("MemberRole", txCtx, input.ChatID, input.From).Return(model.RoleMember, nil)
+
+	chatRepo.On("Chat", txCtx, input.ChatID).Return(model.Chat{ID: input.ChatID, Kind: model.ChatKindGroup}, nil)
+
+	chatRepo.On("SendMessage", txCtx, input).Return(model.Message{}, sl.Err("repository.SendMessage", apperr.AlreadyExists("message with this client message id already exists")))
+
+	chatRepo.On("MessageByClientID", txCtx, input.ChatID, input.From, input.ClientMessageID).Return(storedMessage, nil)
+
+	h := hub.New()
+
+	sub := h.Subscribe(input.ChatID, gofakeit.Uint64())
+	defer h.Unsubscribe(sub)
+
+	// Лог не пишется, поэтому мок лога без ожиданий
+	service := chatservice.NewService(postgres.NewTxManager(db), chatRepo, nil, nil, nil, mockrepository.NewMockLog(t), nil, h, nil, config.Chat{})
+
+	msg, err := service.SendMessage(ctx, input)
+
+	require.NoError(t, err)
+	require.Equal(t, storedMessage, msg)
+	require.Empty(t, sub.Messages())
+}
+
+func TestService_SendMessageWithAttachments(t *testing.T) {
+	var (
+		input = converter.SendMessageInput{
+			ChatID:        gofakeit.Int64(),
+			From:          gofakeit.Uint64(),
+			AttachmentIDs: []uint64{gofakeit.Uint64(), gofakeit.Uint64()},
+		}
+
+		stored = model.Message{
+			ID:     gofakeit.Uint64(),
+			ChatID: input.ChatID,
+			UserID: input.From,
+		}
+
+		attachments = []model.Attachment{
+			{ID: input.AttachmentIDs[0], ChatID: input.ChatID, UserID: input.From, MessageID: &stored.ID, Filename: "photo.png", MimeType: "image/png"},
+			{ID: input.AttachmentIDs[1], ChatID: input.ChatID, UserID: input.From, MessageID: &stored.ID, Filename: "report.pdf", MimeType: "application/pdf"},
+		}
+	)
+
+	withAttachments := stored
+	withAttachments.Attachments = attachments
+
+	tests := []struct {
+		name   string
+		commit bool
+		want   model.Message
+		err    error
+		mocker func(txCtx context.Context, chatRepo *mockrepository.MockChat, attachmentRepo *mockrepository.MockAttachment, notificationRepo *mockrepository.MockNotification, logRepo *mockrepository.MockLog)
+	}{
+		{
+			name:   "message without text has attachments",
+			commit: true,
+			want:   withAttachments,
+			mocker: func(txCtx context.Context, chatRepo *mockrepository.MockChat, attachmentRepo *mockrepository.MockAttachment, notificationRepo *mockrepository.MockNotification, logRepo *mockrepository.MockLog) {
+				chatRepo.On("MemberRole", txCtx, input.ChatID, input.From).Return(model.RoleOwner, nil)
+				chatRepo.On("SendMessage", txCtx, input).Return(stored, nil)
+				attachmentRepo.On("Attach", txCtx, stored.ID, input.ChatID, input.From, input.AttachmentIDs).Return(attachments, nil)
+				notificationRepo.On("Enqueue", txCtx, withAttachments, []uint64(nil)).Return(nil)
+				logRepo.On("Log", txCtx, model.Log{Action: model.LogSendMessage, UserID: input.From}).Return(nil)
+			},
+		},
+		{
+			name: "one of attachments is already sent",
+			err: sl.Err("service.SendMessage", apperr.InvalidArgument("attachment not found or already attached", apperr.FieldViolation{
+				Field:       "attachment_ids",
+				Description: "attachments must be uploaded by the sender to this chat and not sent yet",
+			})),
+			mocker: func(txCtx context.Context, chatRepo *mockrepository.MockChat, attachmentRepo *mockrepository.MockAttachment, notificationRepo *mockrepository.MockNotification, logRepo *mockrepository.MockLog) {
+				chatRepo.On("MemberRole", txCtx, input.ChatID, input.From).Return(model.RoleOwner, nil)
+				chatRepo.On("SendMessage", txCtx, input).Return(stored, nil)
+				attachmentRepo.On("Attach", txCtx, stored.ID, input.ChatID, input.From, input.AttachmentIDs).Return(attachments[:1], nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			tx := mockpostgres.NewMockTx(t)
+
+			txCtx := postgres.InjectTX(ctx, tx)
+
+			if tt.commit {
+				tx.On("Commit", txCtx).Return(nil)
+			} else {
+				tx.On("Rollback", txCtx).Return(nil)
+			}
+
+			db := mockpostgres.NewMockPostgres(t)
+			db.On("BeginTx", ctx, pgx.TxOptions{IsoLevel: pgx.ReadCommitted}).Return(tx, nil)
+
+			chatRepo := mockrepository.NewMockChat(t)
+			attachmentRepo := mockrepository.NewMockAttachment(t)
+			notificationRepo := mockrepository.NewMockNotification(t)
+			logRepo := mockrepository.NewMockLog(t)
+
+			tt.mocker(txCtx, chatRepo, attachmentRepo, notificationRepo, logRepo)
+
+			service := chatservice.NewService(postgres.NewTxManager(db), chatRepo, nil, attachmentRepo, notificationRepo, logRepo, nil, hub.New(), nil, config.Chat{})
+
+			msg, err := service.SendMessage(ctx, input)
+
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.want, msg)
+		})
+	}
+}
+
+func TestService_SendEmptyMessage(t *testing.T) {
+	service := chatservice.NewService(nil, nil, nil, nil, nil, nil, nil, hub.New(), nil, config.Chat{})
+
+	_, err := service.SendMessage(context.Background(), converter.SendMessageInput{
+		ChatID: gofakeit.Int64(),
+		From:   gofakeit.Uint64(),
+	})
+
+	require.Equal(t, sl.Err("service.SendMessage", apperr.InvalidArgument("message must have text or attachments", apperr.FieldViolation{
+		Field:       "text",
+		Description: "text is required when there are no attachments",
+	})), err)
+}
@@ -0,0 +1,200 @@
+package usertests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/brianvoe/gofakeit"
+	"github.com/defany/chat-server/app/internal/apperr"
+	"github.com/defany/chat-server/app/internal/config"
+	"github.com/defany/chat-server/app/internal/converter"
+	"github.com/defany/chat-server/app/internal/model"
+	mockrepository "github.com/defany/chat-server/app/internal/repository/mocks"
+	chatservice "github.com/defany/chat-server/app/internal/service/chat"
+	"github.com/defany/chat-server/app/internal/service/hub"
+	"github.com/defany/db/pkg/postgres"
+	mockpostgres "github.com/defany/db/pkg/postgres/mocks"
+	"github.com/defany/slogger/pkg/logger/sl"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService_UpdateChat(t *testing.T) {
+	type mocker struct {
+		chat *mockrepository.MockChat
+		log  *mockrepository.MockLog
+	}
+
+	var (
+		title = gofakeit.JobTitle()
+
+		input = converter.UpdateChatInput{
+			ChatID:  gofakeit.Int64(),
+			UserID:  gofakeit.Uint64(),
+			Title:   &title,
+			Version: 3,
+		}
+
+		current = model.Chat{
+			ID:          input.ChatID,
+			Title:       gofakeit.JobTitle(),
+			Description: gofakeit.Sentence(5),
+			AvatarURL:   gofakeit.URL(),
+			Version:     3,
+		}
+
+		// Описание и аватар не переданы, поэтому остаются прежними
+		changed = model.Chat{
+			ID:          input.ChatID,
+			Title:       title,
+			Description: current.Description,
+			AvatarURL:   current.AvatarURL,
+			Version:     3,
+		}
+
+		updated = model.Chat{
+			ID:          input.ChatID,
+			Title:       title,
+			Description: current.Description,
+			AvatarURL:   current.AvatarURL,
+			Version:     4,
+		}
+
+		conflictErr = apperr.FailedPrecondition("chat was changed by someone else, reload it and try again")
+	)
+
+	tests := []struct {
+		name   string
+		commit bool
+		want   model.Chat
+		err    error
+		mocker func(txCtx context.Context, m mocker)
+	}{
+		{
+			name:   "admin renames chat",
+			commit: true,
+			want:   updated,
+			err:    nil,
+			mocker: func(txCtx context.Context, m mocker) {
+				m.chat.On("MemberRole", txCtx, input.ChatID, input.UserID).Return(model.RoleAdmin, nil)
+				m.chat.On("Chat", txCtx, input.ChatID).Return(current, nil)
+				m.chat.On("UpdateChat", txCtx, changed).Return(updated, nil)
+				m.log.On("Log", txCtx, model.Log{Action: model.LogUpdateChat, UserID: input.UserID}).Return(nil)
+			},
+		},
+		{
+			name: "chat was updated concurrently",
+			err:  sl.Err("service.UpdateChat", conflictErr),
+			mocker: func(txCtx context.Context, m mocker) {
+				m.chat.On("MemberRole", txCtx, input.ChatID, input.UserID).Return(model.RoleOwner, nil)
+				m.chat.On("Chat", txCtx, input.ChatID).Return(current, nil)
+				m.chat.On("UpdateChat", txCtx, changed).Return(model.Chat{}, conflictErr)
+			},
+		},
+		{
+			name: "regular member can not update chat",
+			err:  sl.Err("service.UpdateChat", apperr.PermissionDenied("action is not allowed for chat member")),
+			mocker: func(txCtx context.Context, m mocker) {
+				m.chat.On("MemberRole", txCtx, input.ChatID, input.UserID).Return(model.RoleMember, nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			tx := mockpostgres.NewMockTx(t)
+
+			txCtx := postgres.InjectTX(ctx, tx)
+
+			if tt.commit {
+				tx.On("Commit", txCtx).Return(nil)
+			} else {
+				tx.On("Rollback", txCtx).Return(nil)
+			}
+
+			db := mockpostgres.NewMockPostgres(t)
+			db.On("BeginTx", ctx, pgx.TxOptions{IsoLevel: pgx.ReadCommitted}).Return(tx, nil)
+
+			m := mocker{
+				chat: mockrepository.NewMockChat(t),
+				log:  mockrepository.NewMockLog(t),
+			}
+
+			tt.mocker(txCtx, m)
+
+			service := chatservice.NewService(postgres.NewTxManager(db), m.chat, nil, nil, nil, m.log, nil, hub.New(), nil, config.Chat{})
+
+			chat, err := service.UpdateChat(ctx, input)
+
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.want, chat)
+		})
+	}
+}
+
+func TestService_UpdateChatWithoutChanges(t *testing.T) {
+	service := chatservice.NewService(nil, nil, nil, nil, nil, nil, nil, hub.New(), nil, config.Chat{})
+
+	_, err := service.UpdateChat(context.Background(), converter.UpdateChatInput{
+		ChatID:  gofakeit.Int64(),
+		UserID:  gofakeit.Uint64(),
+		Version: 1,
+	})
+
+	require.Equal(t, sl.Err("service.UpdateChat", apperr.InvalidArgument("nothing to update")), err)
+}
+
+func TestService_GetChat(t *testing.T) {
+	var (
+		ctx = context.Background()
+
+		input = converter.GetChatInput{
+			ChatID: gofakeit.Int64(),
+			UserID: gofakeit.Uint64(),
+		}
+
+		chat = model.Chat{
+			ID:      input.ChatID,
+			Title:   gofakeit.JobTitle(),
+			Version: 1,
+		}
+	)
+
+	tests := []struct {
+		name string
+		role model.Role
+		want model.Chat
+		err  error
+	}{
+		{
+			name: "member reads chat",
+			role: model.RoleMember,
+			want: chat,
+		},
+		{
+			name: "stranger can not read chat",
+			role: "",
+			err:  sl.Err("service.GetChat", apperr.PermissionDenied("user is not a member of the chat")),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := mockrepository.NewMockChat(t)
+			repo.On("MemberRole", ctx, input.ChatID, input.UserID).Return(tt.role, nil)
+
+			if tt.err == nil {
+				repo.On("Chat", ctx, input.ChatID).Return(chat, nil)
+			}
+
+			service := chatservice.NewService(nil, repo, nil, nil, nil, nil, nil, hub.New(), nil, config.Chat{})
+
+			got, err := service.GetChat(ctx, input)
+
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,19 @@
+package chatservice
+
+import (
+	"context"
+
+	"github.com/defany/chat-server/app/internal/model"
+	"github.com/defany/slogger/pkg/logger/sl"
+)
+
+func (s *service) GetUnreadCounts(ctx context.Context, userID uint64) ([]model.UnreadCount, error) {
+	op := sl.FnName()
+
+	counts, err := s.repo.UnreadCounts(ctx, userID)
+	if err != nil {
+		return nil, sl.Err(op, err)
+	}
+
+	return counts, nil
+}
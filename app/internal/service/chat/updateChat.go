@@ -0,0 +1,67 @@
+package chatservice
+
+import (
+	"context"
+
+	"github.com/defany/chat-server/app/internal/apperr"
+	"github.com/defany/chat-server/app/internal/converter"
+	"github.com/defany/chat-server/app/internal/model"
+	"github.com/defany/slogger/pkg/logger/sl"
+)
+
+func (s *service) UpdateChat(ctx context.Context, input converter.UpdateChatInput) (model.Chat, error) {
+	op := sl.FnName()
+
+	if input.Title == nil && input.Description == nil && input.AvatarURL == nil {
+		return model.Chat{}, sl.Err(op, apperr.InvalidArgument("nothing to update"))
+	}
+
+	var chat model.Chat
+
+	err := s.tx.ReadCommitted(ctx, func(ctx context.Context) error {
+		err := s.access.RequireRole(ctx, input.ChatID, input.UserID, model.RoleOwner, model.RoleAdmin)
+		if err != nil {
+			return err
+		}
+
+		current, err := s.repo.Chat(ctx, input.ChatID)
+		if err != nil {
+			return err
+		}
+
+		if input.Title != nil {
+			current.Title = *input.Title
+		}
+
+		if input.Description != nil {
+			current.Description = *input.Description
+		}
+
+		if input.AvatarURL != nil {
+			current.AvatarURL = *input.AvatarURL
+		}
+
+		// Сравнение версий делает сам update, так параллельная правка между чтением и записью тоже не пройдет
+		current.Version = input.Version
+
+		chat, err = s.repo.UpdateChat(ctx, current)
+		if err != nil {
+			return err
+		}
+
+		err = s.log.Log(ctx, model.Log{
+			Action: model.LogUpdateChat,
+			UserID: input.UserID,
+		})
+		if err != nil {
+			return err
+		}
+
+		return nil
+	})
+	if err != nil {
+		return model.Chat{}, sl.Err(op, err)
+	}
+
+	return chat, nil
+}
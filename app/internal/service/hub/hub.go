@@ -0,0 +1,282 @@
+package hub
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+
+	"github.com/defany/chat-server/app/internal/model"
+)
+
+const (
+	subscriptionBuffer = 64
+
+	// Подписчики раскладываются по шардам, у каждого шарда свой воркер рассылки.
+	// Отправитель только кладет событие в очереди шардов и не ждет, пока сообщение разойдется по всем подписчикам канала
+	shardsCount = 16
+	shardQueue  = 1024
+)
+
+var (
+	ErrChatClosed     = errors.New("chat closed")
+	ErrMemberRemoved  = errors.New("member removed from chat")
+	ErrSlowSubscriber = errors.New("subscriber is too slow")
+	ErrHubClosed      = errors.New("hub closed")
+)
+
+type Subscription struct {
+	chatID int64
+	userID uint64
+	shard  *shard
+
+	messages chan model.Message
+
+	once sync.Once
+	err  error
+}
+
+func (s *Subscription) Messages() <-chan model.Message {
+	return s.messages
+}
+
+// Err возвращает причину, по которой канал сообщений был закрыт
+func (s *Subscription) Err() error {
+	return s.err
+}
+
+func (s *Subscription) close(err error) {
+	s.once.Do(func() {
+		s.err = err
+
+		close(s.messages)
+	})
+}
+
+type eventKind int
+
+const (
+	eventPublish eventKind = iota
+	eventRemoveMember
+	eventCloseChat
+)
+
+type event struct {
+	kind   eventKind
+	chatID int64
+	userID uint64
+	msg    model.Message
+}
+
+// shard обрабатывает события строго по очереди, поэтому подписчик получает сообщения в порядке публикации,
+// а удаление участника или закрытие чата не обгоняет уже опубликованные сообщения
+type shard struct {
+	mu     sync.Mutex
+	chats  map[int64]map[*Subscription]struct{}
+	events chan event
+}
+
+func (sh *shard) has(chatID int64) bool {
+	sh.mu.Lock()
+	defer sh.mu.Unlock()
+
+	return len(sh.chats[chatID]) != 0
+}
+
+func (sh *shard) handle(e event) {
+	sh.mu.Lock()
+	defer sh.mu.Unlock()
+
+	switch e.kind {
+	case eventPublish:
+		for sub := range sh.chats[e.chatID] {
+			select {
+			case sub.messages <- e.msg:
+			default:
+				// Не ждем тех, кто не успевает читать, пусть переподключаются и догружают историю
+				sh.remove(sub)
+
+				sub.close(ErrSlowSubscriber)
+			}
+		}
+	case eventRemoveMember:
+		for sub := range sh.chats[e.chatID] {
+			if sub.userID != e.userID {
+				continue
+			}
+
+			sh.remove(sub)
+
+			sub.close(ErrMemberRemoved)
+		}
+	case eventCloseChat:
+		for sub := range sh.chats[e.chatID] {
+			sub.close(ErrChatClosed)
+		}
+
+		delete(sh.chats, e.chatID)
+	}
+}
+
+func (sh *shard) remove(sub *Subscription) {
+	subs, ok := sh.chats[sub.chatID]
+	if !ok {
+		return
+	}
+
+	delete(subs, sub)
+
+	if len(subs) == 0 {
+		delete(sh.chats, sub.chatID)
+	}
+}
+
+// Hub раздает сообщения всем подписчикам чата в рамках одного инстанса сервиса
+type Hub struct {
+	shards []*shard
+	next   atomic.Uint64
+
+	mu     sync.RWMutex
+	closed bool
+	done   chan struct{}
+}
+
+func New() *Hub {
+	h := &Hub{
+		shards: make([]*shard, shardsCount),
+		done:   make(chan struct{}),
+	}
+
+	for i := range h.shards {
+		sh := &shard{
+			chats:  make(map[int64]map[*Subscription]struct{}),
+			events: make(chan event, shardQueue),
+		}
+
+		h.shards[i] = sh
+
+		go h.run(sh)
+	}
+
+	return h
+}
+
+func (h *Hub) Subscribe(chatID int64, userID uint64) *Subscription {
+	sh := h.shards[h.next.Add(1)%shardsCount]
+
+	sub := &Subscription{
+		chatID:   chatID,
+		userID:   userID,
+		shard:    sh,
+		messages: make(chan model.Message, subscriptionBuffer),
+	}
+
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	if h.closed {
+		sub.close(ErrHubClosed)
+
+		return sub
+	}
+
+	sh.mu.Lock()
+	defer sh.mu.Unlock()
+
+	subs, ok := sh.chats[chatID]
+	if !ok {
+		subs = make(map[*Subscription]struct{})
+		sh.chats[chatID] = subs
+	}
+
+	subs[sub] = struct{}{}
+
+	return sub
+}
+
+func (h *Hub) Unsubscribe(sub *Subscription) {
+	sub.shard.mu.Lock()
+	defer sub.shard.mu.Unlock()
+
+	sub.shard.remove(sub)
+
+	sub.close(nil)
+}
+
+// Publish не ждет доставки. Блокируется только если воркеры шардов отстали на целую очередь событий
+func (h *Hub) Publish(msg model.Message) {
+	h.dispatch(event{
+		kind:   eventPublish,
+		chatID: msg.ChatID,
+		msg:    msg,
+	})
+}
+
+func (h *Hub) CloseChat(chatID int64) {
+	h.dispatch(event{
+		kind:   eventCloseChat,
+		chatID: chatID,
+	})
+}
+
+// RemoveMember закрывает подписки пользователя, которого больше нет в чате.
+// Сообщения, опубликованные до вызова, пользователь еще успеет получить
+func (h *Hub) RemoveMember(chatID int64, userID uint64) {
+	h.dispatch(event{
+		kind:   eventRemoveMember,
+		chatID: chatID,
+		userID: userID,
+	})
+}
+
+func (h *Hub) Close() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.closed {
+		return
+	}
+
+	h.closed = true
+
+	close(h.done)
+
+	for _, sh := range h.shards {
+		sh.mu.Lock()
+
+		for chatID, subs := range sh.chats {
+			for sub := range subs {
+				sub.close(ErrHubClosed)
+			}
+
+			delete(sh.chats, chatID)
+		}
+
+		sh.mu.Unlock()
+	}
+}
+
+func (h *Hub) dispatch(e event) {
+	for _, sh := range h.shards {
+		// В шарде без подписчиков чата делать нечего, его воркер не будим
+		if !sh.has(e.chatID) {
+			continue
+		}
+
+		select {
+		case sh.events <- e:
+		case <-h.done:
+			return
+		}
+	}
+}
+
+func (h *Hub) run(sh *shard) {
+	for {
+		select {
+		case e := <-sh.events:
+			sh.handle(e)
+		case <-h.done:
+			return
+		}
+	}
+}
@@ -0,0 +1,154 @@
+package hubtests
+
+import (
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/defany/chat-server/app/internal/model"
+	"github.com/defany/chat-server/app/internal/service/hub"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHub_PublishToManySubscribers(t *testing.T) {
+	const subscribers = 10000
+
+	h := hub.New()
+	defer h.Close()
+
+	chatID := gofakeit.Int64()
+
+	subs := make([]*hub.Subscription, 0, subscribers)
+	for i := 0; i < subscribers; i++ {
+		subs = append(subs, h.Subscribe(chatID, gofakeit.Uint64()))
+	}
+
+	msg := model.Message{
+		ID:     gofakeit.Uint64(),
+		ChatID: chatID,
+		Text:   gofakeit.Sentence(5),
+	}
+
+	h.Publish(msg)
+
+	for _, sub := range subs {
+		require.Equal(t, msg, <-sub.Messages())
+	}
+}
+
+func TestHub_KeepsOrderForSubscriber(t *testing.T) {
+	h := hub.New()
+	defer h.Close()
+
+	chatID := gofakeit.Int64()
+
+	sub := h.Subscribe(chatID, gofakeit.Uint64())
+
+	for id := uint64(1); id <= 50; id++ {
+		h.Publish(model.Message{ID: id, ChatID: chatID})
+	}
+
+	for id := uint64(1); id <= 50; id++ {
+		require.Equal(t, id, (<-sub.Messages()).ID)
+	}
+}
+
+func TestHub_SkipsOtherChats(t *testing.T) {
+	h := hub.New()
+	defer h.Close()
+
+	chatID := gofakeit.Int64()
+
+	sub := h.Subscribe(chatID, gofakeit.Uint64())
+
+	h.Publish(model.Message{ID: 1, ChatID: chatID + 1})
+	h.Publish(model.Message{ID: 2, ChatID: chatID})
+
+	require.Equal(t, uint64(2), (<-sub.Messages()).ID)
+}
+
+func TestHub_RemoveMemberAfterPublish(t *testing.T) {
+	h := hub.New()
+	defer h.Close()
+
+	var (
+		chatID = gofakeit.Int64()
+		userID = gofakeit.Uint64()
+	)
+
+	removed := h.Subscribe(chatID, userID)
+	other := h.Subscribe(chatID, userID+1)
+
+	msg := model.Message{ID: gofakeit.Uint64(), ChatID: chatID}
+
+	h.Publish(msg)
+	h.RemoveMember(chatID, userID)
+
+	// Удаление идет через ту же очередь, поэтому опубликованное раньше сообщение не теряется
+	require.Equal(t, msg, <-removed.Messages())
+
+	_, ok := <-removed.Messages()
+	require.False(t, ok)
+	require.ErrorIs(t, removed.Err(), hub.ErrMemberRemoved)
+
+	require.Equal(t, msg, <-other.Messages())
+}
+
+func TestHub_CloseChat(t *testing.T) {
+	h := hub.New()
+	defer h.Close()
+
+	chatID := gofakeit.Int64()
+
+	sub := h.Subscribe(chatID, gofakeit.Uint64())
+
+	h.CloseChat(chatID)
+
+	_, ok := <-sub.Messages()
+	require.False(t, ok)
+	require.ErrorIs(t, sub.Err(), hub.ErrChatClosed)
+}
+
+func TestHub_SlowSubscriberIsDropped(t *testing.T) {
+	h := hub.New()
+	defer h.Close()
+
+	chatID := gofakeit.Int64()
+
+	sub := h.Subscribe(chatID, gofakeit.Uint64())
+
+	// Подписчик ничего не читает, буфер переполняется и подписка закрывается
+	for id := uint64(1); id <= 100; id++ {
+		h.Publish(model.Message{ID: id, ChatID: chatID})
+	}
+
+	var last uint64
+	for msg := range sub.Messages() {
+		last = msg.ID
+	}
+
+	require.Less(t, last, uint64(100))
+	require.ErrorIs(t, sub.Err(), hub.ErrSlowSubscriber)
+}
+
+func TestHub_Close(t *testing.T) {
+	h := hub.New()
+
+	chatID := gofakeit.Int64()
+
+	sub := h.Subscribe(chatID, gofakeit.Uint64())
+
+	h.Close()
+
+	_, ok := <-sub.Messages()
+	require.False(t, ok)
+	require.ErrorIs(t, sub.Err(), hub.ErrHubClosed)
+
+	late := h.Subscribe(chatID, gofakeit.Uint64())
+
+	_, ok = <-late.Messages()
+	require.False(t, ok)
+	require.ErrorIs(t, late.Err(), hub.ErrHubClosed)
+
+	// После закрытия публикация ничего не делает и не блокируется
+	h.Publish(model.Message{ChatID: chatID})
+}
@@ -0,0 +1,277 @@
+// Code generated by mockery v2.42.0. DO NOT EDIT.
+
+package mockservicedef
+
+import (
+	context "context"
+	io "io"
+
+	converter "github.com/defany/chat-server/app/internal/converter"
+
+	mock "github.com/stretchr/testify/mock"
+
+	model "github.com/defany/chat-server/app/internal/model"
+)
+
+// MockAttachment is an autogenerated mock type for the Attachment type
+type MockAttachment struct {
+	mock.Mock
+}
+
+type MockAttachment_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockAttachment) EXPECT() *MockAttachment_Expecter {
+	return &MockAttachment_Expecter{mock: &_m.Mock}
+}
+
+// Download provides a mock function with given fields: ctx, input
+func (_m *MockAttachment) Download(ctx context.Context, input converter.DownloadAttachmentInput) (model.Attachment, io.ReadCloser, error) {
+	ret := _m.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Download")
+	}
+
+	var r0 model.Attachment
+	var r1 io.ReadCloser
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, converter.DownloadAttachmentInput) (model.Attachment, io.ReadCloser, error)); ok {
+		return rf(ctx, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, converter.DownloadAttachmentInput) model.Attachment); ok {
+		r0 = rf(ctx, input)
+	} else {
+		r0 = ret.Get(0).(model.Attachment)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, converter.DownloadAttachmentInput) io.ReadCloser); ok {
+		r1 = rf(ctx, input)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(io.ReadCloser)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, converter.DownloadAttachmentInput) error); ok {
+		r2 = rf(ctx, input)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockAttachment_Download_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Download'
+type MockAttachment_Download_Call struct {
+	*mock.Call
+}
+
+// Download is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input converter.DownloadAttachmentInput
+func (_e *MockAttachment_Expecter) Download(ctx interface{}, input interface{}) *MockAttachment_Download_Call {
+	return &MockAttachment_Download_Call{Call: _e.mock.On("Download", ctx, input)}
+}
+
+func (_c *MockAttachment_Download_Call) Run(run func(ctx context.Context, input converter.DownloadAttachmentInput)) *MockAttachment_Download_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(converter.DownloadAttachmentInput))
+	})
+	return _c
+}
+
+func (_c *MockAttachment_Download_Call) Return(_a0 model.Attachment, _a1 io.ReadCloser, _a2 error) *MockAttachment_Download_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockAttachment_Download_Call) RunAndReturn(run func(context.Context, converter.DownloadAttachmentInput) (model.Attachment, io.ReadCloser, error)) *MockAttachment_Download_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PurgeOrphans provides a mock function with given fields: ctx
+func (_m *MockAttachment) PurgeOrphans(ctx context.Context) (int64, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PurgeOrphans")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (int64, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) int64); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAttachment_PurgeOrphans_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PurgeOrphans'
+type MockAttachment_PurgeOrphans_Call struct {
+	*mock.Call
+}
+
+// PurgeOrphans is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockAttachment_Expecter) PurgeOrphans(ctx interface{}) *MockAttachment_PurgeOrphans_Call {
+	return &MockAttachment_PurgeOrphans_Call{Call: _e.mock.On("PurgeOrphans", ctx)}
+}
+
+func (_c *MockAttachment_PurgeOrphans_Call) Run(run func(ctx context.Context)) *MockAttachment_PurgeOrphans_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockAttachment_PurgeOrphans_Call) Return(_a0 int64, _a1 error) *MockAttachment_PurgeOrphans_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAttachment_PurgeOrphans_Call) RunAndReturn(run func(context.Context) (int64, error)) *MockAttachment_PurgeOrphans_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PurgeUnattached provides a mock function with given fields: ctx
+func (_m *MockAttachment) PurgeUnattached(ctx context.Context) (int64, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PurgeUnattached")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (int64, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) int64); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAttachment_PurgeUnattached_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PurgeUnattached'
+type MockAttachment_PurgeUnattached_Call struct {
+	*mock.Call
+}
+
+// PurgeUnattached is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockAttachment_Expecter) PurgeUnattached(ctx interface{}) *MockAttachment_PurgeUnattached_Call {
+	return &MockAttachment_PurgeUnattached_Call{Call: _e.mock.On("PurgeUnattached", ctx)}
+}
+
+func (_c *MockAttachment_PurgeUnattached_Call) Run(run func(ctx context.Context)) *MockAttachment_PurgeUnattached_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockAttachment_PurgeUnattached_Call) Return(_a0 int64, _a1 error) *MockAttachment_PurgeUnattached_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAttachment_PurgeUnattached_Call) RunAndReturn(run func(context.Context) (int64, error)) *MockAttachment_PurgeUnattached_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Upload provides a mock function with given fields: ctx, input, body
+func (_m *MockAttachment) Upload(ctx context.Context, input converter.UploadAttachmentInput, body io.Reader) (model.Attachment, error) {
+	ret := _m.Called(ctx, input, body)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Upload")
+	}
+
+	var r0 model.Attachment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, converter.UploadAttachmentInput, io.Reader) (model.Attachment, error)); ok {
+		return rf(ctx, input, body)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, converter.UploadAttachmentInput, io.Reader) model.Attachment); ok {
+		r0 = rf(ctx, input, body)
+	} else {
+		r0 = ret.Get(0).(model.Attachment)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, converter.UploadAttachmentInput, io.Reader) error); ok {
+		r1 = rf(ctx, input, body)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAttachment_Upload_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Upload'
+type MockAttachment_Upload_Call struct {
+	*mock.Call
+}
+
+// Upload is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input converter.UploadAttachmentInput
+//   - body io.Reader
+func (_e *MockAttachment_Expecter) Upload(ctx interface{}, input interface{}, body interface{}) *MockAttachment_Upload_Call {
+	return &MockAttachment_Upload_Call{Call: _e.mock.On("Upload", ctx, input, body)}
+}
+
+func (_c *MockAttachment_Upload_Call) Run(run func(ctx context.Context, input converter.UploadAttachmentInput, body io.Reader)) *MockAttachment_Upload_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(converter.UploadAttachmentInput), args[2].(io.Reader))
+	})
+	return _c
+}
+
+func (_c *MockAttachment_Upload_Call) Return(_a0 model.Attachment, _a1 error) *MockAttachment_Upload_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAttachment_Upload_Call) RunAndReturn(run func(context.Context, converter.UploadAttachmentInput, io.Reader) (model.Attachment, error)) *MockAttachment_Upload_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockAttachment creates a new instance of MockAttachment. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockAttachment(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockAttachment {
+	mock := &MockAttachment{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -7,6 +7,8 @@ import (
 
 	converter "github.com/defany/chat-server/app/internal/converter"
 	mock "github.com/stretchr/testify/mock"
+
+	model "github.com/defany/chat-server/app/internal/model"
 )
 
 // MockChat is an autogenerated mock type for the Chat type
@@ -22,6 +24,158 @@ func (_m *MockChat) EXPECT() *MockChat_Expecter {
 	return &MockChat_Expecter{mock: &_m.Mock}
 }
 
+// AddMembers provides a mock function with given fields: ctx, input
+func (_m *MockChat) AddMembers(ctx context.Context, input converter.AddMembersInput) error {
+	ret := _m.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddMembers")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, converter.AddMembersInput) error); ok {
+		r0 = rf(ctx, input)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockChat_AddMembers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddMembers'
+type MockChat_AddMembers_Call struct {
+	*mock.Call
+}
+
+// AddMembers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input converter.AddMembersInput
+func (_e *MockChat_Expecter) AddMembers(ctx interface{}, input interface{}) *MockChat_AddMembers_Call {
+	return &MockChat_AddMembers_Call{Call: _e.mock.On("AddMembers", ctx, input)}
+}
+
+func (_c *MockChat_AddMembers_Call) Run(run func(ctx context.Context, input converter.AddMembersInput)) *MockChat_AddMembers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(converter.AddMembersInput))
+	})
+	return _c
+}
+
+func (_c *MockChat_AddMembers_Call) Return(_a0 error) *MockChat_AddMembers_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockChat_AddMembers_Call) RunAndReturn(run func(context.Context, converter.AddMembersInput) error) *MockChat_AddMembers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ConnectChat provides a mock function with given fields: ctx, input, send
+func (_m *MockChat) ConnectChat(ctx context.Context, input converter.ConnectChatInput, send func(model.Message) error) error {
+	ret := _m.Called(ctx, input, send)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ConnectChat")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, converter.ConnectChatInput, func(model.Message) error) error); ok {
+		r0 = rf(ctx, input, send)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockChat_ConnectChat_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ConnectChat'
+type MockChat_ConnectChat_Call struct {
+	*mock.Call
+}
+
+// ConnectChat is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input converter.ConnectChatInput
+//   - send func(model.Message) error
+func (_e *MockChat_Expecter) ConnectChat(ctx interface{}, input interface{}, send interface{}) *MockChat_ConnectChat_Call {
+	return &MockChat_ConnectChat_Call{Call: _e.mock.On("ConnectChat", ctx, input, send)}
+}
+
+func (_c *MockChat_ConnectChat_Call) Run(run func(ctx context.Context, input converter.ConnectChatInput, send func(model.Message) error)) *MockChat_ConnectChat_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(converter.ConnectChatInput), args[2].(func(model.Message) error))
+	})
+	return _c
+}
+
+func (_c *MockChat_ConnectChat_Call) Return(_a0 error) *MockChat_ConnectChat_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockChat_ConnectChat_Call) RunAndReturn(run func(context.Context, converter.ConnectChatInput, func(model.Message) error) error) *MockChat_ConnectChat_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateChannel provides a mock function with given fields: ctx, input
+func (_m *MockChat) CreateChannel(ctx context.Context, input converter.CreateChannelInput) (converter.CreateChatOutput, error) {
+	ret := _m.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateChannel")
+	}
+
+	var r0 converter.CreateChatOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, converter.CreateChannelInput) (converter.CreateChatOutput, error)); ok {
+		return rf(ctx, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, converter.CreateChannelInput) converter.CreateChatOutput); ok {
+		r0 = rf(ctx, input)
+	} else {
+		r0 = ret.Get(0).(converter.CreateChatOutput)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, converter.CreateChannelInput) error); ok {
+		r1 = rf(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockChat_CreateChannel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateChannel'
+type MockChat_CreateChannel_Call struct {
+	*mock.Call
+}
+
+// CreateChannel is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input converter.CreateChannelInput
+func (_e *MockChat_Expecter) CreateChannel(ctx interface{}, input interface{}) *MockChat_CreateChannel_Call {
+	return &MockChat_CreateChannel_Call{Call: _e.mock.On("CreateChannel", ctx, input)}
+}
+
+func (_c *MockChat_CreateChannel_Call) Run(run func(ctx context.Context, input converter.CreateChannelInput)) *MockChat_CreateChannel_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(converter.CreateChannelInput))
+	})
+	return _c
+}
+
+func (_c *MockChat_CreateChannel_Call) Return(_a0 converter.CreateChatOutput, _a1 error) *MockChat_CreateChannel_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockChat_CreateChannel_Call) RunAndReturn(run func(context.Context, converter.CreateChannelInput) (converter.CreateChatOutput, error)) *MockChat_CreateChannel_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateChat provides a mock function with given fields: ctx, input
 func (_m *MockChat) CreateChat(ctx context.Context, input converter.CreateChatInput) (converter.CreateChatOutput, error) {
 	ret := _m.Called(ctx, input)
@@ -126,16 +280,16 @@ func (_c *MockChat_DeleteChat_Call) RunAndReturn(run func(context.Context, conve
 	return _c
 }
 
-// SendMessage provides a mock function with given fields: ctx, input
-func (_m *MockChat) SendMessage(ctx context.Context, input converter.SendMessageInput) error {
+// DeleteMessage provides a mock function with given fields: ctx, input
+func (_m *MockChat) DeleteMessage(ctx context.Context, input converter.DeleteMessageInput) error {
 	ret := _m.Called(ctx, input)
 
 	if len(ret) == 0 {
-		panic("no return value specified for SendMessage")
+		panic("no return value specified for DeleteMessage")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, converter.SendMessageInput) error); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, converter.DeleteMessageInput) error); ok {
 		r0 = rf(ctx, input)
 	} else {
 		r0 = ret.Error(0)
@@ -144,31 +298,1094 @@ func (_m *MockChat) SendMessage(ctx context.Context, input converter.SendMessage
 	return r0
 }
 
-// MockChat_SendMessage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SendMessage'
-type MockChat_SendMessage_Call struct {
+// MockChat_DeleteMessage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteMessage'
+type MockChat_DeleteMessage_Call struct {
 	*mock.Call
 }
 
-// SendMessage is a helper method to define mock.On call
+// DeleteMessage is a helper method to define mock.On call
 //   - ctx context.Context
-//   - input converter.SendMessageInput
-func (_e *MockChat_Expecter) SendMessage(ctx interface{}, input interface{}) *MockChat_SendMessage_Call {
-	return &MockChat_SendMessage_Call{Call: _e.mock.On("SendMessage", ctx, input)}
+//   - input converter.DeleteMessageInput
+func (_e *MockChat_Expecter) DeleteMessage(ctx interface{}, input interface{}) *MockChat_DeleteMessage_Call {
+	return &MockChat_DeleteMessage_Call{Call: _e.mock.On("DeleteMessage", ctx, input)}
 }
 
-func (_c *MockChat_SendMessage_Call) Run(run func(ctx context.Context, input converter.SendMessageInput)) *MockChat_SendMessage_Call {
+func (_c *MockChat_DeleteMessage_Call) Run(run func(ctx context.Context, input converter.DeleteMessageInput)) *MockChat_DeleteMessage_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(converter.SendMessageInput))
+		run(args[0].(context.Context), args[1].(converter.DeleteMessageInput))
 	})
 	return _c
 }
 
-func (_c *MockChat_SendMessage_Call) Return(_a0 error) *MockChat_SendMessage_Call {
+func (_c *MockChat_DeleteMessage_Call) Return(_a0 error) *MockChat_DeleteMessage_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockChat_SendMessage_Call) RunAndReturn(run func(context.Context, converter.SendMessageInput) error) *MockChat_SendMessage_Call {
+func (_c *MockChat_DeleteMessage_Call) RunAndReturn(run func(context.Context, converter.DeleteMessageInput) error) *MockChat_DeleteMessage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// EditMessage provides a mock function with given fields: ctx, input
+func (_m *MockChat) EditMessage(ctx context.Context, input converter.EditMessageInput) (model.Message, error) {
+	ret := _m.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EditMessage")
+	}
+
+	var r0 model.Message
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, converter.EditMessageInput) (model.Message, error)); ok {
+		return rf(ctx, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, converter.EditMessageInput) model.Message); ok {
+		r0 = rf(ctx, input)
+	} else {
+		r0 = ret.Get(0).(model.Message)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, converter.EditMessageInput) error); ok {
+		r1 = rf(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockChat_EditMessage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EditMessage'
+type MockChat_EditMessage_Call struct {
+	*mock.Call
+}
+
+// EditMessage is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input converter.EditMessageInput
+func (_e *MockChat_Expecter) EditMessage(ctx interface{}, input interface{}) *MockChat_EditMessage_Call {
+	return &MockChat_EditMessage_Call{Call: _e.mock.On("EditMessage", ctx, input)}
+}
+
+func (_c *MockChat_EditMessage_Call) Run(run func(ctx context.Context, input converter.EditMessageInput)) *MockChat_EditMessage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(converter.EditMessageInput))
+	})
+	return _c
+}
+
+func (_c *MockChat_EditMessage_Call) Return(_a0 model.Message, _a1 error) *MockChat_EditMessage_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockChat_EditMessage_Call) RunAndReturn(run func(context.Context, converter.EditMessageInput) (model.Message, error)) *MockChat_EditMessage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetChat provides a mock function with given fields: ctx, input
+func (_m *MockChat) GetChat(ctx context.Context, input converter.GetChatInput) (model.Chat, error) {
+	ret := _m.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetChat")
+	}
+
+	var r0 model.Chat
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, converter.GetChatInput) (model.Chat, error)); ok {
+		return rf(ctx, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, converter.GetChatInput) model.Chat); ok {
+		r0 = rf(ctx, input)
+	} else {
+		r0 = ret.Get(0).(model.Chat)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, converter.GetChatInput) error); ok {
+		r1 = rf(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockChat_GetChat_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetChat'
+type MockChat_GetChat_Call struct {
+	*mock.Call
+}
+
+// GetChat is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input converter.GetChatInput
+func (_e *MockChat_Expecter) GetChat(ctx interface{}, input interface{}) *MockChat_GetChat_Call {
+	return &MockChat_GetChat_Call{Call: _e.mock.On("GetChat", ctx, input)}
+}
+
+func (_c *MockChat_GetChat_Call) Run(run func(ctx context.Context, input converter.GetChatInput)) *MockChat_GetChat_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(converter.GetChatInput))
+	})
+	return _c
+}
+
+func (_c *MockChat_GetChat_Call) Return(_a0 model.Chat, _a1 error) *MockChat_GetChat_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockChat_GetChat_Call) RunAndReturn(run func(context.Context, converter.GetChatInput) (model.Chat, error)) *MockChat_GetChat_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOrCreateDirectChat provides a mock function with given fields: ctx, input
+func (_m *MockChat) GetOrCreateDirectChat(ctx context.Context, input converter.GetOrCreateDirectChatInput) (converter.GetOrCreateDirectChatOutput, error) {
+	ret := _m.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrCreateDirectChat")
+	}
+
+	var r0 converter.GetOrCreateDirectChatOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, converter.GetOrCreateDirectChatInput) (converter.GetOrCreateDirectChatOutput, error)); ok {
+		return rf(ctx, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, converter.GetOrCreateDirectChatInput) converter.GetOrCreateDirectChatOutput); ok {
+		r0 = rf(ctx, input)
+	} else {
+		r0 = ret.Get(0).(converter.GetOrCreateDirectChatOutput)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, converter.GetOrCreateDirectChatInput) error); ok {
+		r1 = rf(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockChat_GetOrCreateDirectChat_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrCreateDirectChat'
+type MockChat_GetOrCreateDirectChat_Call struct {
+	*mock.Call
+}
+
+// GetOrCreateDirectChat is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input converter.GetOrCreateDirectChatInput
+func (_e *MockChat_Expecter) GetOrCreateDirectChat(ctx interface{}, input interface{}) *MockChat_GetOrCreateDirectChat_Call {
+	return &MockChat_GetOrCreateDirectChat_Call{Call: _e.mock.On("GetOrCreateDirectChat", ctx, input)}
+}
+
+func (_c *MockChat_GetOrCreateDirectChat_Call) Run(run func(ctx context.Context, input converter.GetOrCreateDirectChatInput)) *MockChat_GetOrCreateDirectChat_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(converter.GetOrCreateDirectChatInput))
+	})
+	return _c
+}
+
+func (_c *MockChat_GetOrCreateDirectChat_Call) Return(_a0 converter.GetOrCreateDirectChatOutput, _a1 error) *MockChat_GetOrCreateDirectChat_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockChat_GetOrCreateDirectChat_Call) RunAndReturn(run func(context.Context, converter.GetOrCreateDirectChatInput) (converter.GetOrCreateDirectChatOutput, error)) *MockChat_GetOrCreateDirectChat_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUnreadCounts provides a mock function with given fields: ctx, userID
+func (_m *MockChat) GetUnreadCounts(ctx context.Context, userID uint64) ([]model.UnreadCount, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUnreadCounts")
+	}
+
+	var r0 []model.UnreadCount
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) ([]model.UnreadCount, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) []model.UnreadCount); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.UnreadCount)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockChat_GetUnreadCounts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUnreadCounts'
+type MockChat_GetUnreadCounts_Call struct {
+	*mock.Call
+}
+
+// GetUnreadCounts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint64
+func (_e *MockChat_Expecter) GetUnreadCounts(ctx interface{}, userID interface{}) *MockChat_GetUnreadCounts_Call {
+	return &MockChat_GetUnreadCounts_Call{Call: _e.mock.On("GetUnreadCounts", ctx, userID)}
+}
+
+func (_c *MockChat_GetUnreadCounts_Call) Run(run func(ctx context.Context, userID uint64)) *MockChat_GetUnreadCounts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockChat_GetUnreadCounts_Call) Return(_a0 []model.UnreadCount, _a1 error) *MockChat_GetUnreadCounts_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockChat_GetUnreadCounts_Call) RunAndReturn(run func(context.Context, uint64) ([]model.UnreadCount, error)) *MockChat_GetUnreadCounts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LeaveChat provides a mock function with given fields: ctx, input
+func (_m *MockChat) LeaveChat(ctx context.Context, input converter.LeaveChatInput) error {
+	ret := _m.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LeaveChat")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, converter.LeaveChatInput) error); ok {
+		r0 = rf(ctx, input)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockChat_LeaveChat_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LeaveChat'
+type MockChat_LeaveChat_Call struct {
+	*mock.Call
+}
+
+// LeaveChat is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input converter.LeaveChatInput
+func (_e *MockChat_Expecter) LeaveChat(ctx interface{}, input interface{}) *MockChat_LeaveChat_Call {
+	return &MockChat_LeaveChat_Call{Call: _e.mock.On("LeaveChat", ctx, input)}
+}
+
+func (_c *MockChat_LeaveChat_Call) Run(run func(ctx context.Context, input converter.LeaveChatInput)) *MockChat_LeaveChat_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(converter.LeaveChatInput))
+	})
+	return _c
+}
+
+func (_c *MockChat_LeaveChat_Call) Return(_a0 error) *MockChat_LeaveChat_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockChat_LeaveChat_Call) RunAndReturn(run func(context.Context, converter.LeaveChatInput) error) *MockChat_LeaveChat_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListChats provides a mock function with given fields: ctx, input
+func (_m *MockChat) ListChats(ctx context.Context, input converter.ListChatsInput) (converter.ListChatsOutput, error) {
+	ret := _m.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListChats")
+	}
+
+	var r0 converter.ListChatsOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, converter.ListChatsInput) (converter.ListChatsOutput, error)); ok {
+		return rf(ctx, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, converter.ListChatsInput) converter.ListChatsOutput); ok {
+		r0 = rf(ctx, input)
+	} else {
+		r0 = ret.Get(0).(converter.ListChatsOutput)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, converter.ListChatsInput) error); ok {
+		r1 = rf(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockChat_ListChats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListChats'
+type MockChat_ListChats_Call struct {
+	*mock.Call
+}
+
+// ListChats is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input converter.ListChatsInput
+func (_e *MockChat_Expecter) ListChats(ctx interface{}, input interface{}) *MockChat_ListChats_Call {
+	return &MockChat_ListChats_Call{Call: _e.mock.On("ListChats", ctx, input)}
+}
+
+func (_c *MockChat_ListChats_Call) Run(run func(ctx context.Context, input converter.ListChatsInput)) *MockChat_ListChats_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(converter.ListChatsInput))
+	})
+	return _c
+}
+
+func (_c *MockChat_ListChats_Call) Return(_a0 converter.ListChatsOutput, _a1 error) *MockChat_ListChats_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockChat_ListChats_Call) RunAndReturn(run func(context.Context, converter.ListChatsInput) (converter.ListChatsOutput, error)) *MockChat_ListChats_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListMentions provides a mock function with given fields: ctx, input
+func (_m *MockChat) ListMentions(ctx context.Context, input converter.ListMentionsInput) (converter.ListMentionsOutput, error) {
+	ret := _m.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListMentions")
+	}
+
+	var r0 converter.ListMentionsOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, converter.ListMentionsInput) (converter.ListMentionsOutput, error)); ok {
+		return rf(ctx, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, converter.ListMentionsInput) converter.ListMentionsOutput); ok {
+		r0 = rf(ctx, input)
+	} else {
+		r0 = ret.Get(0).(converter.ListMentionsOutput)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, converter.ListMentionsInput) error); ok {
+		r1 = rf(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockChat_ListMentions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListMentions'
+type MockChat_ListMentions_Call struct {
+	*mock.Call
+}
+
+// ListMentions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input converter.ListMentionsInput
+func (_e *MockChat_Expecter) ListMentions(ctx interface{}, input interface{}) *MockChat_ListMentions_Call {
+	return &MockChat_ListMentions_Call{Call: _e.mock.On("ListMentions", ctx, input)}
+}
+
+func (_c *MockChat_ListMentions_Call) Run(run func(ctx context.Context, input converter.ListMentionsInput)) *MockChat_ListMentions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(converter.ListMentionsInput))
+	})
+	return _c
+}
+
+func (_c *MockChat_ListMentions_Call) Return(_a0 converter.ListMentionsOutput, _a1 error) *MockChat_ListMentions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockChat_ListMentions_Call) RunAndReturn(run func(context.Context, converter.ListMentionsInput) (converter.ListMentionsOutput, error)) *MockChat_ListMentions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListMessages provides a mock function with given fields: ctx, input
+func (_m *MockChat) ListMessages(ctx context.Context, input converter.ListMessagesInput) (converter.ListMessagesOutput, error) {
+	ret := _m.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListMessages")
+	}
+
+	var r0 converter.ListMessagesOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, converter.ListMessagesInput) (converter.ListMessagesOutput, error)); ok {
+		return rf(ctx, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, converter.ListMessagesInput) converter.ListMessagesOutput); ok {
+		r0 = rf(ctx, input)
+	} else {
+		r0 = ret.Get(0).(converter.ListMessagesOutput)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, converter.ListMessagesInput) error); ok {
+		r1 = rf(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockChat_ListMessages_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListMessages'
+type MockChat_ListMessages_Call struct {
+	*mock.Call
+}
+
+// ListMessages is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input converter.ListMessagesInput
+func (_e *MockChat_Expecter) ListMessages(ctx interface{}, input interface{}) *MockChat_ListMessages_Call {
+	return &MockChat_ListMessages_Call{Call: _e.mock.On("ListMessages", ctx, input)}
+}
+
+func (_c *MockChat_ListMessages_Call) Run(run func(ctx context.Context, input converter.ListMessagesInput)) *MockChat_ListMessages_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(converter.ListMessagesInput))
+	})
+	return _c
+}
+
+func (_c *MockChat_ListMessages_Call) Return(_a0 converter.ListMessagesOutput, _a1 error) *MockChat_ListMessages_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockChat_ListMessages_Call) RunAndReturn(run func(context.Context, converter.ListMessagesInput) (converter.ListMessagesOutput, error)) *MockChat_ListMessages_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListThread provides a mock function with given fields: ctx, input
+func (_m *MockChat) ListThread(ctx context.Context, input converter.ListThreadInput) (converter.ListThreadOutput, error) {
+	ret := _m.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListThread")
+	}
+
+	var r0 converter.ListThreadOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, converter.ListThreadInput) (converter.ListThreadOutput, error)); ok {
+		return rf(ctx, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, converter.ListThreadInput) converter.ListThreadOutput); ok {
+		r0 = rf(ctx, input)
+	} else {
+		r0 = ret.Get(0).(converter.ListThreadOutput)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, converter.ListThreadInput) error); ok {
+		r1 = rf(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockChat_ListThread_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListThread'
+type MockChat_ListThread_Call struct {
+	*mock.Call
+}
+
+// ListThread is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input converter.ListThreadInput
+func (_e *MockChat_Expecter) ListThread(ctx interface{}, input interface{}) *MockChat_ListThread_Call {
+	return &MockChat_ListThread_Call{Call: _e.mock.On("ListThread", ctx, input)}
+}
+
+func (_c *MockChat_ListThread_Call) Run(run func(ctx context.Context, input converter.ListThreadInput)) *MockChat_ListThread_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(converter.ListThreadInput))
+	})
+	return _c
+}
+
+func (_c *MockChat_ListThread_Call) Return(_a0 converter.ListThreadOutput, _a1 error) *MockChat_ListThread_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockChat_ListThread_Call) RunAndReturn(run func(context.Context, converter.ListThreadInput) (converter.ListThreadOutput, error)) *MockChat_ListThread_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkRead provides a mock function with given fields: ctx, input
+func (_m *MockChat) MarkRead(ctx context.Context, input converter.MarkReadInput) error {
+	ret := _m.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkRead")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, converter.MarkReadInput) error); ok {
+		r0 = rf(ctx, input)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockChat_MarkRead_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkRead'
+type MockChat_MarkRead_Call struct {
+	*mock.Call
+}
+
+// MarkRead is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input converter.MarkReadInput
+func (_e *MockChat_Expecter) MarkRead(ctx interface{}, input interface{}) *MockChat_MarkRead_Call {
+	return &MockChat_MarkRead_Call{Call: _e.mock.On("MarkRead", ctx, input)}
+}
+
+func (_c *MockChat_MarkRead_Call) Run(run func(ctx context.Context, input converter.MarkReadInput)) *MockChat_MarkRead_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(converter.MarkReadInput))
+	})
+	return _c
+}
+
+func (_c *MockChat_MarkRead_Call) Return(_a0 error) *MockChat_MarkRead_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockChat_MarkRead_Call) RunAndReturn(run func(context.Context, converter.MarkReadInput) error) *MockChat_MarkRead_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MuteChat provides a mock function with given fields: ctx, input
+func (_m *MockChat) MuteChat(ctx context.Context, input converter.MuteChatInput) error {
+	ret := _m.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MuteChat")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, converter.MuteChatInput) error); ok {
+		r0 = rf(ctx, input)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockChat_MuteChat_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MuteChat'
+type MockChat_MuteChat_Call struct {
+	*mock.Call
+}
+
+// MuteChat is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input converter.MuteChatInput
+func (_e *MockChat_Expecter) MuteChat(ctx interface{}, input interface{}) *MockChat_MuteChat_Call {
+	return &MockChat_MuteChat_Call{Call: _e.mock.On("MuteChat", ctx, input)}
+}
+
+func (_c *MockChat_MuteChat_Call) Run(run func(ctx context.Context, input converter.MuteChatInput)) *MockChat_MuteChat_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(converter.MuteChatInput))
+	})
+	return _c
+}
+
+func (_c *MockChat_MuteChat_Call) Return(_a0 error) *MockChat_MuteChat_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockChat_MuteChat_Call) RunAndReturn(run func(context.Context, converter.MuteChatInput) error) *MockChat_MuteChat_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Presence provides a mock function with given fields: ctx, userID, recv, send
+func (_m *MockChat) Presence(ctx context.Context, userID uint64, recv func() (converter.PresenceInput, error), send func(model.PresenceEvent) error) error {
+	ret := _m.Called(ctx, userID, recv, send)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Presence")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, func() (converter.PresenceInput, error), func(model.PresenceEvent) error) error); ok {
+		r0 = rf(ctx, userID, recv, send)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockChat_Presence_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Presence'
+type MockChat_Presence_Call struct {
+	*mock.Call
+}
+
+// Presence is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint64
+//   - recv func()(converter.PresenceInput , error)
+//   - send func(model.PresenceEvent) error
+func (_e *MockChat_Expecter) Presence(ctx interface{}, userID interface{}, recv interface{}, send interface{}) *MockChat_Presence_Call {
+	return &MockChat_Presence_Call{Call: _e.mock.On("Presence", ctx, userID, recv, send)}
+}
+
+func (_c *MockChat_Presence_Call) Run(run func(ctx context.Context, userID uint64, recv func() (converter.PresenceInput, error), send func(model.PresenceEvent) error)) *MockChat_Presence_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(func() (converter.PresenceInput, error)), args[3].(func(model.PresenceEvent) error))
+	})
+	return _c
+}
+
+func (_c *MockChat_Presence_Call) Return(_a0 error) *MockChat_Presence_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockChat_Presence_Call) RunAndReturn(run func(context.Context, uint64, func() (converter.PresenceInput, error), func(model.PresenceEvent) error) error) *MockChat_Presence_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PurgeDeletedChats provides a mock function with given fields: ctx
+func (_m *MockChat) PurgeDeletedChats(ctx context.Context) (int64, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PurgeDeletedChats")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (int64, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) int64); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockChat_PurgeDeletedChats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PurgeDeletedChats'
+type MockChat_PurgeDeletedChats_Call struct {
+	*mock.Call
+}
+
+// PurgeDeletedChats is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockChat_Expecter) PurgeDeletedChats(ctx interface{}) *MockChat_PurgeDeletedChats_Call {
+	return &MockChat_PurgeDeletedChats_Call{Call: _e.mock.On("PurgeDeletedChats", ctx)}
+}
+
+func (_c *MockChat_PurgeDeletedChats_Call) Run(run func(ctx context.Context)) *MockChat_PurgeDeletedChats_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockChat_PurgeDeletedChats_Call) Return(_a0 int64, _a1 error) *MockChat_PurgeDeletedChats_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockChat_PurgeDeletedChats_Call) RunAndReturn(run func(context.Context) (int64, error)) *MockChat_PurgeDeletedChats_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveMember provides a mock function with given fields: ctx, input
+func (_m *MockChat) RemoveMember(ctx context.Context, input converter.RemoveMemberInput) error {
+	ret := _m.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveMember")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, converter.RemoveMemberInput) error); ok {
+		r0 = rf(ctx, input)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockChat_RemoveMember_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveMember'
+type MockChat_RemoveMember_Call struct {
+	*mock.Call
+}
+
+// RemoveMember is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input converter.RemoveMemberInput
+func (_e *MockChat_Expecter) RemoveMember(ctx interface{}, input interface{}) *MockChat_RemoveMember_Call {
+	return &MockChat_RemoveMember_Call{Call: _e.mock.On("RemoveMember", ctx, input)}
+}
+
+func (_c *MockChat_RemoveMember_Call) Run(run func(ctx context.Context, input converter.RemoveMemberInput)) *MockChat_RemoveMember_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(converter.RemoveMemberInput))
+	})
+	return _c
+}
+
+func (_c *MockChat_RemoveMember_Call) Return(_a0 error) *MockChat_RemoveMember_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockChat_RemoveMember_Call) RunAndReturn(run func(context.Context, converter.RemoveMemberInput) error) *MockChat_RemoveMember_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RestoreChat provides a mock function with given fields: ctx, input
+func (_m *MockChat) RestoreChat(ctx context.Context, input converter.RestoreChatInput) error {
+	ret := _m.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RestoreChat")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, converter.RestoreChatInput) error); ok {
+		r0 = rf(ctx, input)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockChat_RestoreChat_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestoreChat'
+type MockChat_RestoreChat_Call struct {
+	*mock.Call
+}
+
+// RestoreChat is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input converter.RestoreChatInput
+func (_e *MockChat_Expecter) RestoreChat(ctx interface{}, input interface{}) *MockChat_RestoreChat_Call {
+	return &MockChat_RestoreChat_Call{Call: _e.mock.On("RestoreChat", ctx, input)}
+}
+
+func (_c *MockChat_RestoreChat_Call) Run(run func(ctx context.Context, input converter.RestoreChatInput)) *MockChat_RestoreChat_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(converter.RestoreChatInput))
+	})
+	return _c
+}
+
+func (_c *MockChat_RestoreChat_Call) Return(_a0 error) *MockChat_RestoreChat_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockChat_RestoreChat_Call) RunAndReturn(run func(context.Context, converter.RestoreChatInput) error) *MockChat_RestoreChat_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SearchMessages provides a mock function with given fields: ctx, input
+func (_m *MockChat) SearchMessages(ctx context.Context, input converter.SearchMessagesInput) (converter.SearchMessagesOutput, error) {
+	ret := _m.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchMessages")
+	}
+
+	var r0 converter.SearchMessagesOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, converter.SearchMessagesInput) (converter.SearchMessagesOutput, error)); ok {
+		return rf(ctx, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, converter.SearchMessagesInput) converter.SearchMessagesOutput); ok {
+		r0 = rf(ctx, input)
+	} else {
+		r0 = ret.Get(0).(converter.SearchMessagesOutput)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, converter.SearchMessagesInput) error); ok {
+		r1 = rf(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockChat_SearchMessages_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchMessages'
+type MockChat_SearchMessages_Call struct {
+	*mock.Call
+}
+
+// SearchMessages is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input converter.SearchMessagesInput
+func (_e *MockChat_Expecter) SearchMessages(ctx interface{}, input interface{}) *MockChat_SearchMessages_Call {
+	return &MockChat_SearchMessages_Call{Call: _e.mock.On("SearchMessages", ctx, input)}
+}
+
+func (_c *MockChat_SearchMessages_Call) Run(run func(ctx context.Context, input converter.SearchMessagesInput)) *MockChat_SearchMessages_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(converter.SearchMessagesInput))
+	})
+	return _c
+}
+
+func (_c *MockChat_SearchMessages_Call) Return(_a0 converter.SearchMessagesOutput, _a1 error) *MockChat_SearchMessages_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockChat_SearchMessages_Call) RunAndReturn(run func(context.Context, converter.SearchMessagesInput) (converter.SearchMessagesOutput, error)) *MockChat_SearchMessages_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SendMessage provides a mock function with given fields: ctx, input
+func (_m *MockChat) SendMessage(ctx context.Context, input converter.SendMessageInput) (model.Message, error) {
+	ret := _m.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SendMessage")
+	}
+
+	var r0 model.Message
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, converter.SendMessageInput) (model.Message, error)); ok {
+		return rf(ctx, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, converter.SendMessageInput) model.Message); ok {
+		r0 = rf(ctx, input)
+	} else {
+		r0 = ret.Get(0).(model.Message)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, converter.SendMessageInput) error); ok {
+		r1 = rf(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockChat_SendMessage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SendMessage'
+type MockChat_SendMessage_Call struct {
+	*mock.Call
+}
+
+// SendMessage is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input converter.SendMessageInput
+func (_e *MockChat_Expecter) SendMessage(ctx interface{}, input interface{}) *MockChat_SendMessage_Call {
+	return &MockChat_SendMessage_Call{Call: _e.mock.On("SendMessage", ctx, input)}
+}
+
+func (_c *MockChat_SendMessage_Call) Run(run func(ctx context.Context, input converter.SendMessageInput)) *MockChat_SendMessage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(converter.SendMessageInput))
+	})
+	return _c
+}
+
+func (_c *MockChat_SendMessage_Call) Return(_a0 model.Message, _a1 error) *MockChat_SendMessage_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockChat_SendMessage_Call) RunAndReturn(run func(context.Context, converter.SendMessageInput) (model.Message, error)) *MockChat_SendMessage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetMemberAdmin provides a mock function with given fields: ctx, input
+func (_m *MockChat) SetMemberAdmin(ctx context.Context, input converter.SetMemberAdminInput) error {
+	ret := _m.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetMemberAdmin")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, converter.SetMemberAdminInput) error); ok {
+		r0 = rf(ctx, input)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockChat_SetMemberAdmin_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetMemberAdmin'
+type MockChat_SetMemberAdmin_Call struct {
+	*mock.Call
+}
+
+// SetMemberAdmin is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input converter.SetMemberAdminInput
+func (_e *MockChat_Expecter) SetMemberAdmin(ctx interface{}, input interface{}) *MockChat_SetMemberAdmin_Call {
+	return &MockChat_SetMemberAdmin_Call{Call: _e.mock.On("SetMemberAdmin", ctx, input)}
+}
+
+func (_c *MockChat_SetMemberAdmin_Call) Run(run func(ctx context.Context, input converter.SetMemberAdminInput)) *MockChat_SetMemberAdmin_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(converter.SetMemberAdminInput))
+	})
+	return _c
+}
+
+func (_c *MockChat_SetMemberAdmin_Call) Return(_a0 error) *MockChat_SetMemberAdmin_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockChat_SetMemberAdmin_Call) RunAndReturn(run func(context.Context, converter.SetMemberAdminInput) error) *MockChat_SetMemberAdmin_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Subscribe provides a mock function with given fields: ctx, input
+func (_m *MockChat) Subscribe(ctx context.Context, input converter.SubscribeInput) error {
+	ret := _m.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Subscribe")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, converter.SubscribeInput) error); ok {
+		r0 = rf(ctx, input)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockChat_Subscribe_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Subscribe'
+type MockChat_Subscribe_Call struct {
+	*mock.Call
+}
+
+// Subscribe is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input converter.SubscribeInput
+func (_e *MockChat_Expecter) Subscribe(ctx interface{}, input interface{}) *MockChat_Subscribe_Call {
+	return &MockChat_Subscribe_Call{Call: _e.mock.On("Subscribe", ctx, input)}
+}
+
+func (_c *MockChat_Subscribe_Call) Run(run func(ctx context.Context, input converter.SubscribeInput)) *MockChat_Subscribe_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(converter.SubscribeInput))
+	})
+	return _c
+}
+
+func (_c *MockChat_Subscribe_Call) Return(_a0 error) *MockChat_Subscribe_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockChat_Subscribe_Call) RunAndReturn(run func(context.Context, converter.SubscribeInput) error) *MockChat_Subscribe_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateChat provides a mock function with given fields: ctx, input
+func (_m *MockChat) UpdateChat(ctx context.Context, input converter.UpdateChatInput) (model.Chat, error) {
+	ret := _m.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateChat")
+	}
+
+	var r0 model.Chat
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, converter.UpdateChatInput) (model.Chat, error)); ok {
+		return rf(ctx, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, converter.UpdateChatInput) model.Chat); ok {
+		r0 = rf(ctx, input)
+	} else {
+		r0 = ret.Get(0).(model.Chat)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, converter.UpdateChatInput) error); ok {
+		r1 = rf(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockChat_UpdateChat_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateChat'
+type MockChat_UpdateChat_Call struct {
+	*mock.Call
+}
+
+// UpdateChat is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input converter.UpdateChatInput
+func (_e *MockChat_Expecter) UpdateChat(ctx interface{}, input interface{}) *MockChat_UpdateChat_Call {
+	return &MockChat_UpdateChat_Call{Call: _e.mock.On("UpdateChat", ctx, input)}
+}
+
+func (_c *MockChat_UpdateChat_Call) Run(run func(ctx context.Context, input converter.UpdateChatInput)) *MockChat_UpdateChat_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(converter.UpdateChatInput))
+	})
+	return _c
+}
+
+func (_c *MockChat_UpdateChat_Call) Return(_a0 model.Chat, _a1 error) *MockChat_UpdateChat_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockChat_UpdateChat_Call) RunAndReturn(run func(context.Context, converter.UpdateChatInput) (model.Chat, error)) *MockChat_UpdateChat_Call {
 	_c.Call.Return(run)
 	return _c
 }
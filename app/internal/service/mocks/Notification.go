@@ -0,0 +1,99 @@
+// Code generated by mockery v2.42.0. DO NOT EDIT.
+
+package mockservicedef
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockNotification is an autogenerated mock type for the Notification type
+type MockNotification struct {
+	mock.Mock
+}
+
+type MockNotification_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockNotification) EXPECT() *MockNotification_Expecter {
+	return &MockNotification_Expecter{mock: &_m.Mock}
+}
+
+// Dispatch provides a mock function with given fields: ctx
+func (_m *MockNotification) Dispatch(ctx context.Context) (int64, int64, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Dispatch")
+	}
+
+	var r0 int64
+	var r1 int64
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context) (int64, int64, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) int64); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) int64); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context) error); ok {
+		r2 = rf(ctx)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockNotification_Dispatch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Dispatch'
+type MockNotification_Dispatch_Call struct {
+	*mock.Call
+}
+
+// Dispatch is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockNotification_Expecter) Dispatch(ctx interface{}) *MockNotification_Dispatch_Call {
+	return &MockNotification_Dispatch_Call{Call: _e.mock.On("Dispatch", ctx)}
+}
+
+func (_c *MockNotification_Dispatch_Call) Run(run func(ctx context.Context)) *MockNotification_Dispatch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockNotification_Dispatch_Call) Return(sent int64, failed int64, err error) *MockNotification_Dispatch_Call {
+	_c.Call.Return(sent, failed, err)
+	return _c
+}
+
+func (_c *MockNotification_Dispatch_Call) RunAndReturn(run func(context.Context) (int64, int64, error)) *MockNotification_Dispatch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockNotification creates a new instance of MockNotification. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockNotification(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockNotification {
+	mock := &MockNotification{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
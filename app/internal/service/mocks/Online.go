@@ -0,0 +1,179 @@
+// Code generated by mockery v2.42.0. DO NOT EDIT.
+
+package mockservicedef
+
+import (
+	context "context"
+
+	converter "github.com/defany/chat-server/app/internal/converter"
+	mock "github.com/stretchr/testify/mock"
+
+	model "github.com/defany/chat-server/app/internal/model"
+)
+
+// MockOnline is an autogenerated mock type for the Online type
+type MockOnline struct {
+	mock.Mock
+}
+
+type MockOnline_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockOnline) EXPECT() *MockOnline_Expecter {
+	return &MockOnline_Expecter{mock: &_m.Mock}
+}
+
+// Connect provides a mock function with given fields: ctx, userID
+func (_m *MockOnline) Connect(ctx context.Context, userID uint64) {
+	_m.Called(ctx, userID)
+}
+
+// MockOnline_Connect_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Connect'
+type MockOnline_Connect_Call struct {
+	*mock.Call
+}
+
+// Connect is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint64
+func (_e *MockOnline_Expecter) Connect(ctx interface{}, userID interface{}) *MockOnline_Connect_Call {
+	return &MockOnline_Connect_Call{Call: _e.mock.On("Connect", ctx, userID)}
+}
+
+func (_c *MockOnline_Connect_Call) Run(run func(ctx context.Context, userID uint64)) *MockOnline_Connect_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockOnline_Connect_Call) Return() *MockOnline_Connect_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockOnline_Connect_Call) RunAndReturn(run func(context.Context, uint64)) *MockOnline_Connect_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Disconnect provides a mock function with given fields: ctx, userID
+func (_m *MockOnline) Disconnect(ctx context.Context, userID uint64) error {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Disconnect")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) error); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockOnline_Disconnect_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Disconnect'
+type MockOnline_Disconnect_Call struct {
+	*mock.Call
+}
+
+// Disconnect is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uint64
+func (_e *MockOnline_Expecter) Disconnect(ctx interface{}, userID interface{}) *MockOnline_Disconnect_Call {
+	return &MockOnline_Disconnect_Call{Call: _e.mock.On("Disconnect", ctx, userID)}
+}
+
+func (_c *MockOnline_Disconnect_Call) Run(run func(ctx context.Context, userID uint64)) *MockOnline_Disconnect_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockOnline_Disconnect_Call) Return(_a0 error) *MockOnline_Disconnect_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockOnline_Disconnect_Call) RunAndReturn(run func(context.Context, uint64) error) *MockOnline_Disconnect_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPresence provides a mock function with given fields: ctx, input
+func (_m *MockOnline) GetPresence(ctx context.Context, input converter.GetPresenceInput) ([]model.UserPresence, error) {
+	ret := _m.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPresence")
+	}
+
+	var r0 []model.UserPresence
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, converter.GetPresenceInput) ([]model.UserPresence, error)); ok {
+		return rf(ctx, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, converter.GetPresenceInput) []model.UserPresence); ok {
+		r0 = rf(ctx, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.UserPresence)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, converter.GetPresenceInput) error); ok {
+		r1 = rf(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockOnline_GetPresence_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPresence'
+type MockOnline_GetPresence_Call struct {
+	*mock.Call
+}
+
+// GetPresence is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input converter.GetPresenceInput
+func (_e *MockOnline_Expecter) GetPresence(ctx interface{}, input interface{}) *MockOnline_GetPresence_Call {
+	return &MockOnline_GetPresence_Call{Call: _e.mock.On("GetPresence", ctx, input)}
+}
+
+func (_c *MockOnline_GetPresence_Call) Run(run func(ctx context.Context, input converter.GetPresenceInput)) *MockOnline_GetPresence_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(converter.GetPresenceInput))
+	})
+	return _c
+}
+
+func (_c *MockOnline_GetPresence_Call) Return(_a0 []model.UserPresence, _a1 error) *MockOnline_GetPresence_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockOnline_GetPresence_Call) RunAndReturn(run func(context.Context, converter.GetPresenceInput) ([]model.UserPresence, error)) *MockOnline_GetPresence_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockOnline creates a new instance of MockOnline. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockOnline(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockOnline {
+	mock := &MockOnline{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,131 @@
+// Code generated by mockery v2.42.0. DO NOT EDIT.
+
+package mockservicedef
+
+import (
+	context "context"
+
+	converter "github.com/defany/chat-server/app/internal/converter"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockReaction is an autogenerated mock type for the Reaction type
+type MockReaction struct {
+	mock.Mock
+}
+
+type MockReaction_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockReaction) EXPECT() *MockReaction_Expecter {
+	return &MockReaction_Expecter{mock: &_m.Mock}
+}
+
+// AddReaction provides a mock function with given fields: ctx, input
+func (_m *MockReaction) AddReaction(ctx context.Context, input converter.ReactionInput) error {
+	ret := _m.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddReaction")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, converter.ReactionInput) error); ok {
+		r0 = rf(ctx, input)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockReaction_AddReaction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddReaction'
+type MockReaction_AddReaction_Call struct {
+	*mock.Call
+}
+
+// AddReaction is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input converter.ReactionInput
+func (_e *MockReaction_Expecter) AddReaction(ctx interface{}, input interface{}) *MockReaction_AddReaction_Call {
+	return &MockReaction_AddReaction_Call{Call: _e.mock.On("AddReaction", ctx, input)}
+}
+
+func (_c *MockReaction_AddReaction_Call) Run(run func(ctx context.Context, input converter.ReactionInput)) *MockReaction_AddReaction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(converter.ReactionInput))
+	})
+	return _c
+}
+
+func (_c *MockReaction_AddReaction_Call) Return(_a0 error) *MockReaction_AddReaction_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockReaction_AddReaction_Call) RunAndReturn(run func(context.Context, converter.ReactionInput) error) *MockReaction_AddReaction_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveReaction provides a mock function with given fields: ctx, input
+func (_m *MockReaction) RemoveReaction(ctx context.Context, input converter.ReactionInput) error {
+	ret := _m.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveReaction")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, converter.ReactionInput) error); ok {
+		r0 = rf(ctx, input)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockReaction_RemoveReaction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveReaction'
+type MockReaction_RemoveReaction_Call struct {
+	*mock.Call
+}
+
+// RemoveReaction is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input converter.ReactionInput
+func (_e *MockReaction_Expecter) RemoveReaction(ctx interface{}, input interface{}) *MockReaction_RemoveReaction_Call {
+	return &MockReaction_RemoveReaction_Call{Call: _e.mock.On("RemoveReaction", ctx, input)}
+}
+
+func (_c *MockReaction_RemoveReaction_Call) Run(run func(ctx context.Context, input converter.ReactionInput)) *MockReaction_RemoveReaction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(converter.ReactionInput))
+	})
+	return _c
+}
+
+func (_c *MockReaction_RemoveReaction_Call) Return(_a0 error) *MockReaction_RemoveReaction_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockReaction_RemoveReaction_Call) RunAndReturn(run func(context.Context, converter.ReactionInput) error) *MockReaction_RemoveReaction_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockReaction creates a new instance of MockReaction. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockReaction(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockReaction {
+	mock := &MockReaction{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,109 @@
+package notificationservice
+
+import (
+	"context"
+	"time"
+
+	"github.com/defany/slogger/pkg/logger/sl"
+)
+
+// Dispatch забирает уведомления пачками и доставляет по одному. Доставленные отмечаются всей пачкой в конце,
+// поэтому при падении между доставкой и отметкой уведомление придет повторно - получатель дедуплицирует его по id
+func (s *service) Dispatch(ctx context.Context) (int64, int64, error) {
+	op := sl.FnName()
+
+	var sent, failed int64
+
+	err := s.expand(ctx)
+	if err != nil {
+		return sent, failed, sl.Err(op, err)
+	}
+
+	for {
+		notifications, err := s.notifications.Claim(ctx, s.cfg.BatchSize, s.cfg.MaxAttempts, s.cfg.Lease)
+		if err != nil {
+			return sent, failed, sl.Err(op, err)
+		}
+
+		delivered := make([]uint64, 0, len(notifications))
+
+		for _, notification := range notifications {
+			// Про удаленное сообщение сообщать уже нечего, просто убираем уведомление из очереди
+			if notification.MessageDeleted {
+				delivered = append(delivered, notification.ID)
+
+				continue
+			}
+
+			err := s.notifier.Notify(ctx, notification)
+			if err == nil {
+				delivered = append(delivered, notification.ID)
+
+				continue
+			}
+
+			failed++
+
+			// После последней попытки строка так и остается неотправленной, Claim ее больше не возьмет
+			err = s.notifications.Retry(ctx, notification.ID, s.retryDelay(notification.Attempts), err.Error())
+			if err != nil {
+				return sent, failed, sl.Err(op, err)
+			}
+		}
+
+		if err := s.notifications.MarkSent(ctx, delivered); err != nil {
+			return sent, failed, sl.Err(op, err)
+		}
+
+		sent += int64(len(delivered))
+
+		if uint64(len(notifications)) < s.cfg.BatchSize {
+			return sent, failed, nil
+		}
+	}
+}
+
+// expand раскладывает outbox по получателям. Каждая пачка в своей транзакции, чтобы не держать блокировки на весь разбор
+func (s *service) expand(ctx context.Context) error {
+	for {
+		var pending []uint64
+
+		err := s.tx.ReadCommitted(ctx, func(ctx context.Context) error {
+			var err error
+
+			pending, err = s.notifications.Pending(ctx, s.cfg.BatchSize)
+			if err != nil {
+				return err
+			}
+
+			if len(pending) == 0 {
+				return nil
+			}
+
+			_, err = s.notifications.Expand(ctx, pending)
+			if err != nil {
+				return err
+			}
+
+			return s.notifications.Remove(ctx, pending)
+		})
+		if err != nil {
+			return err
+		}
+
+		if uint64(len(pending)) < s.cfg.BatchSize {
+			return nil
+		}
+	}
+}
+
+// retryDelay растет вдвое с каждой попыткой, чтобы не долбить лежащий вебхук
+func (s *service) retryDelay(attempts int) time.Duration {
+	delay := s.cfg.RetryDelay
+
+	for i := 1; i < attempts && delay < s.cfg.MaxRetryDelay; i++ {
+		delay *= 2
+	}
+
+	return min(delay, s.cfg.MaxRetryDelay)
+}
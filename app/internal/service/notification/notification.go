@@ -0,0 +1,55 @@
+package notificationservice
+
+import (
+	"time"
+
+	"github.com/defany/chat-server/app/internal/client"
+	"github.com/defany/chat-server/app/internal/config"
+	"github.com/defany/chat-server/app/internal/repository"
+	servicedef "github.com/defany/chat-server/app/internal/service"
+	"github.com/defany/db/pkg/postgres"
+)
+
+const (
+	defaultBatchSize     = 100
+	defaultMaxAttempts   = 10
+	defaultRetryDelay    = 5 * time.Second
+	defaultMaxRetryDelay = 10 * time.Minute
+	defaultLease         = time.Minute
+)
+
+type service struct {
+	tx            postgres.TxManager
+	notifications repository.Notification
+	notifier      client.Notifier
+	cfg           config.Notifications
+}
+
+func NewService(tx postgres.TxManager, notifications repository.Notification, notifier client.Notifier, cfg config.Notifications) servicedef.Notification {
+	if cfg.BatchSize == 0 {
+		cfg.BatchSize = defaultBatchSize
+	}
+
+	if cfg.MaxAttempts == 0 {
+		cfg.MaxAttempts = defaultMaxAttempts
+	}
+
+	if cfg.RetryDelay == 0 {
+		cfg.RetryDelay = defaultRetryDelay
+	}
+
+	if cfg.MaxRetryDelay == 0 {
+		cfg.MaxRetryDelay = defaultMaxRetryDelay
+	}
+
+	if cfg.Lease == 0 {
+		cfg.Lease = defaultLease
+	}
+
+	return &service{
+		tx:            tx,
+		notifications: notifications,
+		notifier:      notifier,
+		cfg:           cfg,
+	}
+}
@@ -0,0 +1,197 @@
+package notificationtests
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+	mockclient "github.com/defany/chat-server/app/internal/client/mocks"
+	"github.com/defany/chat-server/app/internal/config"
+	"github.com/defany/chat-server/app/internal/model"
+	mockrepository "github.com/defany/chat-server/app/internal/repository/mocks"
+	notificationservice "github.com/defany/chat-server/app/internal/service/notification"
+	"github.com/defany/db/pkg/postgres"
+	mockpostgres "github.com/defany/db/pkg/postgres/mocks"
+	"github.com/defany/slogger/pkg/logger/sl"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService_Dispatch(t *testing.T) {
+	var (
+		ctx = context.Background()
+
+		cfg = config.Notifications{
+			BatchSize:     2,
+			MaxAttempts:   5,
+			RetryDelay:    time.Second,
+			MaxRetryDelay: 3 * time.Second,
+			Lease:         time.Minute,
+		}
+
+		first = model.Notification{
+			ID:        gofakeit.Uint64(),
+			Kind:      model.NotificationMention,
+			UserID:    gofakeit.Uint64(),
+			ChatID:    gofakeit.Int64(),
+			MessageID: gofakeit.Uint64(),
+			Text:      gofakeit.Sentence(3),
+			Attempts:  1,
+		}
+
+		second = model.Notification{
+			ID:        first.ID + 1,
+			Kind:      model.NotificationMessage,
+			UserID:    gofakeit.Uint64(),
+			ChatID:    first.ChatID,
+			MessageID: first.MessageID,
+			Text:      first.Text,
+			Attempts:  3,
+		}
+
+		third = model.Notification{
+			ID:       first.ID + 2,
+			Kind:     model.NotificationMessage,
+			UserID:   gofakeit.Uint64(),
+			Attempts: 1,
+		}
+
+		deleted = model.Notification{
+			ID:             first.ID + 3,
+			Kind:           model.NotificationMessage,
+			UserID:         gofakeit.Uint64(),
+			MessageDeleted: true,
+			Attempts:       1,
+		}
+
+		outbox = []uint64{gofakeit.Uint64(), gofakeit.Uint64()}
+
+		claimErr = errors.New("connection refused")
+
+		expandErr = errors.New("deadlock detected")
+
+		webhookErr = errors.New("webhook responded with status 503")
+	)
+
+	// После третьей попытки задержка 1s * 2 * 2 = 4s, но ее режет MaxRetryDelay
+	retryDelay := cfg.MaxRetryDelay
+
+	emptyOutbox := func(txCtx context.Context, notifications *mockrepository.MockNotification) {
+		notifications.On("Pending", txCtx, cfg.BatchSize).Return([]uint64(nil), nil)
+	}
+
+	tests := []struct {
+		name   string
+		sent   int64
+		failed int64
+		err    error
+		// expand вызывается внутри транзакции, поэтому получает txCtx, а доставка идет с исходным ctx
+		expand func(txCtx context.Context, notifications *mockrepository.MockNotification)
+		commit bool
+		mocker func(notifications *mockrepository.MockNotification, notifier *mockclient.MockNotifier)
+	}{
+		{
+			name:   "failed notification is retried later",
+			sent:   2,
+			failed: 1,
+			expand: emptyOutbox,
+			commit: true,
+			mocker: func(notifications *mockrepository.MockNotification, notifier *mockclient.MockNotifier) {
+				notifications.On("Claim", ctx, cfg.BatchSize, cfg.MaxAttempts, cfg.Lease).Return([]model.Notification{first, second}, nil).Once()
+				notifications.On("Claim", ctx, cfg.BatchSize, cfg.MaxAttempts, cfg.Lease).Return([]model.Notification{third}, nil).Once()
+
+				notifier.On("Notify", ctx, first).Return(nil)
+				notifier.On("Notify", ctx, second).Return(webhookErr)
+				notifier.On("Notify", ctx, third).Return(nil)
+
+				notifications.On("Retry", ctx, second.ID, retryDelay, webhookErr.Error()).Return(nil)
+				notifications.On("MarkSent", ctx, []uint64{first.ID}).Return(nil)
+				notifications.On("MarkSent", ctx, []uint64{third.ID}).Return(nil)
+			},
+		},
+		{
+			name:   "queue is empty",
+			expand: emptyOutbox,
+			commit: true,
+			mocker: func(notifications *mockrepository.MockNotification, notifier *mockclient.MockNotifier) {
+				notifications.On("Claim", ctx, cfg.BatchSize, cfg.MaxAttempts, cfg.Lease).Return([]model.Notification(nil), nil)
+				notifications.On("MarkSent", ctx, []uint64{}).Return(nil)
+			},
+		},
+		{
+			name:   "outbox is unavailable",
+			err:    sl.Err("service.Dispatch", claimErr),
+			expand: emptyOutbox,
+			commit: true,
+			mocker: func(notifications *mockrepository.MockNotification, notifier *mockclient.MockNotifier) {
+				notifications.On("Claim", ctx, cfg.BatchSize, cfg.MaxAttempts, cfg.Lease).Return([]model.Notification(nil), claimErr)
+			},
+		},
+		{
+			name:   "outbox is expanded before delivery",
+			commit: true,
+			expand: func(txCtx context.Context, notifications *mockrepository.MockNotification) {
+				notifications.On("Pending", txCtx, cfg.BatchSize).Return(outbox, nil).Once()
+				notifications.On("Expand", txCtx, outbox).Return(int64(10000), nil)
+				notifications.On("Remove", txCtx, outbox).Return(nil)
+				notifications.On("Pending", txCtx, cfg.BatchSize).Return([]uint64(nil), nil).Once()
+			},
+			mocker: func(notifications *mockrepository.MockNotification, notifier *mockclient.MockNotifier) {
+				notifications.On("Claim", ctx, cfg.BatchSize, cfg.MaxAttempts, cfg.Lease).Return([]model.Notification(nil), nil)
+				notifications.On("MarkSent", ctx, []uint64{}).Return(nil)
+			},
+		},
+		{
+			name: "failed expansion is rolled back",
+			err:  sl.Err("service.Dispatch", expandErr),
+			expand: func(txCtx context.Context, notifications *mockrepository.MockNotification) {
+				notifications.On("Pending", txCtx, cfg.BatchSize).Return(outbox, nil)
+				notifications.On("Expand", txCtx, outbox).Return(int64(0), expandErr)
+			},
+			mocker: func(notifications *mockrepository.MockNotification, notifier *mockclient.MockNotifier) {},
+		},
+		{
+			name:   "notification about deleted message is dropped",
+			sent:   1,
+			expand: emptyOutbox,
+			commit: true,
+			mocker: func(notifications *mockrepository.MockNotification, notifier *mockclient.MockNotifier) {
+				notifications.On("Claim", ctx, cfg.BatchSize, cfg.MaxAttempts, cfg.Lease).Return([]model.Notification{deleted}, nil)
+				notifications.On("MarkSent", ctx, []uint64{deleted.ID}).Return(nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			notifications := mockrepository.NewMockNotification(t)
+			notifier := mockclient.NewMockNotifier(t)
+
+			tx := mockpostgres.NewMockTx(t)
+
+			txCtx := postgres.InjectTX(ctx, tx)
+
+			if tt.commit {
+				tx.On("Commit", txCtx).Return(nil)
+			} else {
+				tx.On("Rollback", txCtx).Return(nil)
+			}
+
+			db := mockpostgres.NewMockPostgres(t)
+			db.On("BeginTx", ctx, pgx.TxOptions{IsoLevel: pgx.ReadCommitted}).Return(tx, nil)
+
+			tt.expand(txCtx, notifications)
+			tt.mocker(notifications, notifier)
+
+			service := notificationservice.NewService(postgres.NewTxManager(db), notifications, notifier, cfg)
+
+			sent, failed, err := service.Dispatch(ctx)
+
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.sent, sent)
+			require.Equal(t, tt.failed, failed)
+		})
+	}
+}
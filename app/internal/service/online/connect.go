@@ -0,0 +1,27 @@
+package onlineservice
+
+import (
+	"context"
+	"time"
+
+	"github.com/defany/slogger/pkg/logger/sl"
+)
+
+func (s *service) Connect(_ context.Context, userID uint64) {
+	s.registry.Connect(userID)
+}
+
+// Disconnect запоминает время, когда пользователь закрыл последнее подключение
+func (s *service) Disconnect(ctx context.Context, userID uint64) error {
+	op := sl.FnName()
+
+	if !s.registry.Disconnect(userID) {
+		return nil
+	}
+
+	if err := s.repo.SetLastSeen(ctx, userID, time.Now()); err != nil {
+		return sl.Err(op, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,61 @@
+package onlineservice
+
+import (
+	"context"
+	"slices"
+
+	"github.com/defany/chat-server/app/internal/converter"
+	"github.com/defany/chat-server/app/internal/model"
+	"github.com/defany/slogger/pkg/logger/sl"
+)
+
+func (s *service) GetPresence(ctx context.Context, input converter.GetPresenceInput) ([]model.UserPresence, error) {
+	op := sl.FnName()
+
+	contacts, err := s.repo.Contacts(ctx, input.UserID, input.UserIDs)
+	if err != nil {
+		return nil, sl.Err(op, err)
+	}
+
+	// Порядок сохраняем как в запросе, а свой статус пользователь видит всегда
+	userIDs := slices.DeleteFunc(slices.Clone(input.UserIDs), func(userID uint64) bool {
+		return userID != input.UserID && !slices.Contains(contacts, userID)
+	})
+
+	presences := make([]model.UserPresence, 0, len(userIDs))
+
+	var offline []uint64
+
+	for _, userID := range userIDs {
+		online := s.registry.Online(userID)
+		if !online {
+			offline = append(offline, userID)
+		}
+
+		presences = append(presences, model.UserPresence{
+			UserID: userID,
+			Online: online,
+		})
+	}
+
+	if len(offline) == 0 {
+		return presences, nil
+	}
+
+	lastSeen, err := s.repo.LastSeen(ctx, offline)
+	if err != nil {
+		return nil, sl.Err(op, err)
+	}
+
+	for i, presence := range presences {
+		if presence.Online {
+			continue
+		}
+
+		if at, ok := lastSeen[presence.UserID]; ok {
+			presences[i].LastSeenAt = &at
+		}
+	}
+
+	return presences, nil
+}
@@ -0,0 +1,19 @@
+package onlineservice
+
+import (
+	"github.com/defany/chat-server/app/internal/repository"
+	servicedef "github.com/defany/chat-server/app/internal/service"
+	"github.com/defany/chat-server/app/internal/service/presence"
+)
+
+type service struct {
+	repo     repository.Presence
+	registry *presence.Registry
+}
+
+func NewService(repo repository.Presence, registry *presence.Registry) servicedef.Online {
+	return &service{
+		repo:     repo,
+		registry: registry,
+	}
+}
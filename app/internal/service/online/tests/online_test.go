@@ -0,0 +1,114 @@
+package onlinetests
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/defany/chat-server/app/internal/converter"
+	"github.com/defany/chat-server/app/internal/model"
+	mockrepository "github.com/defany/chat-server/app/internal/repository/mocks"
+	onlineservice "github.com/defany/chat-server/app/internal/service/online"
+	"github.com/defany/chat-server/app/internal/service/presence"
+	"github.com/defany/slogger/pkg/logger/sl"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService_Disconnect(t *testing.T) {
+	var (
+		ctx = context.Background()
+
+		userID = gofakeit.Uint64()
+
+		repoErr = errors.New("connection refused")
+	)
+
+	tests := []struct {
+		name     string
+		connects int
+		err      error
+		repoErr  error
+	}{
+		{
+			name:     "last seen is saved when the last stream is closed",
+			connects: 1,
+			err:      nil,
+		},
+		{
+			name:     "last seen is not saved while another stream is open",
+			connects: 2,
+			err:      nil,
+		},
+		{
+			name:     "failed to save last seen",
+			connects: 1,
+			err:      sl.Err("service.Disconnect", repoErr),
+			repoErr:  repoErr,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := mockrepository.NewMockPresence(t)
+			if tt.connects == 1 {
+				repo.On("SetLastSeen", ctx, userID, mock.AnythingOfType("time.Time")).Return(tt.repoErr)
+			}
+
+			registry := presence.NewRegistry()
+			service := onlineservice.NewService(repo, registry)
+
+			for range tt.connects {
+				service.Connect(ctx, userID)
+			}
+
+			err := service.Disconnect(ctx, userID)
+
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.connects > 1, registry.Online(userID))
+		})
+	}
+}
+
+func TestService_GetPresence(t *testing.T) {
+	var (
+		ctx = context.Background()
+
+		callerID   = gofakeit.Uint64()
+		onlineID   = gofakeit.Uint64()
+		offlineID  = gofakeit.Uint64()
+		unknownID  = gofakeit.Uint64()
+		strangerID = gofakeit.Uint64()
+
+		lastSeen = time.Now().Add(-5 * time.Minute)
+
+		requested = []uint64{offlineID, strangerID, onlineID, unknownID}
+	)
+
+	repo := mockrepository.NewMockPresence(t)
+	// С чужаком общих чатов нет, его статус не отдаем даже если он в сети
+	repo.On("Contacts", ctx, callerID, requested).Return([]uint64{onlineID, offlineID, unknownID}, nil)
+	repo.On("LastSeen", ctx, []uint64{offlineID, unknownID}).Return(map[uint64]time.Time{
+		offlineID: lastSeen,
+	}, nil)
+
+	registry := presence.NewRegistry()
+	registry.Connect(onlineID)
+	registry.Connect(strangerID)
+
+	service := onlineservice.NewService(repo, registry)
+
+	presences, err := service.GetPresence(ctx, converter.GetPresenceInput{
+		UserID:  callerID,
+		UserIDs: requested,
+	})
+
+	require.NoError(t, err)
+	require.Equal(t, []model.UserPresence{
+		{UserID: offlineID, LastSeenAt: &lastSeen},
+		{UserID: onlineID, Online: true},
+		{UserID: unknownID},
+	}, presences)
+}
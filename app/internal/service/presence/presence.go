@@ -0,0 +1,340 @@
+package presence
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/defany/chat-server/app/internal/model"
+)
+
+const watcherBuffer = 64
+
+var (
+	ErrNotJoined     = errors.New("presence of the chat is not watched")
+	ErrTrackerClosed = errors.New("presence tracker closed")
+)
+
+type Watcher struct {
+	userID uint64
+	chats  map[int64]struct{}
+
+	events chan model.PresenceEvent
+
+	once sync.Once
+	err  error
+}
+
+func (w *Watcher) Events() <-chan model.PresenceEvent {
+	return w.events
+}
+
+// Err возвращает причину, по которой канал событий был закрыт
+func (w *Watcher) Err() error {
+	return w.err
+}
+
+func (w *Watcher) close(err error) {
+	w.once.Do(func() {
+		w.err = err
+
+		close(w.events)
+	})
+}
+
+type typingKey struct {
+	chatID int64
+	userID uint64
+}
+
+type typingState struct {
+	timer *time.Timer
+}
+
+// Tracker хранит, кто из участников чатов онлайн и кто печатает, только в памяти инстанса.
+// Статус "печатает" сбрасывается сам, если клиент не подтвердил его в течение ttl
+type Tracker struct {
+	mu     sync.Mutex
+	ttl    time.Duration
+	chats  map[int64]map[*Watcher]struct{}
+	typing map[typingKey]*typingState
+	closed bool
+}
+
+func New(ttl time.Duration) *Tracker {
+	return &Tracker{
+		ttl:    ttl,
+		chats:  make(map[int64]map[*Watcher]struct{}),
+		typing: make(map[typingKey]*typingState),
+	}
+}
+
+func (t *Tracker) Watch(userID uint64) *Watcher {
+	w := &Watcher{
+		userID: userID,
+		chats:  make(map[int64]struct{}),
+		events: make(chan model.PresenceEvent, watcherBuffer),
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.closed {
+		w.close(ErrTrackerClosed)
+	}
+
+	return w
+}
+
+func (t *Tracker) Unwatch(w *Watcher) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	for chatID := range w.chats {
+		t.leave(w, chatID)
+	}
+
+	w.close(nil)
+}
+
+// Join подписывает на состояние участников чата и сразу отдает тех, кто уже онлайн
+func (t *Tracker) Join(w *Watcher, chatID int64) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.closed {
+		return
+	}
+
+	if _, ok := w.chats[chatID]; ok {
+		return
+	}
+
+	wasOnline := t.online(chatID, w.userID)
+
+	watchers, ok := t.chats[chatID]
+	if !ok {
+		watchers = make(map[*Watcher]struct{})
+		t.chats[chatID] = watchers
+	}
+
+	seen := make(map[uint64]struct{})
+
+	for other := range watchers {
+		if other.userID == w.userID {
+			continue
+		}
+
+		if _, ok := seen[other.userID]; ok {
+			continue
+		}
+
+		seen[other.userID] = struct{}{}
+
+		_, typing := t.typing[typingKey{chatID: chatID, userID: other.userID}]
+
+		t.send(w, model.PresenceEvent{
+			ChatID: chatID,
+			UserID: other.userID,
+			Online: true,
+			Typing: typing,
+		})
+	}
+
+	watchers[w] = struct{}{}
+	w.chats[chatID] = struct{}{}
+
+	if !wasOnline {
+		t.broadcast(model.PresenceEvent{
+			ChatID: chatID,
+			UserID: w.userID,
+			Online: true,
+		})
+	}
+}
+
+func (t *Tracker) Leave(w *Watcher, chatID int64) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.leave(w, chatID)
+}
+
+// RemoveMember отписывает все подключения пользователя, которого больше нет в чате
+func (t *Tracker) RemoveMember(chatID int64, userID uint64) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	for w := range t.chats[chatID] {
+		if w.userID == userID {
+			t.leave(w, chatID)
+		}
+	}
+}
+
+// CloseChat отписывает от удаленного чата всех и сбрасывает статусы "печатает", событий никому не шлем - чата больше нет
+func (t *Tracker) CloseChat(chatID int64) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	for w := range t.chats[chatID] {
+		delete(w.chats, chatID)
+
+		key := typingKey{chatID: chatID, userID: w.userID}
+
+		if state, ok := t.typing[key]; ok {
+			state.timer.Stop()
+
+			delete(t.typing, key)
+		}
+	}
+
+	delete(t.chats, chatID)
+}
+
+// Typing включает или выключает статус "печатает". Повторный старт продлевает его еще на ttl
+func (t *Tracker) Typing(w *Watcher, chatID int64, typing bool) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.closed {
+		return ErrTrackerClosed
+	}
+
+	if _, ok := w.chats[chatID]; !ok {
+		return ErrNotJoined
+	}
+
+	key := typingKey{chatID: chatID, userID: w.userID}
+
+	state, wasTyping := t.typing[key]
+	if wasTyping {
+		state.timer.Stop()
+
+		delete(t.typing, key)
+	}
+
+	if typing {
+		next := &typingState{}
+		next.timer = time.AfterFunc(t.ttl, func() {
+			t.expire(key, next)
+		})
+
+		t.typing[key] = next
+	}
+
+	if typing == wasTyping {
+		return nil
+	}
+
+	t.broadcast(model.PresenceEvent{
+		ChatID: chatID,
+		UserID: w.userID,
+		Online: true,
+		Typing: typing,
+	})
+
+	return nil
+}
+
+func (t *Tracker) Close() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.closed = true
+
+	for key, state := range t.typing {
+		state.timer.Stop()
+
+		delete(t.typing, key)
+	}
+
+	for chatID, watchers := range t.chats {
+		for w := range watchers {
+			w.close(ErrTrackerClosed)
+		}
+
+		delete(t.chats, chatID)
+	}
+}
+
+func (t *Tracker) expire(key typingKey, state *typingState) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	// Статус могли уже продлить или снять, тогда в map лежит другое состояние
+	if t.typing[key] != state {
+		return
+	}
+
+	delete(t.typing, key)
+
+	t.broadcast(model.PresenceEvent{
+		ChatID: key.chatID,
+		UserID: key.userID,
+		Online: t.online(key.chatID, key.userID),
+	})
+}
+
+func (t *Tracker) leave(w *Watcher, chatID int64) {
+	if _, ok := w.chats[chatID]; !ok {
+		return
+	}
+
+	delete(w.chats, chatID)
+
+	watchers := t.chats[chatID]
+
+	delete(watchers, w)
+
+	if len(watchers) == 0 {
+		delete(t.chats, chatID)
+	}
+
+	// У пользователя может быть несколько подключений, офлайн он только когда ушло последнее
+	if t.online(chatID, w.userID) {
+		return
+	}
+
+	key := typingKey{chatID: chatID, userID: w.userID}
+
+	if state, ok := t.typing[key]; ok {
+		state.timer.Stop()
+
+		delete(t.typing, key)
+	}
+
+	t.broadcast(model.PresenceEvent{
+		ChatID: chatID,
+		UserID: w.userID,
+	})
+}
+
+func (t *Tracker) online(chatID int64, userID uint64) bool {
+	for w := range t.chats[chatID] {
+		if w.userID == userID {
+			return true
+		}
+	}
+
+	return false
+}
+
+// broadcast рассылает событие всем, кроме подключений самого пользователя
+func (t *Tracker) broadcast(event model.PresenceEvent) {
+	for w := range t.chats[event.ChatID] {
+		if w.userID == event.UserID {
+			continue
+		}
+
+		t.send(w, event)
+	}
+}
+
+// send не блокируется: presence эфемерен, поэтому медленный клиент просто пропустит событие
+func (t *Tracker) send(w *Watcher, event model.PresenceEvent) {
+	select {
+	case w.events <- event:
+	default:
+	}
+}
@@ -0,0 +1,50 @@
+package presence
+
+import "sync"
+
+// Registry считает открытые стримы пользователей, онлайн тот, у кого есть хотя бы один
+type Registry struct {
+	mu    sync.Mutex
+	conns map[uint64]int
+}
+
+func NewRegistry() *Registry {
+	return &Registry{
+		conns: make(map[uint64]int),
+	}
+}
+
+func (r *Registry) Connect(userID uint64) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.conns[userID]++
+}
+
+// Disconnect возвращает true, если закрылось последнее подключение пользователя
+func (r *Registry) Disconnect(userID uint64) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	conns, ok := r.conns[userID]
+	if !ok {
+		return false
+	}
+
+	if conns > 1 {
+		r.conns[userID] = conns - 1
+
+		return false
+	}
+
+	delete(r.conns, userID)
+
+	return true
+}
+
+func (r *Registry) Online(userID uint64) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.conns[userID] > 0
+}
@@ -0,0 +1,21 @@
+package reactionservice
+
+import (
+	"context"
+
+	"github.com/defany/chat-server/app/internal/converter"
+	"github.com/defany/slogger/pkg/logger/sl"
+)
+
+func (s *service) AddReaction(ctx context.Context, input converter.ReactionInput) error {
+	op := sl.FnName()
+
+	msg, err := s.react(ctx, input, s.reactions.Add)
+	if err != nil {
+		return sl.Err(op, err)
+	}
+
+	s.hub.Publish(msg)
+
+	return nil
+}
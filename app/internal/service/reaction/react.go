@@ -0,0 +1,53 @@
+package reactionservice
+
+import (
+	"context"
+
+	"github.com/defany/chat-server/app/internal/apperr"
+	"github.com/defany/chat-server/app/internal/converter"
+	"github.com/defany/chat-server/app/internal/model"
+)
+
+// react применяет изменение реакции и возвращает сообщение с актуальными счетчиками для подписчиков чата
+func (s *service) react(ctx context.Context, input converter.ReactionInput, apply func(ctx context.Context, reaction model.Reaction) error) (model.Message, error) {
+	var msg model.Message
+
+	err := s.tx.ReadCommitted(ctx, func(ctx context.Context) error {
+		err := s.access.RequireRole(ctx, input.ChatID, input.UserID)
+		if err != nil {
+			return err
+		}
+
+		msg, err = s.chats.Message(ctx, input.ChatID, input.MessageID)
+		if err != nil {
+			return err
+		}
+
+		if msg.DeletedAt != nil {
+			return apperr.NotFound("message not found")
+		}
+
+		err = apply(ctx, model.Reaction{
+			MessageID: msg.ID,
+			UserID:    input.UserID,
+			Emoji:     input.Emoji,
+		})
+		if err != nil {
+			return err
+		}
+
+		counts, err := s.reactions.Counts(ctx, []uint64{msg.ID})
+		if err != nil {
+			return err
+		}
+
+		msg.Reactions = counts[msg.ID]
+
+		return nil
+	})
+	if err != nil {
+		return model.Message{}, err
+	}
+
+	return msg, nil
+}
@@ -0,0 +1,27 @@
+package reactionservice
+
+import (
+	"github.com/defany/chat-server/app/internal/repository"
+	servicedef "github.com/defany/chat-server/app/internal/service"
+	"github.com/defany/chat-server/app/internal/service/access"
+	"github.com/defany/chat-server/app/internal/service/hub"
+	"github.com/defany/db/pkg/postgres"
+)
+
+type service struct {
+	tx        postgres.TxManager
+	chats     repository.Chat
+	reactions repository.Reaction
+	hub       *hub.Hub
+	access    *access.Checker
+}
+
+func NewService(tx postgres.TxManager, chats repository.Chat, reactions repository.Reaction, hub *hub.Hub) servicedef.Reaction {
+	return &service{
+		tx:        tx,
+		chats:     chats,
+		reactions: reactions,
+		hub:       hub,
+		access:    access.New(chats),
+	}
+}
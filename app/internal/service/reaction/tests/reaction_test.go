@@ -0,0 +1,186 @@
+package reactiontests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/defany/chat-server/app/internal/apperr"
+	"github.com/defany/chat-server/app/internal/converter"
+	"github.com/defany/chat-server/app/internal/model"
+	mockrepository "github.com/defany/chat-server/app/internal/repository/mocks"
+	"github.com/defany/chat-server/app/internal/service/hub"
+	reactionservice "github.com/defany/chat-server/app/internal/service/reaction"
+	"github.com/defany/db/pkg/postgres"
+	mockpostgres "github.com/defany/db/pkg/postgres/mocks"
+	"github.com/defany/slogger/pkg/logger/sl"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService_AddReaction(t *testing.T) {
+	type mocker struct {
+		chats     *mockrepository.MockChat
+		reactions *mockrepository.MockReaction
+	}
+
+	var (
+		input = converter.ReactionInput{
+			ChatID:    gofakeit.Int64(),
+			MessageID: gofakeit.Uint64(),
+			UserID:    gofakeit.Uint64(),
+			Emoji:     "👍",
+		}
+
+		msg = model.Message{
+			ID:        input.MessageID,
+			ChatID:    input.ChatID,
+			UserID:    gofakeit.Uint64(),
+			Text:      gofakeit.Sentence(3),
+			Timestamp: gofakeit.Date(),
+		}
+
+		reaction = model.Reaction{
+			MessageID: input.MessageID,
+			UserID:    input.UserID,
+			Emoji:     input.Emoji,
+		}
+
+		counts = map[uint64][]model.ReactionCount{
+			msg.ID: {{Emoji: input.Emoji, Count: 1}},
+		}
+
+		deletedAt = time.Now()
+	)
+
+	tests := []struct {
+		name   string
+		commit bool
+		err    error
+		mocker func(txCtx context.Context, m mocker)
+	}{
+		{
+			name:   "member reacts to message and subscribers get new counts",
+			commit: true,
+			mocker: func(txCtx context.Context, m mocker) {
+				m.chats.On("MemberRole", txCtx, input.ChatID, input.UserID).Return(model.RoleMember, nil)
+				m.chats.On("Message", txCtx, input.ChatID, input.MessageID).Return(msg, nil)
+				m.reactions.On("Add", txCtx, reaction).Return(nil)
+				m.reactions.On("Counts", txCtx, []uint64{msg.ID}).Return(counts, nil)
+			},
+		},
+		{
+			name: "outsider can not react",
+			err:  sl.Err("service.AddReaction", apperr.PermissionDenied("user is not a member of the chat")),
+			mocker: func(txCtx context.Context, m mocker) {
+				m.chats.On("MemberRole", txCtx, input.ChatID, input.UserID).Return(model.Role(""), nil)
+			},
+		},
+		{
+			name: "deleted message can not get reactions",
+			err:  sl.Err("service.AddReaction", apperr.NotFound("message not found")),
+			mocker: func(txCtx context.Context, m mocker) {
+				deleted := msg
+				deleted.DeletedAt = &deletedAt
+
+				m.chats.On("MemberRole", txCtx, input.ChatID, input.UserID).Return(model.RoleMember, nil)
+				m.chats.On("Message", txCtx, input.ChatID, input.MessageID).Return(deleted, nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			tx := mockpostgres.NewMockTx(t)
+
+			txCtx := postgres.InjectTX(ctx, tx)
+
+			if tt.commit {
+				tx.On("Commit", txCtx).Return(nil)
+			} else {
+				tx.On("Rollback", txCtx).Return(nil)
+			}
+
+			db := mockpostgres.NewMockPostgres(t)
+			db.On("BeginTx", ctx, pgx.TxOptions{IsoLevel: pgx.ReadCommitted}).Return(tx, nil)
+
+			m := mocker{
+				chats:     mockrepository.NewMockChat(t),
+				reactions: mockrepository.NewMockReaction(t),
+			}
+
+			tt.mocker(txCtx, m)
+
+			h := hub.New()
+
+			sub := h.Subscribe(input.ChatID, gofakeit.Uint64())
+			defer h.Unsubscribe(sub)
+
+			service := reactionservice.NewService(postgres.NewTxManager(db), m.chats, m.reactions, h)
+
+			err := service.AddReaction(ctx, input)
+
+			require.Equal(t, tt.err, err)
+
+			if tt.err != nil {
+				require.Empty(t, sub.Messages())
+
+				return
+			}
+
+			published := <-sub.Messages()
+
+			require.Equal(t, counts[msg.ID], published.Reactions)
+		})
+	}
+}
+
+func TestService_RemoveReaction(t *testing.T) {
+	var (
+		input = converter.ReactionInput{
+			ChatID:    gofakeit.Int64(),
+			MessageID: gofakeit.Uint64(),
+			UserID:    gofakeit.Uint64(),
+			Emoji:     "🔥",
+		}
+
+		msg = model.Message{
+			ID:     input.MessageID,
+			ChatID: input.ChatID,
+		}
+	)
+
+	ctx := context.Background()
+
+	tx := mockpostgres.NewMockTx(t)
+
+	txCtx := postgres.InjectTX(ctx, tx)
+
+	tx.On("Commit", txCtx).Return(nil)
+
+	db := mockpostgres.NewMockPostgres(t)
+	db.On("BeginTx", ctx, pgx.TxOptions{IsoLevel: pgx.ReadCommitted}).Return(tx, nil)
+
+	chats := mockrepository.NewMockChat(t)
+	chats.On("MemberRole", txCtx, input.ChatID, input.UserID).Return(model.RoleMember, nil)
+	chats.On("Message", txCtx, input.ChatID, input.MessageID).Return(msg, nil)
+
+	reactions := mockrepository.NewMockReaction(t)
+	reactions.On("Remove", txCtx, model.Reaction{MessageID: msg.ID, UserID: input.UserID, Emoji: input.Emoji}).Return(nil)
+	reactions.On("Counts", txCtx, []uint64{msg.ID}).Return(map[uint64][]model.ReactionCount{}, nil)
+
+	h := hub.New()
+
+	sub := h.Subscribe(input.ChatID, gofakeit.Uint64())
+	defer h.Unsubscribe(sub)
+
+	service := reactionservice.NewService(postgres.NewTxManager(db), chats, reactions, h)
+
+	err := service.RemoveReaction(ctx, input)
+
+	require.NoError(t, err)
+	require.Empty(t, (<-sub.Messages()).Reactions)
+}
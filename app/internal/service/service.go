@@ -2,12 +2,67 @@ package servicedef
 
 import (
 	"context"
+	"io"
 
 	"github.com/defany/chat-server/app/internal/converter"
+	"github.com/defany/chat-server/app/internal/model"
 )
 
 type Chat interface {
 	CreateChat(ctx context.Context, input converter.CreateChatInput) (converter.CreateChatOutput, error)
+	GetOrCreateDirectChat(ctx context.Context, input converter.GetOrCreateDirectChatInput) (converter.GetOrCreateDirectChatOutput, error)
 	DeleteChat(ctx context.Context, input converter.DeleteChatInput) error
-	SendMessage(ctx context.Context, input converter.SendMessageInput) error
+	RestoreChat(ctx context.Context, input converter.RestoreChatInput) error
+	GetChat(ctx context.Context, input converter.GetChatInput) (model.Chat, error)
+	UpdateChat(ctx context.Context, input converter.UpdateChatInput) (model.Chat, error)
+	AddMembers(ctx context.Context, input converter.AddMembersInput) error
+	RemoveMember(ctx context.Context, input converter.RemoveMemberInput) error
+	SetMemberAdmin(ctx context.Context, input converter.SetMemberAdminInput) error
+	LeaveChat(ctx context.Context, input converter.LeaveChatInput) error
+	CreateChannel(ctx context.Context, input converter.CreateChannelInput) (converter.CreateChatOutput, error)
+	Subscribe(ctx context.Context, input converter.SubscribeInput) error
+	PurgeDeletedChats(ctx context.Context) (int64, error)
+	SendMessage(ctx context.Context, input converter.SendMessageInput) (model.Message, error)
+	ConnectChat(ctx context.Context, input converter.ConnectChatInput, send func(msg model.Message) error) error
+	ListMessages(ctx context.Context, input converter.ListMessagesInput) (converter.ListMessagesOutput, error)
+	ListChats(ctx context.Context, input converter.ListChatsInput) (converter.ListChatsOutput, error)
+	SearchMessages(ctx context.Context, input converter.SearchMessagesInput) (converter.SearchMessagesOutput, error)
+	ListMentions(ctx context.Context, input converter.ListMentionsInput) (converter.ListMentionsOutput, error)
+	EditMessage(ctx context.Context, input converter.EditMessageInput) (model.Message, error)
+	DeleteMessage(ctx context.Context, input converter.DeleteMessageInput) error
+	ListThread(ctx context.Context, input converter.ListThreadInput) (converter.ListThreadOutput, error)
+	MarkRead(ctx context.Context, input converter.MarkReadInput) error
+	MuteChat(ctx context.Context, input converter.MuteChatInput) error
+	GetUnreadCounts(ctx context.Context, userID uint64) ([]model.UnreadCount, error)
+	Presence(ctx context.Context, userID uint64, recv func() (converter.PresenceInput, error), send func(event model.PresenceEvent) error) error
+}
+
+type Reaction interface {
+	AddReaction(ctx context.Context, input converter.ReactionInput) error
+	RemoveReaction(ctx context.Context, input converter.ReactionInput) error
+}
+
+// Attachment принимает и отдает содержимое вложений потоком, целиком файл в памяти не держим
+type Attachment interface {
+	Upload(ctx context.Context, input converter.UploadAttachmentInput, body io.Reader) (model.Attachment, error)
+	Download(ctx context.Context, input converter.DownloadAttachmentInput) (model.Attachment, io.ReadCloser, error)
+	// PurgeUnattached удаляет вложения, которые так и не прикрепили к сообщению за отведенное время, и возвращает их количество
+	PurgeUnattached(ctx context.Context) (int64, error)
+	// PurgeOrphans удаляет файлы вложений окончательно удаленных чатов и сообщений и возвращает их количество
+	PurgeOrphans(ctx context.Context) (int64, error)
+}
+
+// Notification доставляет уведомления из outbox'а
+type Notification interface {
+	// Dispatch раскладывает outbox по получателям и разбирает очередь, пока в ней есть готовые уведомления.
+	// Возвращает число доставленных и неудачных попыток
+	Dispatch(ctx context.Context) (sent int64, failed int64, err error)
+}
+
+// Online отслеживает подключения пользователей через стримы
+type Online interface {
+	Connect(ctx context.Context, userID uint64)
+	Disconnect(ctx context.Context, userID uint64) error
+	// GetPresence отдает статус только тех, с кем у пользователя есть общий чат, остальных молча пропускает
+	GetPresence(ctx context.Context, input converter.GetPresenceInput) ([]model.UserPresence, error)
 }
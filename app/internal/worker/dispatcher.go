@@ -0,0 +1,52 @@
+package worker
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	servicedef "github.com/defany/chat-server/app/internal/service"
+	"github.com/defany/slogger/pkg/logger/sl"
+)
+
+// Dispatcher опрашивает outbox уведомлений и отдает их на доставку
+type Dispatcher struct {
+	log      *slog.Logger
+	service  servicedef.Notification
+	interval time.Duration
+}
+
+func NewDispatcher(log *slog.Logger, service servicedef.Notification, interval time.Duration) *Dispatcher {
+	return &Dispatcher{
+		log:      log,
+		service:  service,
+		interval: interval,
+	}
+}
+
+// Run блокируется до отмены контекста
+func (d *Dispatcher) Run(ctx context.Context) {
+	log := d.log.With(slog.String("op", sl.FnName()))
+
+	ticker := time.NewTicker(d.interval)
+	defer ticker.Stop()
+
+	for {
+		sent, failed, err := d.service.Dispatch(ctx)
+		if err != nil && ctx.Err() == nil {
+			log.Error("failed to dispatch notifications", sl.ErrAttr(err))
+		}
+
+		if failed > 0 {
+			log.Warn("some notifications were not delivered and will be retried", slog.Int64("sent", sent), slog.Int64("failed", failed))
+		} else if sent > 0 {
+			log.Debug("dispatched notifications", slog.Int64("sent", sent))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
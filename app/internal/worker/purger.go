@@ -0,0 +1,64 @@
+package worker
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	servicedef "github.com/defany/chat-server/app/internal/service"
+	"github.com/defany/slogger/pkg/logger/sl"
+)
+
+// Purger периодически чистит удаленные чаты, срок восстановления которых истек, и брошенные вложения
+type Purger struct {
+	log         *slog.Logger
+	chats       servicedef.Chat
+	attachments servicedef.Attachment
+	interval    time.Duration
+}
+
+func NewPurger(log *slog.Logger, chats servicedef.Chat, attachments servicedef.Attachment, interval time.Duration) *Purger {
+	return &Purger{
+		log:         log,
+		chats:       chats,
+		attachments: attachments,
+		interval:    interval,
+	}
+}
+
+// Run блокируется до отмены контекста
+func (p *Purger) Run(ctx context.Context) {
+	log := p.log.With(slog.String("op", sl.FnName()))
+
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	for {
+		purged, err := p.chats.PurgeDeletedChats(ctx)
+		if err != nil {
+			log.Error("failed to purge deleted chats", sl.ErrAttr(err))
+		} else if purged > 0 {
+			log.Info("purged deleted chats", slog.Int64("count", purged))
+		}
+
+		purged, err = p.attachments.PurgeUnattached(ctx)
+		if err != nil {
+			log.Error("failed to purge unattached attachments", sl.ErrAttr(err))
+		} else if purged > 0 {
+			log.Info("purged unattached attachments", slog.Int64("count", purged))
+		}
+
+		purged, err = p.attachments.PurgeOrphans(ctx)
+		if err != nil {
+			log.Error("failed to purge orphaned attachment files", sl.ErrAttr(err))
+		} else if purged > 0 {
+			log.Info("purged orphaned attachment files", slog.Int64("count", purged))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
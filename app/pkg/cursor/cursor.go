@@ -0,0 +1,33 @@
+package cursor
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+)
+
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+func Encode[T any](v T) (string, error) {
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(raw), nil
+}
+
+func Decode[T any](s string) (T, error) {
+	var v T
+
+	raw, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return v, ErrInvalidCursor
+	}
+
+	if err := json.Unmarshal(raw, &v); err != nil {
+		return v, ErrInvalidCursor
+	}
+
+	return v, nil
+}
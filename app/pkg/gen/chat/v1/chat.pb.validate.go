@@ -57,7 +57,43 @@ func (m *CreateRequest) validate(all bool) error {
 
 	var errors []error
 
-	// no validation rules for Title
+	if utf8.RuneCountInString(m.GetTitle()) > 255 {
+		err := CreateRequestValidationError{
+			field:  "Title",
+			reason: "value length must be at most 255 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := len(m.GetUsernames()); l < 1 || l > 1000 {
+		err := CreateRequestValidationError{
+			field:  "Usernames",
+			reason: "value must contain between 1 and 1000 items, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetUsernames() {
+		_, _ = idx, item
+
+		if l := utf8.RuneCountInString(item); l < 1 || l > 64 {
+			err := CreateRequestValidationError{
+				field:  fmt.Sprintf("Usernames[%v]", idx),
+				reason: "value length must be between 1 and 64 runes, inclusive",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
 
 	if len(errors) > 0 {
 		return CreateRequestMultiError(errors)
@@ -239,44 +275,64 @@ var _ interface {
 	ErrorName() string
 } = CreateResponseValidationError{}
 
-// Validate checks the field values on DeleteRequest with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *DeleteRequest) Validate() error {
+// Validate checks the field values on CreateChannelRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateChannelRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in DeleteRequestMultiError, or
-// nil if none found.
-func (m *DeleteRequest) ValidateAll() error {
+// ValidateAll checks the field values on CreateChannelRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateChannelRequestMultiError, or nil if none found.
+func (m *CreateChannelRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteRequest) validate(all bool) error {
+func (m *CreateChannelRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
+	if l := utf8.RuneCountInString(m.GetTitle()); l < 1 || l > 255 {
+		err := CreateChannelRequestValidationError{
+			field:  "Title",
+			reason: "value length must be between 1 and 255 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetDescription()) > 1024 {
+		err := CreateChannelRequestValidationError{
+			field:  "Description",
+			reason: "value length must be at most 1024 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	if len(errors) > 0 {
-		return DeleteRequestMultiError(errors)
+		return CreateChannelRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteRequestMultiError is an error wrapping multiple validation errors
-// returned by DeleteRequest.ValidateAll() if the designated constraints
-// aren't met.
-type DeleteRequestMultiError []error
+// CreateChannelRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateChannelRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateChannelRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteRequestMultiError) Error() string {
+func (m CreateChannelRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -285,11 +341,11 @@ func (m DeleteRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteRequestMultiError) AllErrors() []error { return m }
+func (m CreateChannelRequestMultiError) AllErrors() []error { return m }
 
-// DeleteRequestValidationError is the validation error returned by
-// DeleteRequest.Validate if the designated constraints aren't met.
-type DeleteRequestValidationError struct {
+// CreateChannelRequestValidationError is the validation error returned by
+// CreateChannelRequest.Validate if the designated constraints aren't met.
+type CreateChannelRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -297,22 +353,24 @@ type DeleteRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteRequestValidationError) Field() string { return e.field }
+func (e CreateChannelRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteRequestValidationError) Reason() string { return e.reason }
+func (e CreateChannelRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteRequestValidationError) Cause() error { return e.cause }
+func (e CreateChannelRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteRequestValidationError) Key() bool { return e.key }
+func (e CreateChannelRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteRequestValidationError) ErrorName() string { return "DeleteRequestValidationError" }
+func (e CreateChannelRequestValidationError) ErrorName() string {
+	return "CreateChannelRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e DeleteRequestValidationError) Error() string {
+func (e CreateChannelRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -324,14 +382,14 @@ func (e DeleteRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteRequest.%s: %s%s",
+		"invalid %sCreateChannelRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteRequestValidationError{}
+var _ error = CreateChannelRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -339,79 +397,159 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteRequestValidationError{}
+} = CreateChannelRequestValidationError{}
 
-// Validate checks the field values on SendMessageRequest with the rules
+// Validate checks the field values on CreateChannelResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *SendMessageRequest) Validate() error {
+func (m *CreateChannelResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SendMessageRequest with the rules
+// ValidateAll checks the field values on CreateChannelResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// SendMessageRequestMultiError, or nil if none found.
-func (m *SendMessageRequest) ValidateAll() error {
+// CreateChannelResponseMultiError, or nil if none found.
+func (m *CreateChannelResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SendMessageRequest) validate(all bool) error {
+func (m *CreateChannelResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for ChatId
+	// no validation rules for Id
 
-	// no validation rules for From
+	if len(errors) > 0 {
+		return CreateChannelResponseMultiError(errors)
+	}
 
-	// no validation rules for Text
+	return nil
+}
 
-	if all {
-		switch v := interface{}(m.GetTimestamp()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, SendMessageRequestValidationError{
-					field:  "Timestamp",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, SendMessageRequestValidationError{
-					field:  "Timestamp",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
+// CreateChannelResponseMultiError is an error wrapping multiple validation
+// errors returned by CreateChannelResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CreateChannelResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateChannelResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateChannelResponseMultiError) AllErrors() []error { return m }
+
+// CreateChannelResponseValidationError is the validation error returned by
+// CreateChannelResponse.Validate if the designated constraints aren't met.
+type CreateChannelResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateChannelResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateChannelResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateChannelResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateChannelResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateChannelResponseValidationError) ErrorName() string {
+	return "CreateChannelResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateChannelResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateChannelResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateChannelResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateChannelResponseValidationError{}
+
+// Validate checks the field values on SubscribeRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SubscribeRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SubscribeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SubscribeRequestMultiError, or nil if none found.
+func (m *SubscribeRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SubscribeRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetChatId() <= 0 {
+		err := SubscribeRequestValidationError{
+			field:  "ChatId",
+			reason: "value must be greater than 0",
 		}
-	} else if v, ok := interface{}(m.GetTimestamp()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return SendMessageRequestValidationError{
-				field:  "Timestamp",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
+		if !all {
+			return err
 		}
+		errors = append(errors, err)
 	}
 
 	if len(errors) > 0 {
-		return SendMessageRequestMultiError(errors)
+		return SubscribeRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// SendMessageRequestMultiError is an error wrapping multiple validation errors
-// returned by SendMessageRequest.ValidateAll() if the designated constraints
+// SubscribeRequestMultiError is an error wrapping multiple validation errors
+// returned by SubscribeRequest.ValidateAll() if the designated constraints
 // aren't met.
-type SendMessageRequestMultiError []error
+type SubscribeRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SendMessageRequestMultiError) Error() string {
+func (m SubscribeRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -420,11 +558,11 @@ func (m SendMessageRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SendMessageRequestMultiError) AllErrors() []error { return m }
+func (m SubscribeRequestMultiError) AllErrors() []error { return m }
 
-// SendMessageRequestValidationError is the validation error returned by
-// SendMessageRequest.Validate if the designated constraints aren't met.
-type SendMessageRequestValidationError struct {
+// SubscribeRequestValidationError is the validation error returned by
+// SubscribeRequest.Validate if the designated constraints aren't met.
+type SubscribeRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -432,24 +570,22 @@ type SendMessageRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e SendMessageRequestValidationError) Field() string { return e.field }
+func (e SubscribeRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SendMessageRequestValidationError) Reason() string { return e.reason }
+func (e SubscribeRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SendMessageRequestValidationError) Cause() error { return e.cause }
+func (e SubscribeRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SendMessageRequestValidationError) Key() bool { return e.key }
+func (e SubscribeRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SendMessageRequestValidationError) ErrorName() string {
-	return "SendMessageRequestValidationError"
-}
+func (e SubscribeRequestValidationError) ErrorName() string { return "SubscribeRequestValidationError" }
 
 // Error satisfies the builtin error interface
-func (e SendMessageRequestValidationError) Error() string {
+func (e SubscribeRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -461,14 +597,14 @@ func (e SendMessageRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSendMessageRequest.%s: %s%s",
+		"invalid %sSubscribeRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SendMessageRequestValidationError{}
+var _ error = SubscribeRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -476,4 +612,7353 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SendMessageRequestValidationError{}
+} = SubscribeRequestValidationError{}
+
+// Validate checks the field values on GetOrCreateDirectChatRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetOrCreateDirectChatRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetOrCreateDirectChatRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetOrCreateDirectChatRequestMultiError, or nil if none found.
+func (m *GetOrCreateDirectChatRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetOrCreateDirectChatRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetUserId() <= 0 {
+		err := GetOrCreateDirectChatRequestValidationError{
+			field:  "UserId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetOrCreateDirectChatRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetOrCreateDirectChatRequestMultiError is an error wrapping multiple
+// validation errors returned by GetOrCreateDirectChatRequest.ValidateAll() if
+// the designated constraints aren't met.
+type GetOrCreateDirectChatRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetOrCreateDirectChatRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetOrCreateDirectChatRequestMultiError) AllErrors() []error { return m }
+
+// GetOrCreateDirectChatRequestValidationError is the validation error returned
+// by GetOrCreateDirectChatRequest.Validate if the designated constraints
+// aren't met.
+type GetOrCreateDirectChatRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetOrCreateDirectChatRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetOrCreateDirectChatRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetOrCreateDirectChatRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetOrCreateDirectChatRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetOrCreateDirectChatRequestValidationError) ErrorName() string {
+	return "GetOrCreateDirectChatRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetOrCreateDirectChatRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetOrCreateDirectChatRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetOrCreateDirectChatRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetOrCreateDirectChatRequestValidationError{}
+
+// Validate checks the field values on GetOrCreateDirectChatResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetOrCreateDirectChatResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetOrCreateDirectChatResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetOrCreateDirectChatResponseMultiError, or nil if none found.
+func (m *GetOrCreateDirectChatResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetOrCreateDirectChatResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetChat()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetOrCreateDirectChatResponseValidationError{
+					field:  "Chat",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetOrCreateDirectChatResponseValidationError{
+					field:  "Chat",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetChat()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetOrCreateDirectChatResponseValidationError{
+				field:  "Chat",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Created
+
+	if len(errors) > 0 {
+		return GetOrCreateDirectChatResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetOrCreateDirectChatResponseMultiError is an error wrapping multiple
+// validation errors returned by GetOrCreateDirectChatResponse.ValidateAll()
+// if the designated constraints aren't met.
+type GetOrCreateDirectChatResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetOrCreateDirectChatResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetOrCreateDirectChatResponseMultiError) AllErrors() []error { return m }
+
+// GetOrCreateDirectChatResponseValidationError is the validation error
+// returned by GetOrCreateDirectChatResponse.Validate if the designated
+// constraints aren't met.
+type GetOrCreateDirectChatResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetOrCreateDirectChatResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetOrCreateDirectChatResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetOrCreateDirectChatResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetOrCreateDirectChatResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetOrCreateDirectChatResponseValidationError) ErrorName() string {
+	return "GetOrCreateDirectChatResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetOrCreateDirectChatResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetOrCreateDirectChatResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetOrCreateDirectChatResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetOrCreateDirectChatResponseValidationError{}
+
+// Validate checks the field values on DeleteRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *DeleteRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in DeleteRequestMultiError, or
+// nil if none found.
+func (m *DeleteRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() <= 0 {
+		err := DeleteRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeleteRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteRequestMultiError is an error wrapping multiple validation errors
+// returned by DeleteRequest.ValidateAll() if the designated constraints
+// aren't met.
+type DeleteRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteRequestMultiError) AllErrors() []error { return m }
+
+// DeleteRequestValidationError is the validation error returned by
+// DeleteRequest.Validate if the designated constraints aren't met.
+type DeleteRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteRequestValidationError) ErrorName() string { return "DeleteRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DeleteRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteRequestValidationError{}
+
+// Validate checks the field values on RestoreChatRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RestoreChatRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RestoreChatRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RestoreChatRequestMultiError, or nil if none found.
+func (m *RestoreChatRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RestoreChatRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() <= 0 {
+		err := RestoreChatRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RestoreChatRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RestoreChatRequestMultiError is an error wrapping multiple validation errors
+// returned by RestoreChatRequest.ValidateAll() if the designated constraints
+// aren't met.
+type RestoreChatRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RestoreChatRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RestoreChatRequestMultiError) AllErrors() []error { return m }
+
+// RestoreChatRequestValidationError is the validation error returned by
+// RestoreChatRequest.Validate if the designated constraints aren't met.
+type RestoreChatRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RestoreChatRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RestoreChatRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RestoreChatRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RestoreChatRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RestoreChatRequestValidationError) ErrorName() string {
+	return "RestoreChatRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RestoreChatRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRestoreChatRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RestoreChatRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RestoreChatRequestValidationError{}
+
+// Validate checks the field values on ChatInfo with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ChatInfo) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ChatInfo with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ChatInfoMultiError, or nil
+// if none found.
+func (m *ChatInfo) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ChatInfo) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Title
+
+	// no validation rules for Description
+
+	// no validation rules for AvatarUrl
+
+	// no validation rules for Version
+
+	if all {
+		switch v := interface{}(m.GetLastMessageAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ChatInfoValidationError{
+					field:  "LastMessageAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ChatInfoValidationError{
+					field:  "LastMessageAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLastMessageAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ChatInfoValidationError{
+				field:  "LastMessageAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Kind
+
+	if len(errors) > 0 {
+		return ChatInfoMultiError(errors)
+	}
+
+	return nil
+}
+
+// ChatInfoMultiError is an error wrapping multiple validation errors returned
+// by ChatInfo.ValidateAll() if the designated constraints aren't met.
+type ChatInfoMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ChatInfoMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ChatInfoMultiError) AllErrors() []error { return m }
+
+// ChatInfoValidationError is the validation error returned by
+// ChatInfo.Validate if the designated constraints aren't met.
+type ChatInfoValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ChatInfoValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ChatInfoValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ChatInfoValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ChatInfoValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ChatInfoValidationError) ErrorName() string { return "ChatInfoValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ChatInfoValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sChatInfo.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ChatInfoValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ChatInfoValidationError{}
+
+// Validate checks the field values on GetChatRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GetChatRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetChatRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GetChatRequestMultiError,
+// or nil if none found.
+func (m *GetChatRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetChatRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetChatId() <= 0 {
+		err := GetChatRequestValidationError{
+			field:  "ChatId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetChatRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetChatRequestMultiError is an error wrapping multiple validation errors
+// returned by GetChatRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetChatRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetChatRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetChatRequestMultiError) AllErrors() []error { return m }
+
+// GetChatRequestValidationError is the validation error returned by
+// GetChatRequest.Validate if the designated constraints aren't met.
+type GetChatRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetChatRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetChatRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetChatRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetChatRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetChatRequestValidationError) ErrorName() string { return "GetChatRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetChatRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetChatRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetChatRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetChatRequestValidationError{}
+
+// Validate checks the field values on GetChatResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetChatResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetChatResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetChatResponseMultiError, or nil if none found.
+func (m *GetChatResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetChatResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetChat()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetChatResponseValidationError{
+					field:  "Chat",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetChatResponseValidationError{
+					field:  "Chat",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetChat()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetChatResponseValidationError{
+				field:  "Chat",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetChatResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetChatResponseMultiError is an error wrapping multiple validation errors
+// returned by GetChatResponse.ValidateAll() if the designated constraints
+// aren't met.
+type GetChatResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetChatResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetChatResponseMultiError) AllErrors() []error { return m }
+
+// GetChatResponseValidationError is the validation error returned by
+// GetChatResponse.Validate if the designated constraints aren't met.
+type GetChatResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetChatResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetChatResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetChatResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetChatResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetChatResponseValidationError) ErrorName() string { return "GetChatResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetChatResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetChatResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetChatResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetChatResponseValidationError{}
+
+// Validate checks the field values on UpdateChatRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *UpdateChatRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateChatRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateChatRequestMultiError, or nil if none found.
+func (m *UpdateChatRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateChatRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetChatId() <= 0 {
+		err := UpdateChatRequestValidationError{
+			field:  "ChatId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetVersion() <= 0 {
+		err := UpdateChatRequestValidationError{
+			field:  "Version",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.Title != nil {
+
+		if l := utf8.RuneCountInString(m.GetTitle()); l < 1 || l > 255 {
+			err := UpdateChatRequestValidationError{
+				field:  "Title",
+				reason: "value length must be between 1 and 255 runes, inclusive",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.Description != nil {
+
+		if utf8.RuneCountInString(m.GetDescription()) > 1024 {
+			err := UpdateChatRequestValidationError{
+				field:  "Description",
+				reason: "value length must be at most 1024 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.AvatarUrl != nil {
+
+		if m.GetAvatarUrl() != "" {
+
+			if utf8.RuneCountInString(m.GetAvatarUrl()) > 2048 {
+				err := UpdateChatRequestValidationError{
+					field:  "AvatarUrl",
+					reason: "value length must be at most 2048 runes",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+			if uri, err := url.Parse(m.GetAvatarUrl()); err != nil {
+				err = UpdateChatRequestValidationError{
+					field:  "AvatarUrl",
+					reason: "value must be a valid URI",
+					cause:  err,
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			} else if !uri.IsAbs() {
+				err := UpdateChatRequestValidationError{
+					field:  "AvatarUrl",
+					reason: "value must be absolute",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return UpdateChatRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateChatRequestMultiError is an error wrapping multiple validation errors
+// returned by UpdateChatRequest.ValidateAll() if the designated constraints
+// aren't met.
+type UpdateChatRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateChatRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateChatRequestMultiError) AllErrors() []error { return m }
+
+// UpdateChatRequestValidationError is the validation error returned by
+// UpdateChatRequest.Validate if the designated constraints aren't met.
+type UpdateChatRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateChatRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateChatRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateChatRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateChatRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateChatRequestValidationError) ErrorName() string {
+	return "UpdateChatRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateChatRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateChatRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateChatRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateChatRequestValidationError{}
+
+// Validate checks the field values on UpdateChatResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateChatResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateChatResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateChatResponseMultiError, or nil if none found.
+func (m *UpdateChatResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateChatResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetChat()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateChatResponseValidationError{
+					field:  "Chat",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateChatResponseValidationError{
+					field:  "Chat",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetChat()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateChatResponseValidationError{
+				field:  "Chat",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateChatResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateChatResponseMultiError is an error wrapping multiple validation errors
+// returned by UpdateChatResponse.ValidateAll() if the designated constraints
+// aren't met.
+type UpdateChatResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateChatResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateChatResponseMultiError) AllErrors() []error { return m }
+
+// UpdateChatResponseValidationError is the validation error returned by
+// UpdateChatResponse.Validate if the designated constraints aren't met.
+type UpdateChatResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateChatResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateChatResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateChatResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateChatResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateChatResponseValidationError) ErrorName() string {
+	return "UpdateChatResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateChatResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateChatResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateChatResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateChatResponseValidationError{}
+
+// Validate checks the field values on AddMembersRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AddMembersRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddMembersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AddMembersRequestMultiError, or nil if none found.
+func (m *AddMembersRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddMembersRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetChatId() <= 0 {
+		err := AddMembersRequestValidationError{
+			field:  "ChatId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := len(m.GetUsernames()); l < 1 || l > 1000 {
+		err := AddMembersRequestValidationError{
+			field:  "Usernames",
+			reason: "value must contain between 1 and 1000 items, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetUsernames() {
+		_, _ = idx, item
+
+		if l := utf8.RuneCountInString(item); l < 1 || l > 64 {
+			err := AddMembersRequestValidationError{
+				field:  fmt.Sprintf("Usernames[%v]", idx),
+				reason: "value length must be between 1 and 64 runes, inclusive",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return AddMembersRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AddMembersRequestMultiError is an error wrapping multiple validation errors
+// returned by AddMembersRequest.ValidateAll() if the designated constraints
+// aren't met.
+type AddMembersRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddMembersRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddMembersRequestMultiError) AllErrors() []error { return m }
+
+// AddMembersRequestValidationError is the validation error returned by
+// AddMembersRequest.Validate if the designated constraints aren't met.
+type AddMembersRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddMembersRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddMembersRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddMembersRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddMembersRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddMembersRequestValidationError) ErrorName() string {
+	return "AddMembersRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AddMembersRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddMembersRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddMembersRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddMembersRequestValidationError{}
+
+// Validate checks the field values on RemoveMemberRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RemoveMemberRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RemoveMemberRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RemoveMemberRequestMultiError, or nil if none found.
+func (m *RemoveMemberRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RemoveMemberRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetChatId() <= 0 {
+		err := RemoveMemberRequestValidationError{
+			field:  "ChatId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetUserId() <= 0 {
+		err := RemoveMemberRequestValidationError{
+			field:  "UserId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RemoveMemberRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RemoveMemberRequestMultiError is an error wrapping multiple validation
+// errors returned by RemoveMemberRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RemoveMemberRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RemoveMemberRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RemoveMemberRequestMultiError) AllErrors() []error { return m }
+
+// RemoveMemberRequestValidationError is the validation error returned by
+// RemoveMemberRequest.Validate if the designated constraints aren't met.
+type RemoveMemberRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RemoveMemberRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RemoveMemberRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RemoveMemberRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RemoveMemberRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RemoveMemberRequestValidationError) ErrorName() string {
+	return "RemoveMemberRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RemoveMemberRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRemoveMemberRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RemoveMemberRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RemoveMemberRequestValidationError{}
+
+// Validate checks the field values on SetMemberAdminRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetMemberAdminRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetMemberAdminRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetMemberAdminRequestMultiError, or nil if none found.
+func (m *SetMemberAdminRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetMemberAdminRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetChatId() <= 0 {
+		err := SetMemberAdminRequestValidationError{
+			field:  "ChatId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetUserId() <= 0 {
+		err := SetMemberAdminRequestValidationError{
+			field:  "UserId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Admin
+
+	if len(errors) > 0 {
+		return SetMemberAdminRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetMemberAdminRequestMultiError is an error wrapping multiple validation
+// errors returned by SetMemberAdminRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SetMemberAdminRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetMemberAdminRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetMemberAdminRequestMultiError) AllErrors() []error { return m }
+
+// SetMemberAdminRequestValidationError is the validation error returned by
+// SetMemberAdminRequest.Validate if the designated constraints aren't met.
+type SetMemberAdminRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetMemberAdminRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetMemberAdminRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetMemberAdminRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetMemberAdminRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetMemberAdminRequestValidationError) ErrorName() string {
+	return "SetMemberAdminRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetMemberAdminRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetMemberAdminRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetMemberAdminRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetMemberAdminRequestValidationError{}
+
+// Validate checks the field values on LeaveChatRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *LeaveChatRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LeaveChatRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// LeaveChatRequestMultiError, or nil if none found.
+func (m *LeaveChatRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LeaveChatRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetChatId() <= 0 {
+		err := LeaveChatRequestValidationError{
+			field:  "ChatId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return LeaveChatRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// LeaveChatRequestMultiError is an error wrapping multiple validation errors
+// returned by LeaveChatRequest.ValidateAll() if the designated constraints
+// aren't met.
+type LeaveChatRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LeaveChatRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LeaveChatRequestMultiError) AllErrors() []error { return m }
+
+// LeaveChatRequestValidationError is the validation error returned by
+// LeaveChatRequest.Validate if the designated constraints aren't met.
+type LeaveChatRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LeaveChatRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LeaveChatRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LeaveChatRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LeaveChatRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LeaveChatRequestValidationError) ErrorName() string { return "LeaveChatRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e LeaveChatRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLeaveChatRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LeaveChatRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LeaveChatRequestValidationError{}
+
+// Validate checks the field values on SendMessageRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SendMessageRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SendMessageRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SendMessageRequestMultiError, or nil if none found.
+func (m *SendMessageRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SendMessageRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetChatId() <= 0 {
+		err := SendMessageRequestValidationError{
+			field:  "ChatId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for From
+
+	if utf8.RuneCountInString(m.GetText()) > 4096 {
+		err := SendMessageRequestValidationError{
+			field:  "Text",
+			reason: "value length must be at most 4096 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetTimestamp()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SendMessageRequestValidationError{
+					field:  "Timestamp",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SendMessageRequestValidationError{
+					field:  "Timestamp",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTimestamp()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SendMessageRequestValidationError{
+				field:  "Timestamp",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if utf8.RuneCountInString(m.GetClientMessageId()) > 64 {
+		err := SendMessageRequestValidationError{
+			field:  "ClientMessageId",
+			reason: "value length must be at most 64 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetReplyToMessageId() < 0 {
+		err := SendMessageRequestValidationError{
+			field:  "ReplyToMessageId",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetAttachmentIds()) > 10 {
+		err := SendMessageRequestValidationError{
+			field:  "AttachmentIds",
+			reason: "value must contain no more than 10 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	_SendMessageRequest_AttachmentIds_Unique := make(map[int64]struct{}, len(m.GetAttachmentIds()))
+
+	for idx, item := range m.GetAttachmentIds() {
+		_, _ = idx, item
+
+		if _, exists := _SendMessageRequest_AttachmentIds_Unique[item]; exists {
+			err := SendMessageRequestValidationError{
+				field:  fmt.Sprintf("AttachmentIds[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+			_SendMessageRequest_AttachmentIds_Unique[item] = struct{}{}
+		}
+
+		if item <= 0 {
+			err := SendMessageRequestValidationError{
+				field:  fmt.Sprintf("AttachmentIds[%v]", idx),
+				reason: "value must be greater than 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetContent()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SendMessageRequestValidationError{
+					field:  "Content",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SendMessageRequestValidationError{
+					field:  "Content",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetContent()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SendMessageRequestValidationError{
+				field:  "Content",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SendMessageRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SendMessageRequestMultiError is an error wrapping multiple validation errors
+// returned by SendMessageRequest.ValidateAll() if the designated constraints
+// aren't met.
+type SendMessageRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SendMessageRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SendMessageRequestMultiError) AllErrors() []error { return m }
+
+// SendMessageRequestValidationError is the validation error returned by
+// SendMessageRequest.Validate if the designated constraints aren't met.
+type SendMessageRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SendMessageRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SendMessageRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SendMessageRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SendMessageRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SendMessageRequestValidationError) ErrorName() string {
+	return "SendMessageRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SendMessageRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSendMessageRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SendMessageRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SendMessageRequestValidationError{}
+
+// Validate checks the field values on SendMessageResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SendMessageResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SendMessageResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SendMessageResponseMultiError, or nil if none found.
+func (m *SendMessageResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SendMessageResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetMessage()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SendMessageResponseValidationError{
+					field:  "Message",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SendMessageResponseValidationError{
+					field:  "Message",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMessage()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SendMessageResponseValidationError{
+				field:  "Message",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SendMessageResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SendMessageResponseMultiError is an error wrapping multiple validation
+// errors returned by SendMessageResponse.ValidateAll() if the designated
+// constraints aren't met.
+type SendMessageResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SendMessageResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SendMessageResponseMultiError) AllErrors() []error { return m }
+
+// SendMessageResponseValidationError is the validation error returned by
+// SendMessageResponse.Validate if the designated constraints aren't met.
+type SendMessageResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SendMessageResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SendMessageResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SendMessageResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SendMessageResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SendMessageResponseValidationError) ErrorName() string {
+	return "SendMessageResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SendMessageResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSendMessageResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SendMessageResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SendMessageResponseValidationError{}
+
+// Validate checks the field values on ConnectChatRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ConnectChatRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ConnectChatRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ConnectChatRequestMultiError, or nil if none found.
+func (m *ConnectChatRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ConnectChatRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetChatId() <= 0 {
+		err := ConnectChatRequestValidationError{
+			field:  "ChatId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ConnectChatRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ConnectChatRequestMultiError is an error wrapping multiple validation errors
+// returned by ConnectChatRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ConnectChatRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ConnectChatRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ConnectChatRequestMultiError) AllErrors() []error { return m }
+
+// ConnectChatRequestValidationError is the validation error returned by
+// ConnectChatRequest.Validate if the designated constraints aren't met.
+type ConnectChatRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ConnectChatRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ConnectChatRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ConnectChatRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ConnectChatRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ConnectChatRequestValidationError) ErrorName() string {
+	return "ConnectChatRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ConnectChatRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sConnectChatRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ConnectChatRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ConnectChatRequestValidationError{}
+
+// Validate checks the field values on Message with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Message) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Message with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in MessageMultiError, or nil if none found.
+func (m *Message) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Message) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for ChatId
+
+	// no validation rules for From
+
+	// no validation rules for Text
+
+	if all {
+		switch v := interface{}(m.GetTimestamp()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MessageValidationError{
+					field:  "Timestamp",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MessageValidationError{
+					field:  "Timestamp",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTimestamp()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MessageValidationError{
+				field:  "Timestamp",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetClientSentAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MessageValidationError{
+					field:  "ClientSentAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MessageValidationError{
+					field:  "ClientSentAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetClientSentAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MessageValidationError{
+				field:  "ClientSentAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for ClientMessageId
+
+	if all {
+		switch v := interface{}(m.GetEditedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MessageValidationError{
+					field:  "EditedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MessageValidationError{
+					field:  "EditedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEditedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MessageValidationError{
+				field:  "EditedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Deleted
+
+	// no validation rules for ReplyToMessageId
+
+	for idx, item := range m.GetReactions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MessageValidationError{
+						field:  fmt.Sprintf("Reactions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MessageValidationError{
+						field:  fmt.Sprintf("Reactions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MessageValidationError{
+					field:  fmt.Sprintf("Reactions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for System
+
+	for idx, item := range m.GetAttachments() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MessageValidationError{
+						field:  fmt.Sprintf("Attachments[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MessageValidationError{
+						field:  fmt.Sprintf("Attachments[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MessageValidationError{
+					field:  fmt.Sprintf("Attachments[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetContent()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MessageValidationError{
+					field:  "Content",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MessageValidationError{
+					field:  "Content",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetContent()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MessageValidationError{
+				field:  "Content",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return MessageMultiError(errors)
+	}
+
+	return nil
+}
+
+// MessageMultiError is an error wrapping multiple validation errors returned
+// by Message.ValidateAll() if the designated constraints aren't met.
+type MessageMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MessageMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MessageMultiError) AllErrors() []error { return m }
+
+// MessageValidationError is the validation error returned by Message.Validate
+// if the designated constraints aren't met.
+type MessageValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MessageValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MessageValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MessageValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MessageValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MessageValidationError) ErrorName() string { return "MessageValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MessageValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMessage.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MessageValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MessageValidationError{}
+
+// Validate checks the field values on MessageContent with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *MessageContent) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MessageContent with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in MessageContentMultiError,
+// or nil if none found.
+func (m *MessageContent) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MessageContent) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetText()) > 4096 {
+		err := MessageContentValidationError{
+			field:  "Text",
+			reason: "value length must be at most 4096 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetEntities()) > 100 {
+		err := MessageContentValidationError{
+			field:  "Entities",
+			reason: "value must contain no more than 100 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetEntities() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MessageContentValidationError{
+						field:  fmt.Sprintf("Entities[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MessageContentValidationError{
+						field:  fmt.Sprintf("Entities[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MessageContentValidationError{
+					field:  fmt.Sprintf("Entities[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return MessageContentMultiError(errors)
+	}
+
+	return nil
+}
+
+// MessageContentMultiError is an error wrapping multiple validation errors
+// returned by MessageContent.ValidateAll() if the designated constraints
+// aren't met.
+type MessageContentMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MessageContentMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MessageContentMultiError) AllErrors() []error { return m }
+
+// MessageContentValidationError is the validation error returned by
+// MessageContent.Validate if the designated constraints aren't met.
+type MessageContentValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MessageContentValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MessageContentValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MessageContentValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MessageContentValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MessageContentValidationError) ErrorName() string { return "MessageContentValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MessageContentValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMessageContent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MessageContentValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MessageContentValidationError{}
+
+// Validate checks the field values on MessageEntity with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *MessageEntity) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MessageEntity with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in MessageEntityMultiError, or
+// nil if none found.
+func (m *MessageEntity) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MessageEntity) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, ok := _MessageEntity_Type_NotInLookup[m.GetType()]; ok {
+		err := MessageEntityValidationError{
+			field:  "Type",
+			reason: "value must not be in list [MESSAGE_ENTITY_TYPE_UNSPECIFIED]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := MessageEntityType_name[int32(m.GetType())]; !ok {
+		err := MessageEntityValidationError{
+			field:  "Type",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetOffset() < 0 {
+		err := MessageEntityValidationError{
+			field:  "Offset",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetLength() <= 0 {
+		err := MessageEntityValidationError{
+			field:  "Length",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetUrl()) > 2048 {
+		err := MessageEntityValidationError{
+			field:  "Url",
+			reason: "value length must be at most 2048 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetUserId() < 0 {
+		err := MessageEntityValidationError{
+			field:  "UserId",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return MessageEntityMultiError(errors)
+	}
+
+	return nil
+}
+
+// MessageEntityMultiError is an error wrapping multiple validation errors
+// returned by MessageEntity.ValidateAll() if the designated constraints
+// aren't met.
+type MessageEntityMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MessageEntityMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MessageEntityMultiError) AllErrors() []error { return m }
+
+// MessageEntityValidationError is the validation error returned by
+// MessageEntity.Validate if the designated constraints aren't met.
+type MessageEntityValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MessageEntityValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MessageEntityValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MessageEntityValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MessageEntityValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MessageEntityValidationError) ErrorName() string { return "MessageEntityValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MessageEntityValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMessageEntity.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MessageEntityValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MessageEntityValidationError{}
+
+var _MessageEntity_Type_NotInLookup = map[MessageEntityType]struct{}{
+	0: {},
+}
+
+// Validate checks the field values on Attachment with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Attachment) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Attachment with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AttachmentMultiError, or
+// nil if none found.
+func (m *Attachment) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Attachment) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Filename
+
+	// no validation rules for MimeType
+
+	// no validation rules for Size
+
+	// no validation rules for Sha256
+
+	if len(errors) > 0 {
+		return AttachmentMultiError(errors)
+	}
+
+	return nil
+}
+
+// AttachmentMultiError is an error wrapping multiple validation errors
+// returned by Attachment.ValidateAll() if the designated constraints aren't met.
+type AttachmentMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AttachmentMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AttachmentMultiError) AllErrors() []error { return m }
+
+// AttachmentValidationError is the validation error returned by
+// Attachment.Validate if the designated constraints aren't met.
+type AttachmentValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AttachmentValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AttachmentValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AttachmentValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AttachmentValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AttachmentValidationError) ErrorName() string { return "AttachmentValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AttachmentValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAttachment.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AttachmentValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AttachmentValidationError{}
+
+// Validate checks the field values on ReactionCount with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ReactionCount) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReactionCount with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ReactionCountMultiError, or
+// nil if none found.
+func (m *ReactionCount) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReactionCount) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Emoji
+
+	// no validation rules for Count
+
+	if len(errors) > 0 {
+		return ReactionCountMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReactionCountMultiError is an error wrapping multiple validation errors
+// returned by ReactionCount.ValidateAll() if the designated constraints
+// aren't met.
+type ReactionCountMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReactionCountMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReactionCountMultiError) AllErrors() []error { return m }
+
+// ReactionCountValidationError is the validation error returned by
+// ReactionCount.Validate if the designated constraints aren't met.
+type ReactionCountValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReactionCountValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReactionCountValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReactionCountValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReactionCountValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReactionCountValidationError) ErrorName() string { return "ReactionCountValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ReactionCountValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReactionCount.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReactionCountValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReactionCountValidationError{}
+
+// Validate checks the field values on ListMessagesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListMessagesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListMessagesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListMessagesRequestMultiError, or nil if none found.
+func (m *ListMessagesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListMessagesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetChatId() <= 0 {
+		err := ListMessagesRequestValidationError{
+			field:  "ChatId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetCursor()) > 512 {
+		err := ListMessagesRequestValidationError{
+			field:  "Cursor",
+			reason: "value length must be at most 512 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetLimit(); val < 0 || val > 100 {
+		err := ListMessagesRequestValidationError{
+			field:  "Limit",
+			reason: "value must be inside range [0, 100]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := ListDirection_name[int32(m.GetDirection())]; !ok {
+		err := ListMessagesRequestValidationError{
+			field:  "Direction",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListMessagesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListMessagesRequestMultiError is an error wrapping multiple validation
+// errors returned by ListMessagesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListMessagesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListMessagesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListMessagesRequestMultiError) AllErrors() []error { return m }
+
+// ListMessagesRequestValidationError is the validation error returned by
+// ListMessagesRequest.Validate if the designated constraints aren't met.
+type ListMessagesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListMessagesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListMessagesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListMessagesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListMessagesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListMessagesRequestValidationError) ErrorName() string {
+	return "ListMessagesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListMessagesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListMessagesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListMessagesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListMessagesRequestValidationError{}
+
+// Validate checks the field values on ListMessagesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListMessagesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListMessagesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListMessagesResponseMultiError, or nil if none found.
+func (m *ListMessagesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListMessagesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetMessages() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListMessagesResponseValidationError{
+						field:  fmt.Sprintf("Messages[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListMessagesResponseValidationError{
+						field:  fmt.Sprintf("Messages[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListMessagesResponseValidationError{
+					field:  fmt.Sprintf("Messages[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextCursor
+
+	if len(errors) > 0 {
+		return ListMessagesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListMessagesResponseMultiError is an error wrapping multiple validation
+// errors returned by ListMessagesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListMessagesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListMessagesResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListMessagesResponseMultiError) AllErrors() []error { return m }
+
+// ListMessagesResponseValidationError is the validation error returned by
+// ListMessagesResponse.Validate if the designated constraints aren't met.
+type ListMessagesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListMessagesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListMessagesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListMessagesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListMessagesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListMessagesResponseValidationError) ErrorName() string {
+	return "ListMessagesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListMessagesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListMessagesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListMessagesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListMessagesResponseValidationError{}
+
+// Validate checks the field values on EditMessageRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *EditMessageRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EditMessageRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// EditMessageRequestMultiError, or nil if none found.
+func (m *EditMessageRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EditMessageRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetChatId() <= 0 {
+		err := EditMessageRequestValidationError{
+			field:  "ChatId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetMessageId() <= 0 {
+		err := EditMessageRequestValidationError{
+			field:  "MessageId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetText()) > 4096 {
+		err := EditMessageRequestValidationError{
+			field:  "Text",
+			reason: "value length must be at most 4096 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetContent()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, EditMessageRequestValidationError{
+					field:  "Content",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, EditMessageRequestValidationError{
+					field:  "Content",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetContent()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return EditMessageRequestValidationError{
+				field:  "Content",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return EditMessageRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// EditMessageRequestMultiError is an error wrapping multiple validation errors
+// returned by EditMessageRequest.ValidateAll() if the designated constraints
+// aren't met.
+type EditMessageRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EditMessageRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EditMessageRequestMultiError) AllErrors() []error { return m }
+
+// EditMessageRequestValidationError is the validation error returned by
+// EditMessageRequest.Validate if the designated constraints aren't met.
+type EditMessageRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EditMessageRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EditMessageRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EditMessageRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EditMessageRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EditMessageRequestValidationError) ErrorName() string {
+	return "EditMessageRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e EditMessageRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEditMessageRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EditMessageRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EditMessageRequestValidationError{}
+
+// Validate checks the field values on EditMessageResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *EditMessageResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EditMessageResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// EditMessageResponseMultiError, or nil if none found.
+func (m *EditMessageResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EditMessageResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetMessage()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, EditMessageResponseValidationError{
+					field:  "Message",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, EditMessageResponseValidationError{
+					field:  "Message",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMessage()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return EditMessageResponseValidationError{
+				field:  "Message",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return EditMessageResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// EditMessageResponseMultiError is an error wrapping multiple validation
+// errors returned by EditMessageResponse.ValidateAll() if the designated
+// constraints aren't met.
+type EditMessageResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EditMessageResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EditMessageResponseMultiError) AllErrors() []error { return m }
+
+// EditMessageResponseValidationError is the validation error returned by
+// EditMessageResponse.Validate if the designated constraints aren't met.
+type EditMessageResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EditMessageResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EditMessageResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EditMessageResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EditMessageResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EditMessageResponseValidationError) ErrorName() string {
+	return "EditMessageResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e EditMessageResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEditMessageResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EditMessageResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EditMessageResponseValidationError{}
+
+// Validate checks the field values on DeleteMessageRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteMessageRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteMessageRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteMessageRequestMultiError, or nil if none found.
+func (m *DeleteMessageRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteMessageRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetChatId() <= 0 {
+		err := DeleteMessageRequestValidationError{
+			field:  "ChatId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetMessageId() <= 0 {
+		err := DeleteMessageRequestValidationError{
+			field:  "MessageId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeleteMessageRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteMessageRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteMessageRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteMessageRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteMessageRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteMessageRequestMultiError) AllErrors() []error { return m }
+
+// DeleteMessageRequestValidationError is the validation error returned by
+// DeleteMessageRequest.Validate if the designated constraints aren't met.
+type DeleteMessageRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteMessageRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteMessageRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteMessageRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteMessageRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteMessageRequestValidationError) ErrorName() string {
+	return "DeleteMessageRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteMessageRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteMessageRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteMessageRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteMessageRequestValidationError{}
+
+// Validate checks the field values on ListThreadRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListThreadRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListThreadRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListThreadRequestMultiError, or nil if none found.
+func (m *ListThreadRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListThreadRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetChatId() <= 0 {
+		err := ListThreadRequestValidationError{
+			field:  "ChatId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetMessageId() <= 0 {
+		err := ListThreadRequestValidationError{
+			field:  "MessageId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetCursor()) > 512 {
+		err := ListThreadRequestValidationError{
+			field:  "Cursor",
+			reason: "value length must be at most 512 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetLimit(); val < 0 || val > 100 {
+		err := ListThreadRequestValidationError{
+			field:  "Limit",
+			reason: "value must be inside range [0, 100]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := ListDirection_name[int32(m.GetDirection())]; !ok {
+		err := ListThreadRequestValidationError{
+			field:  "Direction",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListThreadRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListThreadRequestMultiError is an error wrapping multiple validation errors
+// returned by ListThreadRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListThreadRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListThreadRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListThreadRequestMultiError) AllErrors() []error { return m }
+
+// ListThreadRequestValidationError is the validation error returned by
+// ListThreadRequest.Validate if the designated constraints aren't met.
+type ListThreadRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListThreadRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListThreadRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListThreadRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListThreadRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListThreadRequestValidationError) ErrorName() string {
+	return "ListThreadRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListThreadRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListThreadRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListThreadRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListThreadRequestValidationError{}
+
+// Validate checks the field values on ListThreadResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListThreadResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListThreadResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListThreadResponseMultiError, or nil if none found.
+func (m *ListThreadResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListThreadResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetRoot()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListThreadResponseValidationError{
+					field:  "Root",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListThreadResponseValidationError{
+					field:  "Root",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRoot()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListThreadResponseValidationError{
+				field:  "Root",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetReplies() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListThreadResponseValidationError{
+						field:  fmt.Sprintf("Replies[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListThreadResponseValidationError{
+						field:  fmt.Sprintf("Replies[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListThreadResponseValidationError{
+					field:  fmt.Sprintf("Replies[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextCursor
+
+	if len(errors) > 0 {
+		return ListThreadResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListThreadResponseMultiError is an error wrapping multiple validation errors
+// returned by ListThreadResponse.ValidateAll() if the designated constraints
+// aren't met.
+type ListThreadResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListThreadResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListThreadResponseMultiError) AllErrors() []error { return m }
+
+// ListThreadResponseValidationError is the validation error returned by
+// ListThreadResponse.Validate if the designated constraints aren't met.
+type ListThreadResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListThreadResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListThreadResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListThreadResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListThreadResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListThreadResponseValidationError) ErrorName() string {
+	return "ListThreadResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListThreadResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListThreadResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListThreadResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListThreadResponseValidationError{}
+
+// Validate checks the field values on AddReactionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AddReactionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddReactionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AddReactionRequestMultiError, or nil if none found.
+func (m *AddReactionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddReactionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetChatId() <= 0 {
+		err := AddReactionRequestValidationError{
+			field:  "ChatId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetMessageId() <= 0 {
+		err := AddReactionRequestValidationError{
+			field:  "MessageId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetEmoji()); l < 1 || l > 32 {
+		err := AddReactionRequestValidationError{
+			field:  "Emoji",
+			reason: "value length must be between 1 and 32 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return AddReactionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AddReactionRequestMultiError is an error wrapping multiple validation errors
+// returned by AddReactionRequest.ValidateAll() if the designated constraints
+// aren't met.
+type AddReactionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddReactionRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddReactionRequestMultiError) AllErrors() []error { return m }
+
+// AddReactionRequestValidationError is the validation error returned by
+// AddReactionRequest.Validate if the designated constraints aren't met.
+type AddReactionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddReactionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddReactionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddReactionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddReactionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddReactionRequestValidationError) ErrorName() string {
+	return "AddReactionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AddReactionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddReactionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddReactionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddReactionRequestValidationError{}
+
+// Validate checks the field values on RemoveReactionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RemoveReactionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RemoveReactionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RemoveReactionRequestMultiError, or nil if none found.
+func (m *RemoveReactionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RemoveReactionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetChatId() <= 0 {
+		err := RemoveReactionRequestValidationError{
+			field:  "ChatId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetMessageId() <= 0 {
+		err := RemoveReactionRequestValidationError{
+			field:  "MessageId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetEmoji()); l < 1 || l > 32 {
+		err := RemoveReactionRequestValidationError{
+			field:  "Emoji",
+			reason: "value length must be between 1 and 32 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RemoveReactionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RemoveReactionRequestMultiError is an error wrapping multiple validation
+// errors returned by RemoveReactionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RemoveReactionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RemoveReactionRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RemoveReactionRequestMultiError) AllErrors() []error { return m }
+
+// RemoveReactionRequestValidationError is the validation error returned by
+// RemoveReactionRequest.Validate if the designated constraints aren't met.
+type RemoveReactionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RemoveReactionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RemoveReactionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RemoveReactionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RemoveReactionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RemoveReactionRequestValidationError) ErrorName() string {
+	return "RemoveReactionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RemoveReactionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRemoveReactionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RemoveReactionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RemoveReactionRequestValidationError{}
+
+// Validate checks the field values on MarkReadRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *MarkReadRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MarkReadRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MarkReadRequestMultiError, or nil if none found.
+func (m *MarkReadRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MarkReadRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetChatId() <= 0 {
+		err := MarkReadRequestValidationError{
+			field:  "ChatId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetMessageId() <= 0 {
+		err := MarkReadRequestValidationError{
+			field:  "MessageId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return MarkReadRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// MarkReadRequestMultiError is an error wrapping multiple validation errors
+// returned by MarkReadRequest.ValidateAll() if the designated constraints
+// aren't met.
+type MarkReadRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MarkReadRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MarkReadRequestMultiError) AllErrors() []error { return m }
+
+// MarkReadRequestValidationError is the validation error returned by
+// MarkReadRequest.Validate if the designated constraints aren't met.
+type MarkReadRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MarkReadRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MarkReadRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MarkReadRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MarkReadRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MarkReadRequestValidationError) ErrorName() string { return "MarkReadRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MarkReadRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMarkReadRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MarkReadRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MarkReadRequestValidationError{}
+
+// Validate checks the field values on MuteChatRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *MuteChatRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MuteChatRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MuteChatRequestMultiError, or nil if none found.
+func (m *MuteChatRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MuteChatRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetChatId() <= 0 {
+		err := MuteChatRequestValidationError{
+			field:  "ChatId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Muted
+
+	if len(errors) > 0 {
+		return MuteChatRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// MuteChatRequestMultiError is an error wrapping multiple validation errors
+// returned by MuteChatRequest.ValidateAll() if the designated constraints
+// aren't met.
+type MuteChatRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MuteChatRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MuteChatRequestMultiError) AllErrors() []error { return m }
+
+// MuteChatRequestValidationError is the validation error returned by
+// MuteChatRequest.Validate if the designated constraints aren't met.
+type MuteChatRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MuteChatRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MuteChatRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MuteChatRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MuteChatRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MuteChatRequestValidationError) ErrorName() string { return "MuteChatRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MuteChatRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMuteChatRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MuteChatRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MuteChatRequestValidationError{}
+
+// Validate checks the field values on GetUnreadCountsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetUnreadCountsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetUnreadCountsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetUnreadCountsRequestMultiError, or nil if none found.
+func (m *GetUnreadCountsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetUnreadCountsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return GetUnreadCountsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetUnreadCountsRequestMultiError is an error wrapping multiple validation
+// errors returned by GetUnreadCountsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetUnreadCountsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetUnreadCountsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetUnreadCountsRequestMultiError) AllErrors() []error { return m }
+
+// GetUnreadCountsRequestValidationError is the validation error returned by
+// GetUnreadCountsRequest.Validate if the designated constraints aren't met.
+type GetUnreadCountsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetUnreadCountsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetUnreadCountsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetUnreadCountsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetUnreadCountsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetUnreadCountsRequestValidationError) ErrorName() string {
+	return "GetUnreadCountsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetUnreadCountsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetUnreadCountsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetUnreadCountsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetUnreadCountsRequestValidationError{}
+
+// Validate checks the field values on ChatUnreadCount with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ChatUnreadCount) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ChatUnreadCount with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ChatUnreadCountMultiError, or nil if none found.
+func (m *ChatUnreadCount) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ChatUnreadCount) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ChatId
+
+	// no validation rules for UnreadCount
+
+	// no validation rules for LastReadMessageId
+
+	if len(errors) > 0 {
+		return ChatUnreadCountMultiError(errors)
+	}
+
+	return nil
+}
+
+// ChatUnreadCountMultiError is an error wrapping multiple validation errors
+// returned by ChatUnreadCount.ValidateAll() if the designated constraints
+// aren't met.
+type ChatUnreadCountMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ChatUnreadCountMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ChatUnreadCountMultiError) AllErrors() []error { return m }
+
+// ChatUnreadCountValidationError is the validation error returned by
+// ChatUnreadCount.Validate if the designated constraints aren't met.
+type ChatUnreadCountValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ChatUnreadCountValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ChatUnreadCountValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ChatUnreadCountValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ChatUnreadCountValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ChatUnreadCountValidationError) ErrorName() string { return "ChatUnreadCountValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ChatUnreadCountValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sChatUnreadCount.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ChatUnreadCountValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ChatUnreadCountValidationError{}
+
+// Validate checks the field values on GetUnreadCountsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetUnreadCountsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetUnreadCountsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetUnreadCountsResponseMultiError, or nil if none found.
+func (m *GetUnreadCountsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetUnreadCountsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetCounts() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetUnreadCountsResponseValidationError{
+						field:  fmt.Sprintf("Counts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetUnreadCountsResponseValidationError{
+						field:  fmt.Sprintf("Counts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetUnreadCountsResponseValidationError{
+					field:  fmt.Sprintf("Counts[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetUnreadCountsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetUnreadCountsResponseMultiError is an error wrapping multiple validation
+// errors returned by GetUnreadCountsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetUnreadCountsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetUnreadCountsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetUnreadCountsResponseMultiError) AllErrors() []error { return m }
+
+// GetUnreadCountsResponseValidationError is the validation error returned by
+// GetUnreadCountsResponse.Validate if the designated constraints aren't met.
+type GetUnreadCountsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetUnreadCountsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetUnreadCountsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetUnreadCountsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetUnreadCountsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetUnreadCountsResponseValidationError) ErrorName() string {
+	return "GetUnreadCountsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetUnreadCountsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetUnreadCountsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetUnreadCountsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetUnreadCountsResponseValidationError{}
+
+// Validate checks the field values on PresenceRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *PresenceRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PresenceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PresenceRequestMultiError, or nil if none found.
+func (m *PresenceRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PresenceRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetChatId() <= 0 {
+		err := PresenceRequestValidationError{
+			field:  "ChatId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _PresenceRequest_Action_NotInLookup[m.GetAction()]; ok {
+		err := PresenceRequestValidationError{
+			field:  "Action",
+			reason: "value must not be in list [PRESENCE_ACTION_UNSPECIFIED]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := PresenceAction_name[int32(m.GetAction())]; !ok {
+		err := PresenceRequestValidationError{
+			field:  "Action",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return PresenceRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// PresenceRequestMultiError is an error wrapping multiple validation errors
+// returned by PresenceRequest.ValidateAll() if the designated constraints
+// aren't met.
+type PresenceRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PresenceRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PresenceRequestMultiError) AllErrors() []error { return m }
+
+// PresenceRequestValidationError is the validation error returned by
+// PresenceRequest.Validate if the designated constraints aren't met.
+type PresenceRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PresenceRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PresenceRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PresenceRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PresenceRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PresenceRequestValidationError) ErrorName() string { return "PresenceRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PresenceRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPresenceRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PresenceRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PresenceRequestValidationError{}
+
+var _PresenceRequest_Action_NotInLookup = map[PresenceAction]struct{}{
+	0: {},
+}
+
+// Validate checks the field values on PresenceEvent with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *PresenceEvent) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PresenceEvent with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in PresenceEventMultiError, or
+// nil if none found.
+func (m *PresenceEvent) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PresenceEvent) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ChatId
+
+	// no validation rules for UserId
+
+	// no validation rules for Online
+
+	// no validation rules for Typing
+
+	if len(errors) > 0 {
+		return PresenceEventMultiError(errors)
+	}
+
+	return nil
+}
+
+// PresenceEventMultiError is an error wrapping multiple validation errors
+// returned by PresenceEvent.ValidateAll() if the designated constraints
+// aren't met.
+type PresenceEventMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PresenceEventMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PresenceEventMultiError) AllErrors() []error { return m }
+
+// PresenceEventValidationError is the validation error returned by
+// PresenceEvent.Validate if the designated constraints aren't met.
+type PresenceEventValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PresenceEventValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PresenceEventValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PresenceEventValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PresenceEventValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PresenceEventValidationError) ErrorName() string { return "PresenceEventValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PresenceEventValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPresenceEvent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PresenceEventValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PresenceEventValidationError{}
+
+// Validate checks the field values on GetPresenceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetPresenceRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetPresenceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetPresenceRequestMultiError, or nil if none found.
+func (m *GetPresenceRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetPresenceRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := len(m.GetUserIds()); l < 1 || l > 100 {
+		err := GetPresenceRequestValidationError{
+			field:  "UserIds",
+			reason: "value must contain between 1 and 100 items, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetUserIds() {
+		_, _ = idx, item
+
+		if item <= 0 {
+			err := GetPresenceRequestValidationError{
+				field:  fmt.Sprintf("UserIds[%v]", idx),
+				reason: "value must be greater than 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetPresenceRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetPresenceRequestMultiError is an error wrapping multiple validation errors
+// returned by GetPresenceRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetPresenceRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetPresenceRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetPresenceRequestMultiError) AllErrors() []error { return m }
+
+// GetPresenceRequestValidationError is the validation error returned by
+// GetPresenceRequest.Validate if the designated constraints aren't met.
+type GetPresenceRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetPresenceRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetPresenceRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetPresenceRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetPresenceRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetPresenceRequestValidationError) ErrorName() string {
+	return "GetPresenceRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetPresenceRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetPresenceRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetPresenceRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetPresenceRequestValidationError{}
+
+// Validate checks the field values on UserPresence with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *UserPresence) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UserPresence with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in UserPresenceMultiError, or
+// nil if none found.
+func (m *UserPresence) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UserPresence) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for Online
+
+	if all {
+		switch v := interface{}(m.GetLastSeenAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UserPresenceValidationError{
+					field:  "LastSeenAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UserPresenceValidationError{
+					field:  "LastSeenAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLastSeenAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UserPresenceValidationError{
+				field:  "LastSeenAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UserPresenceMultiError(errors)
+	}
+
+	return nil
+}
+
+// UserPresenceMultiError is an error wrapping multiple validation errors
+// returned by UserPresence.ValidateAll() if the designated constraints aren't met.
+type UserPresenceMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UserPresenceMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UserPresenceMultiError) AllErrors() []error { return m }
+
+// UserPresenceValidationError is the validation error returned by
+// UserPresence.Validate if the designated constraints aren't met.
+type UserPresenceValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UserPresenceValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UserPresenceValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UserPresenceValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UserPresenceValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UserPresenceValidationError) ErrorName() string { return "UserPresenceValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UserPresenceValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUserPresence.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UserPresenceValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UserPresenceValidationError{}
+
+// Validate checks the field values on GetPresenceResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetPresenceResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetPresenceResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetPresenceResponseMultiError, or nil if none found.
+func (m *GetPresenceResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetPresenceResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetPresences() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetPresenceResponseValidationError{
+						field:  fmt.Sprintf("Presences[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetPresenceResponseValidationError{
+						field:  fmt.Sprintf("Presences[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetPresenceResponseValidationError{
+					field:  fmt.Sprintf("Presences[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetPresenceResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetPresenceResponseMultiError is an error wrapping multiple validation
+// errors returned by GetPresenceResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetPresenceResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetPresenceResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetPresenceResponseMultiError) AllErrors() []error { return m }
+
+// GetPresenceResponseValidationError is the validation error returned by
+// GetPresenceResponse.Validate if the designated constraints aren't met.
+type GetPresenceResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetPresenceResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetPresenceResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetPresenceResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetPresenceResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetPresenceResponseValidationError) ErrorName() string {
+	return "GetPresenceResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetPresenceResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetPresenceResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetPresenceResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetPresenceResponseValidationError{}
+
+// Validate checks the field values on ListChatsRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListChatsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListChatsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListChatsRequestMultiError, or nil if none found.
+func (m *ListChatsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListChatsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetCursor()) > 512 {
+		err := ListChatsRequestValidationError{
+			field:  "Cursor",
+			reason: "value length must be at most 512 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetLimit(); val < 0 || val > 100 {
+		err := ListChatsRequestValidationError{
+			field:  "Limit",
+			reason: "value must be inside range [0, 100]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListChatsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListChatsRequestMultiError is an error wrapping multiple validation errors
+// returned by ListChatsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListChatsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListChatsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListChatsRequestMultiError) AllErrors() []error { return m }
+
+// ListChatsRequestValidationError is the validation error returned by
+// ListChatsRequest.Validate if the designated constraints aren't met.
+type ListChatsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListChatsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListChatsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListChatsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListChatsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListChatsRequestValidationError) ErrorName() string { return "ListChatsRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ListChatsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListChatsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListChatsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListChatsRequestValidationError{}
+
+// Validate checks the field values on ChatSummary with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ChatSummary) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ChatSummary with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ChatSummaryMultiError, or
+// nil if none found.
+func (m *ChatSummary) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ChatSummary) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Title
+
+	// no validation rules for MemberCount
+
+	// no validation rules for UnreadCount
+
+	if all {
+		switch v := interface{}(m.GetLastMessageAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ChatSummaryValidationError{
+					field:  "LastMessageAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ChatSummaryValidationError{
+					field:  "LastMessageAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLastMessageAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ChatSummaryValidationError{
+				field:  "LastMessageAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetLastMessage()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ChatSummaryValidationError{
+					field:  "LastMessage",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ChatSummaryValidationError{
+					field:  "LastMessage",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLastMessage()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ChatSummaryValidationError{
+				field:  "LastMessage",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Kind
+
+	if len(errors) > 0 {
+		return ChatSummaryMultiError(errors)
+	}
+
+	return nil
+}
+
+// ChatSummaryMultiError is an error wrapping multiple validation errors
+// returned by ChatSummary.ValidateAll() if the designated constraints aren't met.
+type ChatSummaryMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ChatSummaryMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ChatSummaryMultiError) AllErrors() []error { return m }
+
+// ChatSummaryValidationError is the validation error returned by
+// ChatSummary.Validate if the designated constraints aren't met.
+type ChatSummaryValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ChatSummaryValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ChatSummaryValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ChatSummaryValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ChatSummaryValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ChatSummaryValidationError) ErrorName() string { return "ChatSummaryValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ChatSummaryValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sChatSummary.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ChatSummaryValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ChatSummaryValidationError{}
+
+// Validate checks the field values on ListChatsResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListChatsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListChatsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListChatsResponseMultiError, or nil if none found.
+func (m *ListChatsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListChatsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetChats() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListChatsResponseValidationError{
+						field:  fmt.Sprintf("Chats[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListChatsResponseValidationError{
+						field:  fmt.Sprintf("Chats[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListChatsResponseValidationError{
+					field:  fmt.Sprintf("Chats[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextCursor
+
+	if len(errors) > 0 {
+		return ListChatsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListChatsResponseMultiError is an error wrapping multiple validation errors
+// returned by ListChatsResponse.ValidateAll() if the designated constraints
+// aren't met.
+type ListChatsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListChatsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListChatsResponseMultiError) AllErrors() []error { return m }
+
+// ListChatsResponseValidationError is the validation error returned by
+// ListChatsResponse.Validate if the designated constraints aren't met.
+type ListChatsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListChatsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListChatsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListChatsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListChatsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListChatsResponseValidationError) ErrorName() string {
+	return "ListChatsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListChatsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListChatsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListChatsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListChatsResponseValidationError{}
+
+// Validate checks the field values on SearchMessagesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SearchMessagesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchMessagesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SearchMessagesRequestMultiError, or nil if none found.
+func (m *SearchMessagesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchMessagesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetQuery()); l < 1 || l > 256 {
+		err := SearchMessagesRequestValidationError{
+			field:  "Query",
+			reason: "value length must be between 1 and 256 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetChatId() < 0 {
+		err := SearchMessagesRequestValidationError{
+			field:  "ChatId",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetCursor()) > 512 {
+		err := SearchMessagesRequestValidationError{
+			field:  "Cursor",
+			reason: "value length must be at most 512 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetLimit(); val < 0 || val > 100 {
+		err := SearchMessagesRequestValidationError{
+			field:  "Limit",
+			reason: "value must be inside range [0, 100]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SearchMessagesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchMessagesRequestMultiError is an error wrapping multiple validation
+// errors returned by SearchMessagesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SearchMessagesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchMessagesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchMessagesRequestMultiError) AllErrors() []error { return m }
+
+// SearchMessagesRequestValidationError is the validation error returned by
+// SearchMessagesRequest.Validate if the designated constraints aren't met.
+type SearchMessagesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchMessagesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchMessagesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchMessagesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchMessagesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchMessagesRequestValidationError) ErrorName() string {
+	return "SearchMessagesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SearchMessagesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchMessagesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchMessagesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchMessagesRequestValidationError{}
+
+// Validate checks the field values on SearchHit with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SearchHit) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchHit with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SearchHitMultiError, or nil
+// if none found.
+func (m *SearchHit) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchHit) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetMessage()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SearchHitValidationError{
+					field:  "Message",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SearchHitValidationError{
+					field:  "Message",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMessage()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SearchHitValidationError{
+				field:  "Message",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Snippet
+
+	if len(errors) > 0 {
+		return SearchHitMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchHitMultiError is an error wrapping multiple validation errors returned
+// by SearchHit.ValidateAll() if the designated constraints aren't met.
+type SearchHitMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchHitMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchHitMultiError) AllErrors() []error { return m }
+
+// SearchHitValidationError is the validation error returned by
+// SearchHit.Validate if the designated constraints aren't met.
+type SearchHitValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchHitValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchHitValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchHitValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchHitValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchHitValidationError) ErrorName() string { return "SearchHitValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SearchHitValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchHit.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchHitValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchHitValidationError{}
+
+// Validate checks the field values on SearchMessagesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SearchMessagesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchMessagesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SearchMessagesResponseMultiError, or nil if none found.
+func (m *SearchMessagesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchMessagesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetHits() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SearchMessagesResponseValidationError{
+						field:  fmt.Sprintf("Hits[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SearchMessagesResponseValidationError{
+						field:  fmt.Sprintf("Hits[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SearchMessagesResponseValidationError{
+					field:  fmt.Sprintf("Hits[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextCursor
+
+	if len(errors) > 0 {
+		return SearchMessagesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchMessagesResponseMultiError is an error wrapping multiple validation
+// errors returned by SearchMessagesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type SearchMessagesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchMessagesResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchMessagesResponseMultiError) AllErrors() []error { return m }
+
+// SearchMessagesResponseValidationError is the validation error returned by
+// SearchMessagesResponse.Validate if the designated constraints aren't met.
+type SearchMessagesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchMessagesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchMessagesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchMessagesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchMessagesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchMessagesResponseValidationError) ErrorName() string {
+	return "SearchMessagesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SearchMessagesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchMessagesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchMessagesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchMessagesResponseValidationError{}
+
+// Validate checks the field values on ListMentionsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListMentionsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListMentionsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListMentionsRequestMultiError, or nil if none found.
+func (m *ListMentionsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListMentionsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetCursor()) > 512 {
+		err := ListMentionsRequestValidationError{
+			field:  "Cursor",
+			reason: "value length must be at most 512 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetLimit(); val < 0 || val > 100 {
+		err := ListMentionsRequestValidationError{
+			field:  "Limit",
+			reason: "value must be inside range [0, 100]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListMentionsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListMentionsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListMentionsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListMentionsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListMentionsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListMentionsRequestMultiError) AllErrors() []error { return m }
+
+// ListMentionsRequestValidationError is the validation error returned by
+// ListMentionsRequest.Validate if the designated constraints aren't met.
+type ListMentionsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListMentionsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListMentionsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListMentionsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListMentionsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListMentionsRequestValidationError) ErrorName() string {
+	return "ListMentionsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListMentionsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListMentionsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListMentionsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListMentionsRequestValidationError{}
+
+// Validate checks the field values on ListMentionsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListMentionsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListMentionsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListMentionsResponseMultiError, or nil if none found.
+func (m *ListMentionsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListMentionsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetMessages() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListMentionsResponseValidationError{
+						field:  fmt.Sprintf("Messages[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListMentionsResponseValidationError{
+						field:  fmt.Sprintf("Messages[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListMentionsResponseValidationError{
+					field:  fmt.Sprintf("Messages[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextCursor
+
+	if len(errors) > 0 {
+		return ListMentionsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListMentionsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListMentionsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListMentionsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListMentionsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListMentionsResponseMultiError) AllErrors() []error { return m }
+
+// ListMentionsResponseValidationError is the validation error returned by
+// ListMentionsResponse.Validate if the designated constraints aren't met.
+type ListMentionsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListMentionsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListMentionsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListMentionsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListMentionsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListMentionsResponseValidationError) ErrorName() string {
+	return "ListMentionsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListMentionsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListMentionsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListMentionsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListMentionsResponseValidationError{}
+
+// Validate checks the field values on AttachmentInfo with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AttachmentInfo) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AttachmentInfo with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AttachmentInfoMultiError,
+// or nil if none found.
+func (m *AttachmentInfo) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AttachmentInfo) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetChatId() <= 0 {
+		err := AttachmentInfoValidationError{
+			field:  "ChatId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetFilename()); l < 1 || l > 255 {
+		err := AttachmentInfoValidationError{
+			field:  "Filename",
+			reason: "value length must be between 1 and 255 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetMimeType()); l < 1 || l > 255 {
+		err := AttachmentInfoValidationError{
+			field:  "MimeType",
+			reason: "value length must be between 1 and 255 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return AttachmentInfoMultiError(errors)
+	}
+
+	return nil
+}
+
+// AttachmentInfoMultiError is an error wrapping multiple validation errors
+// returned by AttachmentInfo.ValidateAll() if the designated constraints
+// aren't met.
+type AttachmentInfoMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AttachmentInfoMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AttachmentInfoMultiError) AllErrors() []error { return m }
+
+// AttachmentInfoValidationError is the validation error returned by
+// AttachmentInfo.Validate if the designated constraints aren't met.
+type AttachmentInfoValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AttachmentInfoValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AttachmentInfoValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AttachmentInfoValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AttachmentInfoValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AttachmentInfoValidationError) ErrorName() string { return "AttachmentInfoValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AttachmentInfoValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAttachmentInfo.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AttachmentInfoValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AttachmentInfoValidationError{}
+
+// Validate checks the field values on UploadAttachmentRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UploadAttachmentRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UploadAttachmentRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UploadAttachmentRequestMultiError, or nil if none found.
+func (m *UploadAttachmentRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UploadAttachmentRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	oneofPayloadPresent := false
+	switch v := m.Payload.(type) {
+	case *UploadAttachmentRequest_Info:
+		if v == nil {
+			err := UploadAttachmentRequestValidationError{
+				field:  "Payload",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofPayloadPresent = true
+
+		if all {
+			switch v := interface{}(m.GetInfo()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UploadAttachmentRequestValidationError{
+						field:  "Info",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UploadAttachmentRequestValidationError{
+						field:  "Info",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetInfo()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UploadAttachmentRequestValidationError{
+					field:  "Info",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *UploadAttachmentRequest_Chunk:
+		if v == nil {
+			err := UploadAttachmentRequestValidationError{
+				field:  "Payload",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofPayloadPresent = true
+
+		if len(m.GetChunk()) > 1048576 {
+			err := UploadAttachmentRequestValidationError{
+				field:  "Chunk",
+				reason: "value length must be at most 1048576 bytes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+	if !oneofPayloadPresent {
+		err := UploadAttachmentRequestValidationError{
+			field:  "Payload",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return UploadAttachmentRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UploadAttachmentRequestMultiError is an error wrapping multiple validation
+// errors returned by UploadAttachmentRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UploadAttachmentRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UploadAttachmentRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UploadAttachmentRequestMultiError) AllErrors() []error { return m }
+
+// UploadAttachmentRequestValidationError is the validation error returned by
+// UploadAttachmentRequest.Validate if the designated constraints aren't met.
+type UploadAttachmentRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UploadAttachmentRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UploadAttachmentRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UploadAttachmentRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UploadAttachmentRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UploadAttachmentRequestValidationError) ErrorName() string {
+	return "UploadAttachmentRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UploadAttachmentRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUploadAttachmentRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UploadAttachmentRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UploadAttachmentRequestValidationError{}
+
+// Validate checks the field values on UploadAttachmentResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UploadAttachmentResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UploadAttachmentResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UploadAttachmentResponseMultiError, or nil if none found.
+func (m *UploadAttachmentResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UploadAttachmentResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetAttachment()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UploadAttachmentResponseValidationError{
+					field:  "Attachment",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UploadAttachmentResponseValidationError{
+					field:  "Attachment",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAttachment()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UploadAttachmentResponseValidationError{
+				field:  "Attachment",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UploadAttachmentResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UploadAttachmentResponseMultiError is an error wrapping multiple validation
+// errors returned by UploadAttachmentResponse.ValidateAll() if the designated
+// constraints aren't met.
+type UploadAttachmentResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UploadAttachmentResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UploadAttachmentResponseMultiError) AllErrors() []error { return m }
+
+// UploadAttachmentResponseValidationError is the validation error returned by
+// UploadAttachmentResponse.Validate if the designated constraints aren't met.
+type UploadAttachmentResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UploadAttachmentResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UploadAttachmentResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UploadAttachmentResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UploadAttachmentResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UploadAttachmentResponseValidationError) ErrorName() string {
+	return "UploadAttachmentResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UploadAttachmentResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUploadAttachmentResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UploadAttachmentResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UploadAttachmentResponseValidationError{}
+
+// Validate checks the field values on DownloadAttachmentRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DownloadAttachmentRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DownloadAttachmentRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DownloadAttachmentRequestMultiError, or nil if none found.
+func (m *DownloadAttachmentRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DownloadAttachmentRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetAttachmentId() <= 0 {
+		err := DownloadAttachmentRequestValidationError{
+			field:  "AttachmentId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DownloadAttachmentRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DownloadAttachmentRequestMultiError is an error wrapping multiple validation
+// errors returned by DownloadAttachmentRequest.ValidateAll() if the
+// designated constraints aren't met.
+type DownloadAttachmentRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DownloadAttachmentRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DownloadAttachmentRequestMultiError) AllErrors() []error { return m }
+
+// DownloadAttachmentRequestValidationError is the validation error returned by
+// DownloadAttachmentRequest.Validate if the designated constraints aren't met.
+type DownloadAttachmentRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DownloadAttachmentRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DownloadAttachmentRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DownloadAttachmentRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DownloadAttachmentRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DownloadAttachmentRequestValidationError) ErrorName() string {
+	return "DownloadAttachmentRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DownloadAttachmentRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDownloadAttachmentRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DownloadAttachmentRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DownloadAttachmentRequestValidationError{}
+
+// Validate checks the field values on DownloadAttachmentResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DownloadAttachmentResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DownloadAttachmentResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DownloadAttachmentResponseMultiError, or nil if none found.
+func (m *DownloadAttachmentResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DownloadAttachmentResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	switch v := m.Payload.(type) {
+	case *DownloadAttachmentResponse_Info:
+		if v == nil {
+			err := DownloadAttachmentResponseValidationError{
+				field:  "Payload",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetInfo()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DownloadAttachmentResponseValidationError{
+						field:  "Info",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DownloadAttachmentResponseValidationError{
+						field:  "Info",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetInfo()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DownloadAttachmentResponseValidationError{
+					field:  "Info",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *DownloadAttachmentResponse_Chunk:
+		if v == nil {
+			err := DownloadAttachmentResponseValidationError{
+				field:  "Payload",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		// no validation rules for Chunk
+	default:
+		_ = v // ensures v is used
+	}
+
+	if len(errors) > 0 {
+		return DownloadAttachmentResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DownloadAttachmentResponseMultiError is an error wrapping multiple
+// validation errors returned by DownloadAttachmentResponse.ValidateAll() if
+// the designated constraints aren't met.
+type DownloadAttachmentResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DownloadAttachmentResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DownloadAttachmentResponseMultiError) AllErrors() []error { return m }
+
+// DownloadAttachmentResponseValidationError is the validation error returned
+// by DownloadAttachmentResponse.Validate if the designated constraints aren't met.
+type DownloadAttachmentResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DownloadAttachmentResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DownloadAttachmentResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DownloadAttachmentResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DownloadAttachmentResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DownloadAttachmentResponseValidationError) ErrorName() string {
+	return "DownloadAttachmentResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DownloadAttachmentResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDownloadAttachmentResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DownloadAttachmentResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DownloadAttachmentResponseValidationError{}
@@ -0,0 +1,8 @@
+-- +goose NO TRANSACTION
+
+-- +goose Up
+create index concurrently if not exists chats_messages_chat_id_timestamp_id_idx
+    on chats_messages (chat_id, timestamp, id);
+
+-- +goose Down
+drop index concurrently if exists chats_messages_chat_id_timestamp_id_idx;
@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+alter table users_chats
+    add column if not exists role text not null default 'member'
+        constraint users_chats_role_check check ( role in ('owner', 'admin', 'member') );
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+alter table users_chats drop column if exists role;
+-- +goose StatementEnd
@@ -0,0 +1,30 @@
+-- +goose Up
+-- +goose StatementBegin
+alter table chats add column if not exists deleted_at timestamptz;
+
+create index if not exists chats_deleted_at_idx on chats (deleted_at) where deleted_at is not null;
+
+-- Сами чаты удаляются мягко, а при окончательной очистке участники и сообщения должны уходить вместе с чатом
+alter table users_chats
+    drop constraint if exists users_chats_chat_id_fkey,
+    add constraint users_chats_chat_id_fkey foreign key (chat_id) references chats(id) on delete cascade;
+
+alter table chats_messages
+    drop constraint if exists chats_messages_chat_id_fkey,
+    add constraint chats_messages_chat_id_fkey foreign key (chat_id) references chats(id) on delete cascade;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+alter table chats_messages
+    drop constraint if exists chats_messages_chat_id_fkey,
+    add constraint chats_messages_chat_id_fkey foreign key (chat_id) references chats(id);
+
+alter table users_chats
+    drop constraint if exists users_chats_chat_id_fkey,
+    add constraint users_chats_chat_id_fkey foreign key (chat_id) references chats(id);
+
+drop index if exists chats_deleted_at_idx;
+
+alter table chats drop column if exists deleted_at;
+-- +goose StatementEnd
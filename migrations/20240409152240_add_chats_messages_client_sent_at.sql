@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Время сообщения теперь ставит сервер, а клиентское храним отдельно только для диагностики.
+-- Без зоны значение зависело бы от TimeZone сессии, а pgx читает его как UTC, поэтому переводим в timestamptz.
+-- Старые значения писались клиентом в UTC
+alter table chats_messages
+    alter column timestamp type timestamptz using timestamp at time zone 'UTC',
+    alter column timestamp set default clock_timestamp(),
+    add column if not exists client_sent_at timestamptz;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+alter table chats_messages
+    drop column if exists client_sent_at,
+    alter column timestamp drop default,
+    alter column timestamp type timestamp using timestamp at time zone 'UTC';
+-- +goose StatementEnd
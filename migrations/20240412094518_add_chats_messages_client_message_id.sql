@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+alter table chats_messages add column if not exists client_message_id text;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+-- Ключ идемпотентности необязательный, поэтому индекс только по заполненным строкам
+create unique index if not exists chats_messages_client_message_id_key
+    on chats_messages (chat_id, user_id, client_message_id)
+    where client_message_id is not null;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop index if exists chats_messages_client_message_id_key;
+
+alter table chats_messages drop column if exists client_message_id;
+-- +goose StatementEnd
@@ -0,0 +1,24 @@
+-- +goose Up
+-- +goose StatementBegin
+alter table chats_messages
+    add column if not exists edited_at timestamptz,
+    add column if not exists deleted_at timestamptz;
+
+create table if not exists chats_messages_revisions(
+    id bigserial primary key,
+    message_id bigint not null references chats_messages(id) on delete cascade,
+    text text not null,
+    created_at timestamptz not null default clock_timestamp()
+);
+
+create index if not exists chats_messages_revisions_message_id_idx on chats_messages_revisions (message_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop table if exists chats_messages_revisions;
+
+alter table chats_messages
+    drop column if exists deleted_at,
+    drop column if exists edited_at;
+-- +goose StatementEnd
@@ -0,0 +1,8 @@
+-- +goose NO TRANSACTION
+
+-- +goose Up
+-- Индекс под уникальность (chat_id, id) строим без блокировки записи, ограничение на него вешается следующей миграцией
+create unique index concurrently if not exists chats_messages_chat_id_id_key on chats_messages (chat_id, id);
+
+-- +goose Down
+drop index concurrently if exists chats_messages_chat_id_id_key;
@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Нужен для составного внешнего ключа, чтобы ответить можно было только на сообщение из того же чата.
+-- Индекс уже построен, здесь только превращаем его в ограничение
+alter table chats_messages add constraint chats_messages_chat_id_id_key unique using index chats_messages_chat_id_id_key;
+
+alter table chats_messages
+    add column if not exists reply_to_message_id bigint,
+    add constraint chats_messages_reply_to_fkey foreign key (chat_id, reply_to_message_id)
+        references chats_messages (chat_id, id) on delete cascade;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+alter table chats_messages
+    drop constraint if exists chats_messages_reply_to_fkey,
+    drop column if exists reply_to_message_id;
+
+alter table chats_messages drop constraint if exists chats_messages_chat_id_id_key;
+-- +goose StatementEnd
@@ -0,0 +1,9 @@
+-- +goose NO TRANSACTION
+
+-- +goose Up
+create index concurrently if not exists chats_messages_thread_idx
+    on chats_messages (chat_id, reply_to_message_id, timestamp, id)
+    where reply_to_message_id is not null;
+
+-- +goose Down
+drop index concurrently if exists chats_messages_thread_idx;
@@ -0,0 +1,16 @@
+-- +goose Up
+-- +goose StatementBegin
+create table if not exists messages_reactions(
+    message_id bigint not null references chats_messages(id) on delete cascade,
+    user_id numeric(12, 0) not null constraint positive_messages_reactions_user_id check ( user_id > 0 ),
+    emoji text not null constraint messages_reactions_emoji_check check ( length(emoji) between 1 and 32 ),
+    created_at timestamptz not null default clock_timestamp(),
+
+    primary key (message_id, user_id, emoji)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop table if exists messages_reactions;
+-- +goose StatementEnd
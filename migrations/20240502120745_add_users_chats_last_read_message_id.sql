@@ -0,0 +1,10 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Без внешнего ключа: сообщения удаляются мягко, а отметка о прочтении может указывать на любое из них
+alter table users_chats add column if not exists last_read_message_id bigint;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+alter table users_chats drop column if exists last_read_message_id;
+-- +goose StatementEnd
@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Онлайн статус живет в памяти инстанса, в базе только момент последнего отключения
+create table if not exists users_presence (
+    user_id numeric(12, 0) primary key constraint positive_users_presence_user_id check ( user_id > 0 ),
+    last_seen_at timestamptz not null
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop table if exists users_presence;
+-- +goose StatementEnd
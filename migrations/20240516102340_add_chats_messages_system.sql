@@ -0,0 +1,10 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Служебные сообщения о составе чата, автор - тот, кто совершил действие
+alter table chats_messages add column if not exists system boolean not null default false;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+alter table chats_messages drop column if exists system;
+-- +goose StatementEnd
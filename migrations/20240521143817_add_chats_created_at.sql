@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Время последнего сообщения не храним в чате, а считаем при чтении по индексу (chat_id, timestamp, id):
+-- иначе каждая отправка обновляла бы строку чата и сообщения в один чат выстраивались бы в очередь за ее блокировкой.
+-- Для чата без сообщений активностью считается время создания
+alter table chats add column if not exists created_at timestamptz not null default clock_timestamp();
+
+update chats c
+set created_at = m.created_at
+from (
+    select chat_id, min(timestamp) as created_at
+    from chats_messages
+    group by chat_id
+) m
+where m.chat_id = c.id;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+alter table chats drop column if exists created_at;
+-- +goose StatementEnd
@@ -0,0 +1,7 @@
+-- +goose NO TRANSACTION
+
+-- +goose Up
+create index concurrently if not exists users_chats_user_id_idx on users_chats (user_id);
+
+-- +goose Down
+drop index concurrently if exists users_chats_user_id_idx;
@@ -0,0 +1,16 @@
+-- +goose Up
+-- +goose StatementBegin
+-- version растет при каждом изменении, клиент присылает версию, которую видел, чтобы не затереть чужие правки
+alter table chats
+    add column if not exists description text not null default '',
+    add column if not exists avatar_url text not null default '',
+    add column if not exists version bigint not null default 1;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+alter table chats
+    drop column if exists version,
+    drop column if exists avatar_url,
+    drop column if exists description;
+-- +goose StatementEnd
@@ -0,0 +1,30 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Для личного чата храним пару участников в нормализованном виде (меньший id первым),
+-- уникальный индекс по ней не дает параллельным запросам создать второй чат для той же пары
+alter table chats
+    add column if not exists kind text not null default 'group'
+        constraint chats_kind_check check ( kind in ('direct', 'group', 'channel') ),
+    add column if not exists direct_user_low bigint,
+    add column if not exists direct_user_high bigint,
+    add constraint chats_direct_pair_check check (
+        (kind = 'direct') = (direct_user_low is not null and direct_user_high is not null)
+        and (direct_user_low is null or direct_user_low < direct_user_high)
+    );
+
+-- Удаленный личный чат не мешает создать новый с тем же пользователем
+create unique index if not exists chats_direct_pair_key
+    on chats (direct_user_low, direct_user_high)
+    where deleted_at is null;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop index if exists chats_direct_pair_key;
+
+alter table chats
+    drop constraint if exists chats_direct_pair_check,
+    drop column if exists direct_user_high,
+    drop column if exists direct_user_low,
+    drop column if exists kind;
+-- +goose StatementEnd
@@ -0,0 +1,10 @@
+-- +goose NO TRANSACTION
+
+-- +goose Up
+-- Индекс по выражению, а не по отдельной колонке: generated stored колонка переписала бы всю таблицу под эксклюзивной блокировкой.
+-- Конфигурация simple без стемминга: в чатах пишут на разных языках, а морфология одного языка портит поиск по остальным
+create index concurrently if not exists chats_messages_search_idx
+    on chats_messages using gin (to_tsvector('simple', text));
+
+-- +goose Down
+drop index concurrently if exists chats_messages_search_idx;
@@ -0,0 +1,24 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Вложение загружается до отправки сообщения, поэтому message_id пустой, пока автор не прикрепит его к сообщению.
+-- Файлы лежат во внешнем хранилище под storage_key, при удалении чата строки уходят каскадом, а ключи заранее переносятся в chats_attachments_orphans
+create table if not exists chats_attachments(
+    id bigserial primary key,
+    chat_id bigint not null references chats(id) on delete cascade,
+    user_id numeric(12, 0) not null constraint positive_chats_attachments_user_id check ( user_id > 0 ),
+    message_id bigint references chats_messages(id) on delete cascade,
+    filename text not null,
+    mime_type text not null,
+    size bigint not null constraint chats_attachments_size_check check ( size >= 0 ),
+    sha256 text not null,
+    storage_key text not null constraint chats_attachments_storage_key_key unique,
+    created_at timestamptz not null default clock_timestamp()
+);
+
+create index if not exists chats_attachments_message_id_idx on chats_attachments (message_id) where message_id is not null;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop table if exists chats_attachments;
+-- +goose StatementEnd
@@ -0,0 +1,30 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Разметка текста хранится рядом с ним, отдельно ее никто не читает. Упоминания лежат в своей таблице, чтобы искать их по пользователю.
+-- Ревизия тоже хранит разметку, иначе по ней не восстановить, как выглядело сообщение
+alter table chats_messages
+    add column if not exists entities jsonb not null default '[]';
+
+alter table chats_messages_revisions
+    add column if not exists entities jsonb not null default '[]';
+
+create table if not exists chats_messages_mentions(
+    message_id bigint not null references chats_messages(id) on delete cascade,
+    user_id numeric(12, 0) not null constraint positive_chats_messages_mentions_user_id check ( user_id > 0 ),
+
+    primary key (message_id, user_id)
+);
+
+create index if not exists chats_messages_mentions_user_id_idx on chats_messages_mentions (user_id, message_id desc);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop table if exists chats_messages_mentions;
+
+alter table chats_messages_revisions
+    drop column if exists entities;
+
+alter table chats_messages
+    drop column if exists entities;
+-- +goose StatementEnd
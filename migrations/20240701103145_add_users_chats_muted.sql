@@ -0,0 +1,10 @@
+-- +goose Up
+-- +goose StatementBegin
+-- В заглушенном чате уведомления приходят только об упоминаниях
+alter table users_chats add column if not exists muted boolean not null default false;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+alter table users_chats drop column if exists muted;
+-- +goose StatementEnd
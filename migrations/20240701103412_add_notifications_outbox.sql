@@ -0,0 +1,39 @@
+-- +goose Up
+-- +goose StatementBegin
+-- В транзакции сообщения пишем одну строку на сообщение, по получателям ее раскладывает воркер:
+-- иначе отправка в канал на десятки тысяч подписчиков держала бы автора до вставки всех строк
+create table if not exists notifications_outbox(
+    id bigserial primary key,
+    chat_id bigint not null references chats(id) on delete cascade,
+    message_id bigint not null references chats_messages(id) on delete cascade,
+    author_id numeric(12, 0) not null,
+    mentioned numeric(12, 0)[] not null default '{}',
+    -- Правка сообщения уведомляет только новых упомянутых, остальные участники сообщение уже получали
+    mentions_only boolean not null default false,
+    created_at timestamptz not null default clock_timestamp()
+);
+
+-- Уведомление конкретному получателю. Текст не копируем, при доставке он берется из сообщения
+create table if not exists notifications(
+    id bigserial primary key,
+    kind text not null constraint notifications_kind_check check ( kind in ('message', 'mention') ),
+    user_id numeric(12, 0) not null constraint positive_notifications_user_id check ( user_id > 0 ),
+    chat_id bigint not null references chats(id) on delete cascade,
+    message_id bigint not null references chats_messages(id) on delete cascade,
+    author_id numeric(12, 0) not null,
+    attempts int not null default 0,
+    next_attempt_at timestamptz not null default clock_timestamp(),
+    last_error text,
+    sent_at timestamptz,
+    created_at timestamptz not null default clock_timestamp()
+);
+
+-- Воркер выбирает только недоставленные, отправленные строки в индекс не попадают
+create index if not exists notifications_pending_idx on notifications (next_attempt_at, id) where sent_at is null;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop table if exists notifications;
+drop table if exists notifications_outbox;
+-- +goose StatementEnd
@@ -0,0 +1,8 @@
+-- +goose NO TRANSACTION
+
+-- +goose Up
+-- Для уборки загруженных, но так и не отправленных вложений
+create index concurrently if not exists chats_attachments_unattached_idx on chats_attachments (created_at) where message_id is null;
+
+-- +goose Down
+drop index concurrently if exists chats_attachments_unattached_idx;
@@ -0,0 +1,14 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Файлы, на которые больше не ссылается ни одно вложение: строки уходят вместе с чатом или удаленным сообщением,
+-- а ключи остаются здесь, пока purger не уберет файлы из хранилища
+create table if not exists chats_attachments_orphans(
+    storage_key text primary key,
+    created_at timestamptz not null default clock_timestamp()
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop table if exists chats_attachments_orphans;
+-- +goose StatementEnd